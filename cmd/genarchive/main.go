@@ -0,0 +1,52 @@
+// Command genarchive pre-generates the puzzle archive served by the server.
+//
+// Usage:
+//
+//	genarchive [-n count] [-seed first] [-min-moves n] [-max-moves n] [-o archive.json]
+//
+// It generates games from consecutive seeds, solves each one, and keeps those
+// whose optimal solution is between -min-moves and -max-moves moves, until it
+// has -n puzzles.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/srsalisbury/bouncebot/server/archive"
+)
+
+// maxStates bounds the solver for each candidate; harder games are skipped.
+const maxStates = 2_000_000
+
+func main() {
+	count := flag.Int("n", 500, "number of puzzles to generate")
+	seed := flag.Int64("seed", 1, "first seed to try")
+	minMoves := flag.Int("min-moves", 1, "fewest moves in a puzzle's optimal solution")
+	maxMoves := flag.Int("max-moves", 14, "most moves in a puzzle's optimal solution")
+	out := flag.String("o", "archive.json", "archive file to write")
+	flag.Parse()
+	if *seed <= 0 || *minMoves < 1 || *maxMoves < *minMoves {
+		fmt.Fprintln(os.Stderr, "usage: genarchive [-n count] [-seed first] [-min-moves n] [-max-moves n] [-o archive.json]")
+		os.Exit(2)
+	}
+
+	var puzzles []*archive.Puzzle
+	for s := *seed; len(puzzles) < *count; s++ {
+		p, ok := archive.NewPuzzle(s, *maxMoves, maxStates)
+		if !ok || p.MoveCount() < *minMoves {
+			continue
+		}
+		puzzles = append(puzzles, p)
+		if len(puzzles)%50 == 0 {
+			fmt.Fprintf(os.Stderr, "%d puzzles (seed %d)\n", len(puzzles), s)
+		}
+	}
+
+	if err := archive.New(puzzles).Save(*out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote %d puzzles to %s\n", len(puzzles), *out)
+}
@@ -0,0 +1,100 @@
+// Command replay prints a replay file exported with the ExportReplay RPC.
+//
+// Usage:
+//
+//	replay [-steps] [-event n] file.json
+//
+// By default it prints the starting game and every submission and retraction.
+// With -steps it also plays each submission move by move, printing the game
+// after each move; -event plays only the nth event (from 1).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+func main() {
+	steps := flag.Bool("steps", false, "play each submission move by move")
+	event := flag.Int("event", 0, "only play the nth event (from 1) with -steps")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: replay [-steps] [-event n] file.json")
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	replay, err := model.ParseReplay(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *event < 0 || *event > len(replay.Events) {
+		fmt.Fprintf(os.Stderr, "event %d not found, replay has %d events\n", *event, len(replay.Events))
+		os.Exit(1)
+	}
+
+	printSummary(replay)
+	if !*steps {
+		return
+	}
+	for i, e := range replay.Events {
+		if e.Retract || (*event != 0 && i+1 != *event) {
+			continue
+		}
+		fmt.Printf("\nEvent %d: %s, %d moves\n", i+1, replay.PlayerName(e.PlayerID), len(e.Moves))
+		if err := playEvent(replay.Play(i)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// printSummary prints the starting game, the players' events and the result.
+func printSummary(r *model.Replay) {
+	fmt.Printf("Room %s, seed %d, ended %s\n", r.RoomID, r.Seed, r.EndedAt.Format(time.RFC3339))
+	fmt.Println(r.Game.String())
+
+	start := r.StartedAt
+	if start.IsZero() && len(r.Events) > 0 {
+		start = r.Events[0].At
+	}
+	for i, e := range r.Events {
+		action := "submitted"
+		if e.Retract {
+			action = "retracted"
+		}
+		elapsed := e.At.Sub(start).Round(time.Second)
+		fmt.Printf("%3d  +%-8s %s %s %d moves\n", i+1, elapsed, r.PlayerName(e.PlayerID), action, len(e.Moves))
+	}
+
+	if r.WinnerID != "" {
+		fmt.Printf("Winner: %s\n", r.PlayerName(r.WinnerID))
+	} else {
+		fmt.Println("No winner")
+	}
+	if r.OptimalMoves > 0 {
+		fmt.Printf("Optimal: %d moves\n", r.OptimalMoves)
+	}
+}
+
+// playEvent steps through a submission, printing the game after each move.
+func playEvent(p *model.MovePlayer) error {
+	for !p.Done() {
+		move, err := p.Step()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Move %d: %s\n", p.Played(), move)
+		fmt.Println(p.Game().String())
+	}
+	return nil
+}
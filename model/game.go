@@ -28,8 +28,8 @@ func NewBotPosition(id BotId, x, y BoardDim) BotPosition {
 
 func NewBotPositionFromProto(bpp *pb.BotPos) BotPosition {
 	return BotPosition{
-		Id:  BotId(bpp.Id),
-		Pos: NewPositionFromProto(bpp.Pos),
+		Id:  BotId(bpp.GetId()),
+		Pos: NewPositionFromProto(bpp.GetPos()),
 	}
 }
 
@@ -69,7 +69,7 @@ type Game struct {
 func NewGameFromProto(gp *pb.Game) *Game {
 	bots := make(map[BotId]Position)
 	for _, bot := range gp.Bots {
-		bots[BotId(bot.Id)] = NewPositionFromProto(bot.Pos)
+		bots[BotId(bot.GetId())] = NewPositionFromProto(bot.GetPos())
 	}
 	return &Game{
 		Board:  NewBoardFromProto(gp.Board),
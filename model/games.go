@@ -177,9 +177,16 @@ func mustBuildNewGame(board Board, bots map[BotId]Position, botTarget BotPositio
 // - Random target from possible target locations
 // - Random robot placement (avoiding each other, target, and center cells)
 func NewRandomGame() *Game {
+	return NewRandomGameFromSeed(rand.Int63())
+}
+
+// NewRandomGameFromSeed is like NewRandomGame, but the same seed always generates the same game.
+func NewRandomGameFromSeed(seed int64) *Game {
+	rng := rand.New(rand.NewSource(seed))
+
 	// Shuffle panels 1-4 into random positions
 	panels := []int{1, 2, 3, 4}
-	rand.Shuffle(len(panels), func(i, j int) {
+	rng.Shuffle(len(panels), func(i, j int) {
 		panels[i], panels[j] = panels[j], panels[i]
 	})
 	board := BuildBoard(panels[0], panels[1], panels[2], panels[3])
@@ -189,8 +196,8 @@ func NewRandomGame() *Game {
 	if len(possibleTargets) == 0 {
 		panic("board has no possible targets")
 	}
-	targetPos := possibleTargets[rand.Intn(len(possibleTargets))]
-	targetBotId := BotId(rand.Intn(4))
+	targetPos := possibleTargets[rng.Intn(len(possibleTargets))]
+	targetBotId := BotId(rng.Intn(4))
 	target := BotPosition{Id: targetBotId, Pos: targetPos}
 
 	// Place robots randomly, avoiding:
@@ -230,8 +237,8 @@ func NewRandomGame() *Game {
 		// Find a random unoccupied position
 		for {
 			pos := Position{
-				X: BoardDim(rand.Intn(int(size))),
-				Y: BoardDim(rand.Intn(int(size))),
+				X: BoardDim(rng.Intn(int(size))),
+				Y: BoardDim(rng.Intn(int(size))),
 			}
 			if !isOccupied(pos, bots) {
 				bots[botId] = pos
@@ -248,9 +255,16 @@ func NewRandomGame() *Game {
 // - Same robot positions (keeps robots where they ended up)
 // - New random target position and robot
 func NewContinuationGame(prev *Game) *Game {
+	return NewContinuationGameFromSeed(prev, rand.Int63())
+}
+
+// NewContinuationGameFromSeed is like NewContinuationGame, but the same previous
+// game and seed always generate the same game.
+func NewContinuationGameFromSeed(prev *Game, seed int64) *Game {
 	if prev == nil {
-		return NewRandomGame()
+		return NewRandomGameFromSeed(seed)
 	}
+	rng := rand.New(rand.NewSource(seed))
 
 	// Copy the bot positions
 	bots := make(map[BotId]Position)
@@ -285,8 +299,8 @@ func NewContinuationGame(prev *Game) *Game {
 		availableTargets = possibleTargets
 	}
 
-	targetPos := availableTargets[rand.Intn(len(availableTargets))]
-	targetBotId := BotId(rand.Intn(4))
+	targetPos := availableTargets[rng.Intn(len(availableTargets))]
+	targetBotId := BotId(rng.Intn(4))
 	target := BotPosition{Id: targetBotId, Pos: targetPos}
 
 	return mustBuildNewGame(prev.Board, bots, target)
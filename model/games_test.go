@@ -167,3 +167,17 @@ func TestBuildBoardFromPanels(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRandomGameFromSeed_IsDeterministic(t *testing.T) {
+	a := NewRandomGameFromSeed(42)
+	b := NewRandomGameFromSeed(42)
+	if !a.Equals(b) {
+		t.Errorf("expected the same game for the same seed, got\n%s\nand\n%s", a, b)
+	}
+
+	c := NewContinuationGameFromSeed(a, 7)
+	d := NewContinuationGameFromSeed(b, 7)
+	if !c.Equals(d) {
+		t.Errorf("expected the same continuation for the same seed, got\n%s\nand\n%s", c, d)
+	}
+}
@@ -14,8 +14,8 @@ type Position struct {
 
 func NewPositionFromProto(pp *pb.Position) Position {
 	return Position{
-		X: BoardDim(pp.X),
-		Y: BoardDim(pp.Y),
+		X: BoardDim(pp.GetX()),
+		Y: BoardDim(pp.GetY()),
 	}
 }
 
@@ -20,6 +20,23 @@ import (
 // |           B0 |
 // +----+----+----+
 func renderGame(board Board, bots map[BotId]Position, target *BotPosition) string {
+	return renderLabelledGame(board, bots, target, nil)
+}
+
+// RenderPath renders the game with each move's destination marked by its move
+// number and bot, e.g. " 3:1" where the third move, by bot 1, stops. Later moves
+// to the same cell hide earlier ones.
+func RenderPath(g *Game, moves []BotPosition) string {
+	labels := make(map[Position]string, len(moves))
+	for i, m := range moves {
+		labels[m.Pos] = fmt.Sprintf("%2d:%d", i+1, m.Id)
+	}
+	return renderLabelledGame(g.Board, g.Bots, &g.Target, labels)
+}
+
+// renderLabelledGame renders the game as renderGame does, with labels, which
+// must be 4 characters wide, replacing the contents of their cells.
+func renderLabelledGame(board Board, bots map[BotId]Position, target *BotPosition, labels map[Position]string) string {
 	renderHWall := func(x, y BoardDim) string {
 		if board.HasHWallAt(Position{x, y}) {
 			return "----"
@@ -44,6 +61,9 @@ func renderGame(board Board, bots map[BotId]Position, target *BotPosition) strin
 	}
 	renderCell := func(x, y BoardDim) string {
 		cellPos := Position{x, y}
+		if label, ok := labels[cellPos]; ok {
+			return label
+		}
 		hasBot, botId := hasBotAtPosition(bots, cellPos)
 		if hasBot {
 			return fmt.Sprintf(" B%v ", botId)
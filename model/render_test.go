@@ -292,3 +292,22 @@ func TestParseGameString(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderPath(t *testing.T) {
+	board := NewBoard(3, nil, nil)
+	game, _ := NewGame(board, map[BotId]Position{0: {0, 0}, 1: {1, 1}}, BotPosition{0, Position{2, 2}})
+	moves := []BotPosition{{0, Position{2, 0}}, {0, Position{2, 2}}}
+
+	want := dedentBoardString(`
+		+----+----+----+
+		| B0        1:0|
+		+    +    +    +
+		|      B1      |
+		+    +    +    +
+		|           2:0|
+		+----+----+----+
+		`)
+	if got := RenderPath(game, moves); got != want {
+		t.Errorf("RenderPath()\ngot:\n%v\n\nwant:\n%v", got, want)
+	}
+}
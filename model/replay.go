@@ -0,0 +1,205 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReplayFormatVersion is the replay format version written by ToProto and the newest ParseReplay reads.
+const ReplayFormatVersion = 1
+
+// Replay is a recorded game that can be shared and re-watched.
+// The file format is pb.Replay in its protobuf JSON encoding.
+type Replay struct {
+	RoomID       string
+	Game         *Game // Board, starting bot positions and target
+	Seed         int64 // Seed the game was generated from, 0 if unknown
+	StartedAt    time.Time
+	EndedAt      time.Time
+	Players      []ReplayPlayer
+	Events       []ReplayEvent // Submissions and retractions, oldest first
+	WinnerID     string        // Empty if nobody solved the game
+	OptimalMoves int           // Fewest moves that solve the game, 0 if unknown
+}
+
+// ReplayPlayer is a player in a replayed game.
+type ReplayPlayer struct {
+	ID   string
+	Name string
+}
+
+// ReplayEvent is a solution submitted or retracted during a replayed game.
+type ReplayEvent struct {
+	PlayerID string
+	At       time.Time
+	Retract  bool
+	Moves    []BotPosition
+}
+
+// PlayerName returns the name of the player with the given ID, or empty string if not found.
+func (r *Replay) PlayerName(playerID string) string {
+	for _, p := range r.Players {
+		if p.ID == playerID {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// Play returns a MovePlayer that steps through the moves of event i.
+func (r *Replay) Play(i int) *MovePlayer {
+	return NewMovePlayer(r.Game, r.Events[i].Moves)
+}
+
+// ToProto converts a Replay to its protobuf representation.
+func (r *Replay) ToProto() *pb.Replay {
+	players := make([]*pb.Player, len(r.Players))
+	for i, p := range r.Players {
+		players[i] = &pb.Player{Id: p.ID, Name: p.Name}
+	}
+
+	events := make([]*pb.ReplayEvent, len(r.Events))
+	for i, e := range r.Events {
+		moves := make([]*pb.BotPos, len(e.Moves))
+		for j, m := range e.Moves {
+			moves[j] = m.ToProto()
+		}
+		action := pb.ReplayEvent_ACTION_SUBMIT
+		if e.Retract {
+			action = pb.ReplayEvent_ACTION_RETRACT
+		}
+		events[i] = &pb.ReplayEvent{
+			PlayerId: e.PlayerID,
+			At:       timestamppb.New(e.At),
+			Action:   action,
+			Moves:    moves,
+		}
+	}
+
+	out := &pb.Replay{
+		FormatVersion:    ReplayFormatVersion,
+		RoomId:           r.RoomID,
+		Game:             r.Game.ToProto(),
+		Seed:             r.Seed,
+		EndedAt:          timestamppb.New(r.EndedAt),
+		Players:          players,
+		Events:           events,
+		WinnerId:         r.WinnerID,
+		OptimalMoveCount: int32(r.OptimalMoves),
+	}
+	if !r.StartedAt.IsZero() {
+		out.StartedAt = timestamppb.New(r.StartedAt)
+	}
+	return out
+}
+
+// NewReplayFromProto converts and validates a protobuf replay.
+func NewReplayFromProto(rp *pb.Replay) (*Replay, error) {
+	if rp.FormatVersion > ReplayFormatVersion {
+		return nil, fmt.Errorf("replay format version %d is newer than supported version %d", rp.FormatVersion, ReplayFormatVersion)
+	}
+	if rp.Game.GetBoard() == nil || rp.Game.GetTarget() == nil {
+		return nil, fmt.Errorf("replay has no game")
+	}
+	g := NewGameFromProto(rp.Game)
+	game, err := NewGame(g.Board, g.Bots, g.Target)
+	if err != nil {
+		return nil, fmt.Errorf("replay has an invalid game: %w", err)
+	}
+
+	r := &Replay{
+		RoomID:       rp.RoomId,
+		Game:         game,
+		Seed:         rp.Seed,
+		EndedAt:      rp.EndedAt.AsTime(),
+		WinnerID:     rp.WinnerId,
+		OptimalMoves: int(rp.OptimalMoveCount),
+	}
+	if rp.StartedAt != nil {
+		r.StartedAt = rp.StartedAt.AsTime()
+	}
+	for _, p := range rp.Players {
+		r.Players = append(r.Players, ReplayPlayer{ID: p.Id, Name: p.Name})
+	}
+	for _, e := range rp.Events {
+		if e.Action != pb.ReplayEvent_ACTION_SUBMIT && e.Action != pb.ReplayEvent_ACTION_RETRACT {
+			return nil, fmt.Errorf("replay event has unknown action %v", e.Action)
+		}
+		r.Events = append(r.Events, ReplayEvent{
+			PlayerID: e.PlayerId,
+			At:       e.At.AsTime(),
+			Retract:  e.Action == pb.ReplayEvent_ACTION_RETRACT,
+			Moves:    NewBotPositionsFromProto(e.Moves),
+		})
+	}
+	return r, nil
+}
+
+// MarshalReplay encodes a replay file.
+func MarshalReplay(r *Replay) ([]byte, error) {
+	return protojson.MarshalOptions{Multiline: true}.Marshal(r.ToProto())
+}
+
+// ParseReplay decodes a replay file. Files from a newer format version are refused.
+func ParseReplay(data []byte) (*Replay, error) {
+	var rp pb.Replay
+	if err := protojson.Unmarshal(data, &rp); err != nil {
+		return nil, fmt.Errorf("invalid replay: %w", err)
+	}
+	return NewReplayFromProto(&rp)
+}
+
+// MovePlayer steps forwards and backwards through a sequence of moves from a
+// starting game, checking each move with Game.MoveBot.
+type MovePlayer struct {
+	moves  []BotPosition
+	states []*Game // states[i] is the game after i moves
+}
+
+// NewMovePlayer creates a MovePlayer positioned before the first move.
+func NewMovePlayer(start *Game, moves []BotPosition) *MovePlayer {
+	return &MovePlayer{moves: moves, states: []*Game{start}}
+}
+
+// Game returns the game after the moves played so far.
+func (p *MovePlayer) Game() *Game {
+	return p.states[len(p.states)-1]
+}
+
+// Played returns the number of moves played so far.
+func (p *MovePlayer) Played() int {
+	return len(p.states) - 1
+}
+
+// Done reports whether every move has been played.
+func (p *MovePlayer) Done() bool {
+	return p.Played() == len(p.moves)
+}
+
+// Step plays the next move and returns it.
+// It returns an error if there are no moves left or the move is invalid.
+func (p *MovePlayer) Step() (BotPosition, error) {
+	if p.Done() {
+		return BotPosition{}, fmt.Errorf("no moves left")
+	}
+	move := p.moves[p.Played()]
+	next, err := p.Game().MoveBot(move.Id, move.Pos)
+	if err != nil {
+		return move, fmt.Errorf("move %d: %w", p.Played()+1, err)
+	}
+	p.states = append(p.states, next)
+	return move, nil
+}
+
+// Back undoes the last move played. It returns false if no moves have been played.
+func (p *MovePlayer) Back() bool {
+	if p.Played() == 0 {
+		return false
+	}
+	p.states = p.states[:len(p.states)-1]
+	return true
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
+func testReplay() *Replay {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	return &Replay{
+		RoomID:    "ROOM1",
+		Game:      Game1(),
+		Seed:      42,
+		StartedAt: start,
+		EndedAt:   start.Add(time.Minute),
+		Players:   []ReplayPlayer{{ID: "p1", Name: "Alice"}},
+		Events: []ReplayEvent{
+			{PlayerID: "p1", At: start.Add(10 * time.Second), Moves: Game1Solution()},
+			{PlayerID: "p1", At: start.Add(20 * time.Second), Retract: true, Moves: Game1Solution()},
+		},
+		OptimalMoves: 7,
+	}
+}
+
+func TestReplay_MarshalAndParse(t *testing.T) {
+	want := testReplay()
+
+	data, err := MarshalReplay(want)
+	if err != nil {
+		t.Fatalf("MarshalReplay failed: %v", err)
+	}
+	got, err := ParseReplay(data)
+	if err != nil {
+		t.Fatalf("ParseReplay failed: %v", err)
+	}
+
+	if !got.Game.Equals(want.Game) || got.Seed != want.Seed || got.RoomID != want.RoomID {
+		t.Errorf("expected game, seed and room to round trip, got %+v", got)
+	}
+	if !got.StartedAt.Equal(want.StartedAt) || !got.EndedAt.Equal(want.EndedAt) || got.OptimalMoves != 7 {
+		t.Errorf("expected times and optimal count to round trip, got %+v", got)
+	}
+	if got.PlayerName("p1") != "Alice" {
+		t.Errorf("expected player Alice, got %+v", got.Players)
+	}
+	if len(got.Events) != 2 || got.Events[0].Retract || !got.Events[1].Retract || len(got.Events[0].Moves) != 7 {
+		t.Errorf("expected a submission then a retraction, got %+v", got.Events)
+	}
+}
+
+func TestParseReplay_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"not json", "nope", "invalid replay"},
+		{"no game", `{"formatVersion": 1}`, "no game"},
+		{"newer version", `{"formatVersion": 99}`, "newer than supported"},
+		{"bad action", `{"formatVersion": 1, "game": {"board": {"size": 4}, "bots": [{"id": 0}], "target": {"id": 0}}, "events": [{"action": "ACTION_UNSPECIFIED"}]}`, "unknown action"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseReplay([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestReplay_ToProto_UsesFormatVersion(t *testing.T) {
+	p := testReplay().ToProto()
+	if p.FormatVersion != ReplayFormatVersion {
+		t.Errorf("expected format version %d, got %d", ReplayFormatVersion, p.FormatVersion)
+	}
+	if p.Events[1].Action != pb.ReplayEvent_ACTION_RETRACT {
+		t.Errorf("expected retract action, got %v", p.Events[1].Action)
+	}
+}
+
+func TestMovePlayer_StepsThroughSolution(t *testing.T) {
+	player := testReplay().Play(0)
+
+	for !player.Done() {
+		if _, err := player.Step(); err != nil {
+			t.Fatalf("Step failed: %v", err)
+		}
+	}
+	if player.Played() != 7 || !player.Game().IsWin() {
+		t.Errorf("expected a win after 7 moves, got %d moves played", player.Played())
+	}
+	if _, err := player.Step(); err == nil {
+		t.Error("expected error stepping past the last move")
+	}
+
+	// Stepping back restores earlier positions
+	for player.Back() {
+	}
+	if player.Played() != 0 || !player.Game().Equals(Game1()) {
+		t.Error("expected to be back at the starting game")
+	}
+}
+
+func TestMovePlayer_InvalidMove(t *testing.T) {
+	moves := []BotPosition{NewBotPosition(0, 15, 15)}
+	player := NewMovePlayer(Game1(), moves)
+
+	if _, err := player.Step(); err == nil {
+		t.Fatal("expected error for invalid move")
+	}
+	if player.Played() != 0 {
+		t.Errorf("expected invalid move not to be played, got %d played", player.Played())
+	}
+}
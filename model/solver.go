@@ -0,0 +1,273 @@
+package model
+
+import (
+	"maps"
+	"slices"
+)
+
+// directions lists the four move directions in the order the solver tries them.
+var directions = []Direction{Up, Down, Left, Right}
+
+// Solve finds a shortest solution of at most maxMoves moves with a breadth-first
+// search over robot positions. It gives up after visiting maxStates positions,
+// so ok is false both when no solution exists within maxMoves and when the
+// search was cut short. Moves use the same form as CheckSolution.
+func (g *Game) Solve(maxMoves, maxStates int) (moves []BotPosition, ok bool) {
+	if g.IsWin() {
+		return []BotPosition{}, true
+	}
+
+	s := newSolver(g)
+	if s == nil {
+		return nil, false
+	}
+
+	// parent records how each visited state was first reached
+	type step struct {
+		prev uint64
+		move BotPosition
+	}
+	start := s.key(s.start)
+	parents := map[uint64]step{start: {}}
+	level := [][]Position{s.start}
+
+	for depth := 1; depth <= maxMoves && len(level) > 0; depth++ {
+		var next [][]Position
+		for _, state := range level {
+			stateKey := s.key(state)
+			for i, id := range s.ids {
+				for _, dir := range directions {
+					dest := s.slide(state, i, dir)
+					if dest == state[i] {
+						continue
+					}
+					moved := slices.Clone(state)
+					moved[i] = dest
+					k := s.key(moved)
+					if _, seen := parents[k]; seen {
+						continue
+					}
+					parents[k] = step{prev: stateKey, move: BotPosition{Id: id, Pos: dest}}
+
+					if id == g.Target.Id && dest == g.Target.Pos {
+						// Walk back to the start to recover the moves
+						for k != start {
+							moves = append(moves, parents[k].move)
+							k = parents[k].prev
+						}
+						slices.Reverse(moves)
+						return moves, true
+					}
+					if len(parents) >= maxStates {
+						return nil, false
+					}
+					next = append(next, moved)
+				}
+			}
+		}
+		level = next
+	}
+	return nil, false
+}
+
+// SolveAll finds up to limit different shortest solutions of at most maxMoves
+// moves. It searches like Solve, but finishes the depth of the first solution,
+// remembering every way each position was first reached, so ok is false in the
+// same cases.
+func (g *Game) SolveAll(maxMoves, maxStates, limit int) (solutions [][]BotPosition, ok bool) {
+	if g.IsWin() {
+		return [][]BotPosition{{}}, true
+	}
+
+	s := newSolver(g)
+	if s == nil {
+		return nil, false
+	}
+
+	// parents records every move that reaches a state at its shallowest depth
+	type step struct {
+		prev uint64
+		move BotPosition
+	}
+	start := s.key(s.start)
+	depths := map[uint64]int{start: 0}
+	parents := make(map[uint64][]step)
+	var goals []uint64
+	level := [][]Position{s.start}
+
+	for depth := 1; depth <= maxMoves && len(level) > 0 && len(goals) == 0; depth++ {
+		var next [][]Position
+		for _, state := range level {
+			stateKey := s.key(state)
+			for i, id := range s.ids {
+				for _, dir := range directions {
+					dest := s.slide(state, i, dir)
+					if dest == state[i] {
+						continue
+					}
+					moved := slices.Clone(state)
+					moved[i] = dest
+					k := s.key(moved)
+					move := step{prev: stateKey, move: BotPosition{Id: id, Pos: dest}}
+					if d, seen := depths[k]; seen {
+						if d == depth {
+							parents[k] = append(parents[k], move)
+						}
+						continue
+					}
+					depths[k] = depth
+					parents[k] = []step{move}
+
+					if id == g.Target.Id && dest == g.Target.Pos {
+						goals = append(goals, k)
+						continue
+					}
+					if len(depths) >= maxStates {
+						return nil, false
+					}
+					next = append(next, moved)
+				}
+			}
+		}
+		level = next
+	}
+	if len(goals) == 0 {
+		return nil, false
+	}
+
+	// Walk back from each goal along every parent to recover the moves
+	var walk func(k uint64, moves []BotPosition)
+	walk = func(k uint64, moves []BotPosition) {
+		if len(solutions) >= limit {
+			return
+		}
+		if k == start {
+			solution := slices.Clone(moves)
+			slices.Reverse(solution)
+			solutions = append(solutions, solution)
+			return
+		}
+		for _, p := range parents[k] {
+			walk(p.prev, append(moves[:len(moves):len(moves)], p.move))
+		}
+	}
+	for _, k := range goals {
+		walk(k, nil)
+	}
+	return solutions, true
+}
+
+// solver holds the precomputed tables for one game's search.
+type solver struct {
+	game  *Game
+	ids   []BotId
+	start []Position
+	// stops[dir][cell] is where a bot at cell stops moving in dir on an empty board
+	stops map[Direction][]Position
+}
+
+// newSolver prepares a search, or returns nil if the game is too large to
+// pack its state into a key (more than 8 bots or 256 cells).
+func newSolver(g *Game) *solver {
+	size := int(g.Board.Size())
+	if len(g.Bots) > 8 || size*size > 256 {
+		return nil
+	}
+
+	s := &solver{game: g, ids: slices.Sorted(maps.Keys(g.Bots)), stops: make(map[Direction][]Position)}
+	for _, id := range s.ids {
+		s.start = append(s.start, g.Bots[id])
+	}
+
+	empty := &Game{Board: g.Board}
+	for _, dir := range directions {
+		stops := make([]Position, size*size)
+		for x := 0; x < size; x++ {
+			for y := 0; y < size; y++ {
+				pos := Position{X: BoardDim(x), Y: BoardDim(y)}
+				for !empty.hasWallBlocking(pos, dir) {
+					pos = step(pos, dir)
+				}
+				stops[x*size+y] = pos
+			}
+		}
+		s.stops[dir] = stops
+	}
+	return s
+}
+
+// key packs the bot positions into a single value for the visited set.
+func (s *solver) key(state []Position) uint64 {
+	size := uint64(s.game.Board.Size())
+	var k uint64
+	for _, pos := range state {
+		k = k<<8 | (uint64(pos.X)*size + uint64(pos.Y))
+	}
+	return k
+}
+
+// slide returns where bot i stops moving in dir, blocked by walls and other bots.
+func (s *solver) slide(state []Position, i int, dir Direction) Position {
+	from := state[i]
+	to := s.stops[dir][int(from.X)*int(s.game.Board.Size())+int(from.Y)]
+
+	// Stop short of the nearest bot between from and the wall
+	for j, other := range state {
+		if j == i || !between(from, other, to) {
+			continue
+		}
+		if before := back(other, dir); distance(from, before) < distance(from, to) {
+			to = before
+		}
+	}
+	return to
+}
+
+// between reports whether p lies on the segment after from up to and including to.
+func between(from, p, to Position) bool {
+	if from.X == to.X && p.X == from.X {
+		return p.Y != from.Y && (int(p.Y)-int(from.Y))*(int(to.Y)-int(p.Y)) >= 0
+	}
+	if from.Y == to.Y && p.Y == from.Y {
+		return p.X != from.X && (int(p.X)-int(from.X))*(int(to.X)-int(p.X)) >= 0
+	}
+	return false
+}
+
+// distance is the number of cells between two positions in a line.
+func distance(a, b Position) int {
+	d := int(a.X-b.X) + int(a.Y-b.Y)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// step returns the neighbouring position in dir.
+func step(pos Position, dir Direction) Position {
+	switch dir {
+	case Up:
+		pos.Y--
+	case Down:
+		pos.Y++
+	case Left:
+		pos.X--
+	case Right:
+		pos.X++
+	}
+	return pos
+}
+
+// back returns the neighbouring position opposite dir.
+func back(pos Position, dir Direction) Position {
+	switch dir {
+	case Up:
+		return step(pos, Down)
+	case Down:
+		return step(pos, Up)
+	case Left:
+		return step(pos, Right)
+	default:
+		return step(pos, Left)
+	}
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSolve_Game1(t *testing.T) {
+	game := Game1()
+
+	moves, ok := game.Solve(len(Game1Solution()), 1_000_000)
+	if !ok {
+		t.Fatal("expected a solution no longer than the known one")
+	}
+	if len(moves) > len(Game1Solution()) {
+		t.Errorf("expected at most %d moves, got %d", len(Game1Solution()), len(moves))
+	}
+	if valid, _ := game.CheckSolution(moves); !valid {
+		t.Errorf("solver returned an invalid solution: %v", moves)
+	}
+}
+
+func TestSolve_AlreadyWon(t *testing.T) {
+	board := NewBoard(3, nil, nil)
+	game, _ := NewGame(board, map[BotId]Position{0: {1, 1}}, BotPosition{0, Position{1, 1}})
+
+	moves, ok := game.Solve(5, 100)
+	if !ok || len(moves) != 0 {
+		t.Errorf("expected empty solution, got %v (ok=%v)", moves, ok)
+	}
+}
+
+func TestSolve_UsesBlockingBot(t *testing.T) {
+	// On an empty board bot 0 can only reach (1, 0) by stopping against bot 1:
+	// bot 1 up to (0, 0), then bot 0 left.
+	board := NewBoard(4, nil, nil)
+	bots := map[BotId]Position{0: {3, 0}, 1: {0, 3}}
+	game, _ := NewGame(board, bots, BotPosition{0, Position{1, 0}})
+
+	moves, ok := game.Solve(5, 1000)
+	if !ok || len(moves) != 2 {
+		t.Fatalf("expected a 2-move solution, got %v (ok=%v)", moves, ok)
+	}
+	if valid, _ := game.CheckSolution(moves); !valid {
+		t.Errorf("solver returned an invalid solution: %v", moves)
+	}
+}
+
+func TestSolve_RespectsLimits(t *testing.T) {
+	game := Game1()
+
+	if _, ok := game.Solve(1, 1_000_000); ok {
+		t.Error("expected no 1-move solution")
+	}
+	if _, ok := game.Solve(len(Game1Solution()), 10); ok {
+		t.Error("expected search to give up after 10 states")
+	}
+}
+
+func TestSolveAll_FindsEveryShortestSolution(t *testing.T) {
+	// Bot 0 reaches the far corner going right then down, or down then right
+	board := NewBoard(4, nil, nil)
+	game, _ := NewGame(board, map[BotId]Position{0: {0, 0}}, BotPosition{0, Position{3, 3}})
+
+	solutions, ok := game.SolveAll(5, 1000, 10)
+	if !ok || len(solutions) != 2 {
+		t.Fatalf("expected 2 solutions, got %v (ok=%v)", solutions, ok)
+	}
+	if slices.Equal(solutions[0], solutions[1]) {
+		t.Errorf("expected different solutions, got %v", solutions)
+	}
+	for _, moves := range solutions {
+		if valid, _ := game.CheckSolution(moves); !valid || len(moves) != 2 {
+			t.Errorf("expected a valid 2-move solution, got %v", moves)
+		}
+	}
+
+	if solutions, _ := game.SolveAll(5, 1000, 1); len(solutions) != 1 {
+		t.Errorf("expected limit to cap solutions, got %v", solutions)
+	}
+}
+
+func TestSolveAll_MatchesSolve(t *testing.T) {
+	game := Game1()
+
+	want, _ := game.Solve(len(Game1Solution()), 1_000_000)
+	solutions, ok := game.SolveAll(len(Game1Solution()), 1_000_000, 5)
+	if !ok || len(solutions) == 0 {
+		t.Fatal("expected solutions no longer than the known one")
+	}
+	for _, moves := range solutions {
+		if len(moves) != len(want) {
+			t.Errorf("expected %d moves, got %v", len(want), moves)
+		}
+		if valid, _ := game.CheckSolution(moves); !valid {
+			t.Errorf("solver returned an invalid solution: %v", moves)
+		}
+	}
+	if _, ok := game.SolveAll(1, 1_000_000, 5); ok {
+		t.Error("expected no 1-move solution")
+	}
+}
+
+// Every solution found for random games must pass CheckSolution.
+func TestSolve_RandomGamesAreValid(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		game := NewRandomGame()
+		moves, ok := game.Solve(4, 200_000)
+		if !ok {
+			continue
+		}
+		if valid, _ := game.CheckSolution(moves); !valid {
+			t.Errorf("game %d: invalid solution %v for\n%s", i, moves, game)
+		}
+	}
+}
+
+// The solver's precomputed slides must match ComputeDestination.
+func TestSolver_SlideMatchesComputeDestination(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		game := NewRandomGame()
+		s := newSolver(game)
+		for idx, id := range s.ids {
+			for _, dir := range directions {
+				want, _ := game.ComputeDestination(id, dir)
+				if got := s.slide(s.start, idx, dir); got != want {
+					t.Errorf("bot %d %s: expected %v, got %v in\n%s", id, dir, want, got, game)
+				}
+			}
+		}
+	}
+}
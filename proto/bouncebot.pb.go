@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,208 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Time window that player statistics cover
+type StatsWindow int32
+
+const (
+	StatsWindow_STATS_WINDOW_ALL_TIME StatsWindow = 0
+	StatsWindow_STATS_WINDOW_DAILY    StatsWindow = 1 // the last 24 hours
+	StatsWindow_STATS_WINDOW_WEEKLY   StatsWindow = 2 // the last 7 days
+)
+
+// Enum value maps for StatsWindow.
+var (
+	StatsWindow_name = map[int32]string{
+		0: "STATS_WINDOW_ALL_TIME",
+		1: "STATS_WINDOW_DAILY",
+		2: "STATS_WINDOW_WEEKLY",
+	}
+	StatsWindow_value = map[string]int32{
+		"STATS_WINDOW_ALL_TIME": 0,
+		"STATS_WINDOW_DAILY":    1,
+		"STATS_WINDOW_WEEKLY":   2,
+	}
+)
+
+func (x StatsWindow) Enum() *StatsWindow {
+	p := new(StatsWindow)
+	*p = x
+	return p
+}
+
+func (x StatsWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_bouncebot_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsWindow) Type() protoreflect.EnumType {
+	return &file_bouncebot_proto_enumTypes[0]
+}
+
+func (x StatsWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsWindow.Descriptor instead.
+func (StatsWindow) EnumDescriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{0}
+}
+
+// Leaderboard sort order; ties go to more wins, then more rounds played
+type LeaderboardOrder int32
+
+const (
+	LeaderboardOrder_LEADERBOARD_ORDER_WINS            LeaderboardOrder = 0
+	LeaderboardOrder_LEADERBOARD_ORDER_SOLVE_RATE      LeaderboardOrder = 1
+	LeaderboardOrder_LEADERBOARD_ORDER_FASTEST_SOLVE   LeaderboardOrder = 2
+	LeaderboardOrder_LEADERBOARD_ORDER_BEST_WIN_STREAK LeaderboardOrder = 3
+)
+
+// Enum value maps for LeaderboardOrder.
+var (
+	LeaderboardOrder_name = map[int32]string{
+		0: "LEADERBOARD_ORDER_WINS",
+		1: "LEADERBOARD_ORDER_SOLVE_RATE",
+		2: "LEADERBOARD_ORDER_FASTEST_SOLVE",
+		3: "LEADERBOARD_ORDER_BEST_WIN_STREAK",
+	}
+	LeaderboardOrder_value = map[string]int32{
+		"LEADERBOARD_ORDER_WINS":            0,
+		"LEADERBOARD_ORDER_SOLVE_RATE":      1,
+		"LEADERBOARD_ORDER_FASTEST_SOLVE":   2,
+		"LEADERBOARD_ORDER_BEST_WIN_STREAK": 3,
+	}
+)
+
+func (x LeaderboardOrder) Enum() *LeaderboardOrder {
+	p := new(LeaderboardOrder)
+	*p = x
+	return p
+}
+
+func (x LeaderboardOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_bouncebot_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardOrder) Type() protoreflect.EnumType {
+	return &file_bouncebot_proto_enumTypes[1]
+}
+
+func (x LeaderboardOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardOrder.Descriptor instead.
+func (LeaderboardOrder) EnumDescriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{1}
+}
+
+// Whether archive puzzles need bots other than the target bot to move
+type HelperFilter int32
+
+const (
+	HelperFilter_HELPER_FILTER_ANY          HelperFilter = 0
+	HelperFilter_HELPER_FILTER_REQUIRED     HelperFilter = 1
+	HelperFilter_HELPER_FILTER_NOT_REQUIRED HelperFilter = 2
+)
+
+// Enum value maps for HelperFilter.
+var (
+	HelperFilter_name = map[int32]string{
+		0: "HELPER_FILTER_ANY",
+		1: "HELPER_FILTER_REQUIRED",
+		2: "HELPER_FILTER_NOT_REQUIRED",
+	}
+	HelperFilter_value = map[string]int32{
+		"HELPER_FILTER_ANY":          0,
+		"HELPER_FILTER_REQUIRED":     1,
+		"HELPER_FILTER_NOT_REQUIRED": 2,
+	}
+)
+
+func (x HelperFilter) Enum() *HelperFilter {
+	p := new(HelperFilter)
+	*p = x
+	return p
+}
+
+func (x HelperFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HelperFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_bouncebot_proto_enumTypes[2].Descriptor()
+}
+
+func (HelperFilter) Type() protoreflect.EnumType {
+	return &file_bouncebot_proto_enumTypes[2]
+}
+
+func (x HelperFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HelperFilter.Descriptor instead.
+func (HelperFilter) EnumDescriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{2}
+}
+
+type ReplayEvent_Action int32
+
+const (
+	ReplayEvent_ACTION_UNSPECIFIED ReplayEvent_Action = 0
+	ReplayEvent_ACTION_SUBMIT      ReplayEvent_Action = 1
+	ReplayEvent_ACTION_RETRACT     ReplayEvent_Action = 2
+)
+
+// Enum value maps for ReplayEvent_Action.
+var (
+	ReplayEvent_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_SUBMIT",
+		2: "ACTION_RETRACT",
+	}
+	ReplayEvent_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_SUBMIT":      1,
+		"ACTION_RETRACT":     2,
+	}
+)
+
+func (x ReplayEvent_Action) Enum() *ReplayEvent_Action {
+	p := new(ReplayEvent_Action)
+	*p = x
+	return p
+}
+
+func (x ReplayEvent_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplayEvent_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_bouncebot_proto_enumTypes[3].Descriptor()
+}
+
+func (ReplayEvent_Action) Type() protoreflect.EnumType {
+	return &file_bouncebot_proto_enumTypes[3]
+}
+
+func (x ReplayEvent_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplayEvent_Action.Descriptor instead.
+func (ReplayEvent_Action) EnumDescriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{49, 0}
+}
+
 // Board grid position.
 // This refers to a board position relative to the upper-left board cell.
 // For robots, this is the cell the robot sits on.
@@ -259,6 +462,10 @@ type Player struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // empty for guests
+	Bot           string                 `protobuf:"bytes,4,opt,name=bot,proto3" json:"bot,omitempty"`                              // level of a computer opponent (easy, medium, hard), empty for people
+	Team          string                 `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`                            // empty if not on a team
+	Handicap      *Handicap              `protobuf:"bytes,6,opt,name=handicap,proto3" json:"handicap,omitempty"`                    // unset if the player has none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -307,19 +514,155 @@ func (x *Player) GetName() string {
 	return ""
 }
 
+func (x *Player) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Player) GetBot() string {
+	if x != nil {
+		return x.Bot
+	}
+	return ""
+}
+
+func (x *Player) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *Player) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+// Evens out games between players of different skill when choosing the winner
+type Handicap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExtraMoves    int32                  `protobuf:"varint,1,opt,name=extra_moves,json=extraMoves,proto3" json:"extra_moves,omitempty"` // moves taken off the player's solutions
+	Delay         *durationpb.Duration   `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`                              // added to the player's solve times
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Handicap) Reset() {
+	*x = Handicap{}
+	mi := &file_bouncebot_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handicap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handicap) ProtoMessage() {}
+
+func (x *Handicap) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handicap.ProtoReflect.Descriptor instead.
+func (*Handicap) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Handicap) GetExtraMoves() int32 {
+	if x != nil {
+		return x.ExtraMoves
+	}
+	return 0
+}
+
+func (x *Handicap) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+// Spectator watching a room without playing
+type Spectator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spectator) Reset() {
+	*x = Spectator{}
+	mi := &file_bouncebot_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spectator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spectator) ProtoMessage() {}
+
+func (x *Spectator) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spectator.ProtoReflect.Descriptor instead.
+func (*Spectator) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Spectator) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Spectator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // Player's solution result
 type PlayerSolution struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	SolvedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=solved_at,json=solvedAt,proto3" json:"solved_at,omitempty"`
 	Moves         []*BotPos              `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	Hints         int32                  `protobuf:"varint,4,opt,name=hints,proto3" json:"hints,omitempty"`      // hint tier the player had reached when submitting (see Hint)
+	Handicap      *Handicap              `protobuf:"bytes,5,opt,name=handicap,proto3" json:"handicap,omitempty"` // the player's handicap when submitting; unset if none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerSolution) Reset() {
 	*x = PlayerSolution{}
-	mi := &file_bouncebot_proto_msgTypes[5]
+	mi := &file_bouncebot_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +674,7 @@ func (x *PlayerSolution) String() string {
 func (*PlayerSolution) ProtoMessage() {}
 
 func (x *PlayerSolution) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[5]
+	mi := &file_bouncebot_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +687,7 @@ func (x *PlayerSolution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerSolution.ProtoReflect.Descriptor instead.
 func (*PlayerSolution) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{5}
+	return file_bouncebot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PlayerSolution) GetPlayerId() string {
@@ -368,6 +711,20 @@ func (x *PlayerSolution) GetMoves() []*BotPos {
 	return nil
 }
 
+func (x *PlayerSolution) GetHints() int32 {
+	if x != nil {
+		return x.Hints
+	}
+	return 0
+}
+
+func (x *PlayerSolution) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
 // Player's cumulative score in the room
 type PlayerScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -379,7 +736,7 @@ type PlayerScore struct {
 
 func (x *PlayerScore) Reset() {
 	*x = PlayerScore{}
-	mi := &file_bouncebot_proto_msgTypes[6]
+	mi := &file_bouncebot_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +748,7 @@ func (x *PlayerScore) String() string {
 func (*PlayerScore) ProtoMessage() {}
 
 func (x *PlayerScore) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[6]
+	mi := &file_bouncebot_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +761,7 @@ func (x *PlayerScore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerScore.ProtoReflect.Descriptor instead.
 func (*PlayerScore) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{6}
+	return file_bouncebot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PlayerScore) GetPlayerId() string {
@@ -421,6 +778,58 @@ func (x *PlayerScore) GetWins() int32 {
 	return 0
 }
 
+type TeamScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          string                 `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Wins          int32                  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"` // games won by any member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScore) Reset() {
+	*x = TeamScore{}
+	mi := &file_bouncebot_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScore) ProtoMessage() {}
+
+func (x *TeamScore) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScore.ProtoReflect.Descriptor instead.
+func (*TeamScore) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TeamScore) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TeamScore) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
 // Game room for multiplayer
 type Room struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -434,13 +843,17 @@ type Room struct {
 	GamesPlayed     int32                  `protobuf:"varint,8,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`            // total games completed in room
 	FinishedSolving []string               `protobuf:"bytes,9,rep,name=finished_solving,json=finishedSolving,proto3" json:"finished_solving,omitempty"` // player IDs who are finished solving (triggers game end)
 	ReadyForNext    []string               `protobuf:"bytes,10,rep,name=ready_for_next,json=readyForNext,proto3" json:"ready_for_next,omitempty"`       // player IDs who are ready for next game
+	Spectators      []*Spectator           `protobuf:"bytes,11,rep,name=spectators,proto3" json:"spectators,omitempty"`                                 // spectators watching the room (not players)
+	TeamScores      []*TeamScore           `protobuf:"bytes,12,rep,name=team_scores,json=teamScores,proto3" json:"team_scores,omitempty"`               // teams players are on or that have won, by name
+	TeamQuorum      bool                   `protobuf:"varint,13,opt,name=team_quorum,json=teamQuorum,proto3" json:"team_quorum,omitempty"`              // a team counts as finished or ready once any member is
+	Match           *Match                 `protobuf:"bytes,14,opt,name=match,proto3" json:"match,omitempty"`                                           // current or last match, unset if the room has never played one
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Room) Reset() {
 	*x = Room{}
-	mi := &file_bouncebot_proto_msgTypes[7]
+	mi := &file_bouncebot_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +865,7 @@ func (x *Room) String() string {
 func (*Room) ProtoMessage() {}
 
 func (x *Room) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[7]
+	mi := &file_bouncebot_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +878,7 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Room.ProtoReflect.Descriptor instead.
 func (*Room) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{7}
+	return file_bouncebot_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Room) GetId() string {
@@ -538,16 +951,207 @@ func (x *Room) GetReadyForNext() []string {
 	return nil
 }
 
-type CreateRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+func (x *Room) GetSpectators() []*Spectator {
+	if x != nil {
+		return x.Spectators
+	}
+	return nil
+}
+
+func (x *Room) GetTeamScores() []*TeamScore {
+	if x != nil {
+		return x.TeamScores
+	}
+	return nil
+}
+
+func (x *Room) GetTeamQuorum() bool {
+	if x != nil {
+		return x.TeamQuorum
+	}
+	return false
+}
+
+func (x *Room) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// Series of games with its own standings, ending after a number of rounds or
+// once a player reaches a number of wins.
+type Match struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rounds        int32                  `protobuf:"varint,1,opt,name=rounds,proto3" json:"rounds,omitempty"`                  // games in the match, 0 for no limit
+	FirstTo       int32                  `protobuf:"varint,2,opt,name=first_to,json=firstTo,proto3" json:"first_to,omitempty"` // wins that take the match, 0 for no limit
+	Seed          int64                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`                      // seeds every game of the match, 0 if unseeded
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"` // unset while the match is in progress
+	GamesPlayed   int32                  `protobuf:"varint,6,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Standings     []*MatchStanding       `protobuf:"bytes,7,rep,name=standings,proto3" json:"standings,omitempty"`                     // most wins first
+	ChampionId    string                 `protobuf:"bytes,8,opt,name=champion_id,json=championId,proto3" json:"champion_id,omitempty"` // empty until decided, or if tied or stopped early
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_bouncebot_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Match) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *Match) GetFirstTo() int32 {
+	if x != nil {
+		return x.FirstTo
+	}
+	return 0
+}
+
+func (x *Match) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Match) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Match) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *Match) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *Match) GetStandings() []*MatchStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *Match) GetChampionId() string {
+	if x != nil {
+		return x.ChampionId
+	}
+	return ""
+}
+
+type MatchStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"` // empty if the player has left
+	Wins          int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchStanding) Reset() {
+	*x = MatchStanding{}
+	mi := &file_bouncebot_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchStanding) ProtoMessage() {}
+
+func (x *MatchStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchStanding.ProtoReflect.Descriptor instead.
+func (*MatchStanding) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MatchStanding) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MatchStanding) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *MatchStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`       // defaults to the account name when signed in
+	AccountToken  string                 `protobuf:"bytes,2,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // claim token of the player's account, empty to play as a guest
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateRoomRequest) Reset() {
 	*x = CreateRoomRequest{}
-	mi := &file_bouncebot_proto_msgTypes[8]
+	mi := &file_bouncebot_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +1163,7 @@ func (x *CreateRoomRequest) String() string {
 func (*CreateRoomRequest) ProtoMessage() {}
 
 func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[8]
+	mi := &file_bouncebot_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +1176,7 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{8}
+	return file_bouncebot_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateRoomRequest) GetPlayerName() string {
@@ -582,17 +1186,25 @@ func (x *CreateRoomRequest) GetPlayerName() string {
 	return ""
 }
 
+func (x *CreateRoomRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
 type JoinRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`       // defaults to the account name when signed in
+	AccountToken  string                 `protobuf:"bytes,3,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // claim token of the player's account, empty to play as a guest
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinRoomRequest) Reset() {
 	*x = JoinRoomRequest{}
-	mi := &file_bouncebot_proto_msgTypes[9]
+	mi := &file_bouncebot_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +1216,7 @@ func (x *JoinRoomRequest) String() string {
 func (*JoinRoomRequest) ProtoMessage() {}
 
 func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[9]
+	mi := &file_bouncebot_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +1229,7 @@ func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
 func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{9}
+	return file_bouncebot_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *JoinRoomRequest) GetRoomId() string {
@@ -634,6 +1246,13 @@ func (x *JoinRoomRequest) GetPlayerName() string {
 	return ""
 }
 
+func (x *JoinRoomRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
 type GetRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
@@ -643,7 +1262,7 @@ type GetRoomRequest struct {
 
 func (x *GetRoomRequest) Reset() {
 	*x = GetRoomRequest{}
-	mi := &file_bouncebot_proto_msgTypes[10]
+	mi := &file_bouncebot_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +1274,7 @@ func (x *GetRoomRequest) String() string {
 func (*GetRoomRequest) ProtoMessage() {}
 
 func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[10]
+	mi := &file_bouncebot_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +1287,7 @@ func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
 func (*GetRoomRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{10}
+	return file_bouncebot_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetRoomRequest) GetRoomId() string {
@@ -679,15 +1298,16 @@ func (x *GetRoomRequest) GetRoomId() string {
 }
 
 type StartGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RoomId          string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ArchivePuzzleId string                 `protobuf:"bytes,2,opt,name=archive_puzzle_id,json=archivePuzzleId,proto3" json:"archive_puzzle_id,omitempty"` // optional; play this archive puzzle instead of a generated game
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *StartGameRequest) Reset() {
 	*x = StartGameRequest{}
-	mi := &file_bouncebot_proto_msgTypes[11]
+	mi := &file_bouncebot_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +1319,7 @@ func (x *StartGameRequest) String() string {
 func (*StartGameRequest) ProtoMessage() {}
 
 func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[11]
+	mi := &file_bouncebot_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +1332,7 @@ func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
 func (*StartGameRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{11}
+	return file_bouncebot_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StartGameRequest) GetRoomId() string {
@@ -722,6 +1342,13 @@ func (x *StartGameRequest) GetRoomId() string {
 	return ""
 }
 
+func (x *StartGameRequest) GetArchivePuzzleId() string {
+	if x != nil {
+		return x.ArchivePuzzleId
+	}
+	return ""
+}
+
 type SubmitSolutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
@@ -733,7 +1360,7 @@ type SubmitSolutionRequest struct {
 
 func (x *SubmitSolutionRequest) Reset() {
 	*x = SubmitSolutionRequest{}
-	mi := &file_bouncebot_proto_msgTypes[12]
+	mi := &file_bouncebot_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +1372,7 @@ func (x *SubmitSolutionRequest) String() string {
 func (*SubmitSolutionRequest) ProtoMessage() {}
 
 func (x *SubmitSolutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[12]
+	mi := &file_bouncebot_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +1385,7 @@ func (x *SubmitSolutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitSolutionRequest.ProtoReflect.Descriptor instead.
 func (*SubmitSolutionRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{12}
+	return file_bouncebot_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SubmitSolutionRequest) GetRoomId() string {
@@ -791,7 +1418,7 @@ type SubmitSolutionResponse struct {
 
 func (x *SubmitSolutionResponse) Reset() {
 	*x = SubmitSolutionResponse{}
-	mi := &file_bouncebot_proto_msgTypes[13]
+	mi := &file_bouncebot_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -803,7 +1430,7 @@ func (x *SubmitSolutionResponse) String() string {
 func (*SubmitSolutionResponse) ProtoMessage() {}
 
 func (x *SubmitSolutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[13]
+	mi := &file_bouncebot_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +1443,7 @@ func (x *SubmitSolutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitSolutionResponse.ProtoReflect.Descriptor instead.
 func (*SubmitSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{13}
+	return file_bouncebot_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SubmitSolutionResponse) GetSolution() *PlayerSolution {
@@ -836,7 +1463,7 @@ type RetractSolutionRequest struct {
 
 func (x *RetractSolutionRequest) Reset() {
 	*x = RetractSolutionRequest{}
-	mi := &file_bouncebot_proto_msgTypes[14]
+	mi := &file_bouncebot_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -848,7 +1475,7 @@ func (x *RetractSolutionRequest) String() string {
 func (*RetractSolutionRequest) ProtoMessage() {}
 
 func (x *RetractSolutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[14]
+	mi := &file_bouncebot_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,7 +1488,7 @@ func (x *RetractSolutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetractSolutionRequest.ProtoReflect.Descriptor instead.
 func (*RetractSolutionRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{14}
+	return file_bouncebot_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RetractSolutionRequest) GetRoomId() string {
@@ -887,7 +1514,7 @@ type RetractSolutionResponse struct {
 
 func (x *RetractSolutionResponse) Reset() {
 	*x = RetractSolutionResponse{}
-	mi := &file_bouncebot_proto_msgTypes[15]
+	mi := &file_bouncebot_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +1526,7 @@ func (x *RetractSolutionResponse) String() string {
 func (*RetractSolutionResponse) ProtoMessage() {}
 
 func (x *RetractSolutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[15]
+	mi := &file_bouncebot_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1539,7 @@ func (x *RetractSolutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetractSolutionResponse.ProtoReflect.Descriptor instead.
 func (*RetractSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{15}
+	return file_bouncebot_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RetractSolutionResponse) GetSuccess() bool {
@@ -932,7 +1559,7 @@ type MarkFinishedSolvingRequest struct {
 
 func (x *MarkFinishedSolvingRequest) Reset() {
 	*x = MarkFinishedSolvingRequest{}
-	mi := &file_bouncebot_proto_msgTypes[16]
+	mi := &file_bouncebot_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -944,7 +1571,7 @@ func (x *MarkFinishedSolvingRequest) String() string {
 func (*MarkFinishedSolvingRequest) ProtoMessage() {}
 
 func (x *MarkFinishedSolvingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[16]
+	mi := &file_bouncebot_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +1584,7 @@ func (x *MarkFinishedSolvingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkFinishedSolvingRequest.ProtoReflect.Descriptor instead.
 func (*MarkFinishedSolvingRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{16}
+	return file_bouncebot_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MarkFinishedSolvingRequest) GetRoomId() string {
@@ -983,7 +1610,7 @@ type MarkFinishedSolvingResponse struct {
 
 func (x *MarkFinishedSolvingResponse) Reset() {
 	*x = MarkFinishedSolvingResponse{}
-	mi := &file_bouncebot_proto_msgTypes[17]
+	mi := &file_bouncebot_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -995,7 +1622,7 @@ func (x *MarkFinishedSolvingResponse) String() string {
 func (*MarkFinishedSolvingResponse) ProtoMessage() {}
 
 func (x *MarkFinishedSolvingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[17]
+	mi := &file_bouncebot_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,7 +1635,7 @@ func (x *MarkFinishedSolvingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkFinishedSolvingResponse.ProtoReflect.Descriptor instead.
 func (*MarkFinishedSolvingResponse) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{17}
+	return file_bouncebot_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MarkFinishedSolvingResponse) GetSuccess() bool {
@@ -1028,7 +1655,7 @@ type MarkReadyForNextRequest struct {
 
 func (x *MarkReadyForNextRequest) Reset() {
 	*x = MarkReadyForNextRequest{}
-	mi := &file_bouncebot_proto_msgTypes[18]
+	mi := &file_bouncebot_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1040,7 +1667,7 @@ func (x *MarkReadyForNextRequest) String() string {
 func (*MarkReadyForNextRequest) ProtoMessage() {}
 
 func (x *MarkReadyForNextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[18]
+	mi := &file_bouncebot_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +1680,7 @@ func (x *MarkReadyForNextRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkReadyForNextRequest.ProtoReflect.Descriptor instead.
 func (*MarkReadyForNextRequest) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{18}
+	return file_bouncebot_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MarkReadyForNextRequest) GetRoomId() string {
@@ -1079,7 +1706,7 @@ type MarkReadyForNextResponse struct {
 
 func (x *MarkReadyForNextResponse) Reset() {
 	*x = MarkReadyForNextResponse{}
-	mi := &file_bouncebot_proto_msgTypes[19]
+	mi := &file_bouncebot_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1091,7 +1718,7 @@ func (x *MarkReadyForNextResponse) String() string {
 func (*MarkReadyForNextResponse) ProtoMessage() {}
 
 func (x *MarkReadyForNextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bouncebot_proto_msgTypes[19]
+	mi := &file_bouncebot_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +1731,7 @@ func (x *MarkReadyForNextResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkReadyForNextResponse.ProtoReflect.Descriptor instead.
 func (*MarkReadyForNextResponse) Descriptor() ([]byte, []int) {
-	return file_bouncebot_proto_rawDescGZIP(), []int{19}
+	return file_bouncebot_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MarkReadyForNextResponse) GetSuccess() bool {
@@ -1114,59 +1741,5377 @@ func (x *MarkReadyForNextResponse) GetSuccess() bool {
 	return false
 }
 
-var File_bouncebot_proto protoreflect.FileDescriptor
+type AddBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"` // easy, medium or hard; defaults to medium
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_bouncebot_proto_rawDesc = "" +
-	"\n" +
-	"\x0fbouncebot.proto\x12\tbouncebot\x1a\x1fgoogle/protobuf/timestamp.proto\"&\n" +
-	"\bPosition\x12\f\n" +
-	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
-	"\x01y\x18\x02 \x01(\x05R\x01y\"w\n" +
-	"\x05Board\x12\x12\n" +
-	"\x04size\x18\x01 \x01(\x05R\x04size\x12,\n" +
-	"\av_walls\x18\x02 \x03(\v2\x13.bouncebot.PositionR\x06vWalls\x12,\n" +
-	"\ah_walls\x18\x03 \x03(\v2\x13.bouncebot.PositionR\x06hWalls\"?\n" +
-	"\x06BotPos\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12%\n" +
-	"\x03pos\x18\x02 \x01(\v2\x13.bouncebot.PositionR\x03pos\"\x80\x01\n" +
-	"\x04Game\x12&\n" +
-	"\x05board\x18\x01 \x01(\v2\x10.bouncebot.BoardR\x05board\x12%\n" +
-	"\x04bots\x18\x02 \x03(\v2\x11.bouncebot.BotPosR\x04bots\x12)\n" +
-	"\x06target\x18\x03 \x01(\v2\x11.bouncebot.BotPosR\x06target\",\n" +
-	"\x06Player\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x8f\x01\n" +
-	"\x0ePlayerSolution\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x127\n" +
-	"\tsolved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bsolvedAt\x12'\n" +
-	"\x05moves\x18\x03 \x03(\v2\x11.bouncebot.BotPosR\x05moves\">\n" +
-	"\vPlayerScore\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
-	"\x04wins\x18\x02 \x01(\x05R\x04wins\"\xd3\x03\n" +
-	"\x04Room\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
-	"\aplayers\x18\x02 \x03(\v2\x11.bouncebot.PlayerR\aplayers\x129\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x122\n" +
-	"\fcurrent_game\x18\x04 \x01(\v2\x0f.bouncebot.GameR\vcurrentGame\x12B\n" +
-	"\x0fgame_started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rgameStartedAt\x127\n" +
-	"\tsolutions\x18\x06 \x03(\v2\x19.bouncebot.PlayerSolutionR\tsolutions\x12.\n" +
-	"\x06scores\x18\a \x03(\v2\x16.bouncebot.PlayerScoreR\x06scores\x12!\n" +
-	"\fgames_played\x18\b \x01(\x05R\vgamesPlayed\x12)\n" +
+func (x *AddBotRequest) Reset() {
+	*x = AddBotRequest{}
+	mi := &file_bouncebot_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotRequest) ProtoMessage() {}
+
+func (x *AddBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotRequest.ProtoReflect.Descriptor instead.
+func (*AddBotRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddBotRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type AddBotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // the bot's player ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotResponse) Reset() {
+	*x = AddBotResponse{}
+	mi := &file_bouncebot_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotResponse) ProtoMessage() {}
+
+func (x *AddBotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotResponse.ProtoReflect.Descriptor instead.
+func (*AddBotResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddBotResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *AddBotResponse) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RemoveBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBotRequest) Reset() {
+	*x = RemoveBotRequest{}
+	mi := &file_bouncebot_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBotRequest) ProtoMessage() {}
+
+func (x *RemoveBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBotRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBotRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveBotRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RemoveBotRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RequestHintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestHintRequest) Reset() {
+	*x = RequestHintRequest{}
+	mi := &file_bouncebot_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestHintRequest) ProtoMessage() {}
+
+func (x *RequestHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestHintRequest.ProtoReflect.Descriptor instead.
+func (*RequestHintRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RequestHintRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RequestHintRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// What a player has been shown of the current game's optimal solution. Each
+// request reveals one more tier: 1 the optimal move count, 2 which robots move,
+// 3 the first move, 4 the full solution.
+type Hint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tier          int32                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	OptimalMoves  int32                  `protobuf:"varint,2,opt,name=optimal_moves,json=optimalMoves,proto3" json:"optimal_moves,omitempty"`
+	Bots          []int32                `protobuf:"varint,3,rep,packed,name=bots,proto3" json:"bots,omitempty"` // robots the solution moves, from tier 2
+	Moves         []*BotPos              `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"`       // the first move from tier 3, every move at tier 4
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hint) Reset() {
+	*x = Hint{}
+	mi := &file_bouncebot_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hint) ProtoMessage() {}
+
+func (x *Hint) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hint.ProtoReflect.Descriptor instead.
+func (*Hint) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Hint) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *Hint) GetOptimalMoves() int32 {
+	if x != nil {
+		return x.OptimalMoves
+	}
+	return 0
+}
+
+func (x *Hint) GetBots() []int32 {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+func (x *Hint) GetMoves() []*BotPos {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type SpectateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	SpectatorName string                 `protobuf:"bytes,2,opt,name=spectator_name,json=spectatorName,proto3" json:"spectator_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateRoomRequest) Reset() {
+	*x = SpectateRoomRequest{}
+	mi := &file_bouncebot_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRoomRequest) ProtoMessage() {}
+
+func (x *SpectateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRoomRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SpectateRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SpectateRoomRequest) GetSpectatorName() string {
+	if x != nil {
+		return x.SpectatorName
+	}
+	return ""
+}
+
+type SpectateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	SpectatorId   string                 `protobuf:"bytes,2,opt,name=spectator_id,json=spectatorId,proto3" json:"spectator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateRoomResponse) Reset() {
+	*x = SpectateRoomResponse{}
+	mi := &file_bouncebot_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRoomResponse) ProtoMessage() {}
+
+func (x *SpectateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRoomResponse.ProtoReflect.Descriptor instead.
+func (*SpectateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SpectateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *SpectateRoomResponse) GetSpectatorId() string {
+	if x != nil {
+		return x.SpectatorId
+	}
+	return ""
+}
+
+type SetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Team          string                 `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"` // empty to leave the player's team
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamRequest) Reset() {
+	*x = SetTeamRequest{}
+	mi := &file_bouncebot_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamRequest) ProtoMessage() {}
+
+func (x *SetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetTeamRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetTeamRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetTeamRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type AssignTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Teams         []string               `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"` // defaults to Red and Blue
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTeamsRequest) Reset() {
+	*x = AssignTeamsRequest{}
+	mi := &file_bouncebot_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTeamsRequest) ProtoMessage() {}
+
+func (x *AssignTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTeamsRequest.ProtoReflect.Descriptor instead.
+func (*AssignTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AssignTeamsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AssignTeamsRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type SetTeamQuorumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamQuorumRequest) Reset() {
+	*x = SetTeamQuorumRequest{}
+	mi := &file_bouncebot_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamQuorumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamQuorumRequest) ProtoMessage() {}
+
+func (x *SetTeamQuorumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamQuorumRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamQuorumRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetTeamQuorumRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetTeamQuorumRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type StartMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Rounds        int32                  `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`                  // games in the match, 0 for no limit
+	FirstTo       int32                  `protobuf:"varint,3,opt,name=first_to,json=firstTo,proto3" json:"first_to,omitempty"` // wins that take the match, 0 for no limit
+	Seed          int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`                      // generates every game from this seed, so rooms sharing it play the same puzzles; 0 to continue games as usual
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMatchRequest) Reset() {
+	*x = StartMatchRequest{}
+	mi := &file_bouncebot_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMatchRequest) ProtoMessage() {}
+
+func (x *StartMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMatchRequest.ProtoReflect.Descriptor instead.
+func (*StartMatchRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartMatchRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StartMatchRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *StartMatchRequest) GetFirstTo() int32 {
+	if x != nil {
+		return x.FirstTo
+	}
+	return 0
+}
+
+func (x *StartMatchRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type StopMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopMatchRequest) Reset() {
+	*x = StopMatchRequest{}
+	mi := &file_bouncebot_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMatchRequest) ProtoMessage() {}
+
+func (x *StopMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMatchRequest.ProtoReflect.Descriptor instead.
+func (*StopMatchRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StopMatchRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type SetHandicapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Handicap      *Handicap              `protobuf:"bytes,3,opt,name=handicap,proto3" json:"handicap,omitempty"` // unset to remove the player's handicap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHandicapRequest) Reset() {
+	*x = SetHandicapRequest{}
+	mi := &file_bouncebot_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHandicapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHandicapRequest) ProtoMessage() {}
+
+func (x *SetHandicapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHandicapRequest.ProtoReflect.Descriptor instead.
+func (*SetHandicapRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetHandicapRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetHandicapRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetHandicapRequest) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+type SuggestHandicapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestHandicapsRequest) Reset() {
+	*x = SuggestHandicapsRequest{}
+	mi := &file_bouncebot_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestHandicapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestHandicapsRequest) ProtoMessage() {}
+
+func (x *SuggestHandicapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestHandicapsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestHandicapsRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SuggestHandicapsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type SuggestHandicapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handicaps     []*SuggestedHandicap   `protobuf:"bytes,1,rep,name=handicaps,proto3" json:"handicaps,omitempty"` // in room order, bots left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestHandicapsResponse) Reset() {
+	*x = SuggestHandicapsResponse{}
+	mi := &file_bouncebot_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestHandicapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestHandicapsResponse) ProtoMessage() {}
+
+func (x *SuggestHandicapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestHandicapsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestHandicapsResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SuggestHandicapsResponse) GetHandicaps() []*SuggestedHandicap {
+	if x != nil {
+		return x.Handicaps
+	}
+	return nil
+}
+
+// A handicap suggested from a player's rating
+type SuggestedHandicap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Rating        float64                `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"` // the account's rating, or the initial rating for guests
+	Handicap      *Handicap              `protobuf:"bytes,3,opt,name=handicap,proto3" json:"handicap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestedHandicap) Reset() {
+	*x = SuggestedHandicap{}
+	mi := &file_bouncebot_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestedHandicap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedHandicap) ProtoMessage() {}
+
+func (x *SuggestedHandicap) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedHandicap.ProtoReflect.Descriptor instead.
+func (*SuggestedHandicap) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SuggestedHandicap) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SuggestedHandicap) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SuggestedHandicap) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+type GetTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsRequest) Reset() {
+	*x = GetTeamsRequest{}
+	mi := &file_bouncebot_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsRequest) ProtoMessage() {}
+
+func (x *GetTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTeamsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type GetTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamStanding        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"` // by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsResponse) Reset() {
+	*x = GetTeamsResponse{}
+	mi := &file_bouncebot_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsResponse) ProtoMessage() {}
+
+func (x *GetTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetTeamsResponse) GetTeams() []*TeamStanding {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type TeamStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          string                 `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	PlayerIds     []string               `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	Wins          int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	BestSolution  *PlayerSolution        `protobuf:"bytes,4,opt,name=best_solution,json=bestSolution,proto3" json:"best_solution,omitempty"` // the team's best solution this game, unset if none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamStanding) Reset() {
+	*x = TeamStanding{}
+	mi := &file_bouncebot_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStanding) ProtoMessage() {}
+
+func (x *TeamStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStanding.ProtoReflect.Descriptor instead.
+func (*TeamStanding) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TeamStanding) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TeamStanding) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *TeamStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetBestSolution() *PlayerSolution {
+	if x != nil {
+		return x.BestSolution
+	}
+	return nil
+}
+
+type GetRoomHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomHistoryRequest) Reset() {
+	*x = GetRoomHistoryRequest{}
+	mi := &file_bouncebot_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomHistoryRequest) ProtoMessage() {}
+
+func (x *GetRoomHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRoomHistoryRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type GetRoomHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*GameRecord          `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"` // completed games, oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomHistoryResponse) Reset() {
+	*x = GetRoomHistoryResponse{}
+	mi := &file_bouncebot_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomHistoryResponse) ProtoMessage() {}
+
+func (x *GetRoomHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRoomHistoryResponse) GetGames() []*GameRecord {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// Completed game in a room's history
+type GameRecord struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Game             *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"` // board, starting bot positions and target
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Solutions        []*PlayerSolution      `protobuf:"bytes,4,rep,name=solutions,proto3" json:"solutions,omitempty"`                                                                                                  // every solution submitted and not retracted, oldest first
+	PlayerNames      map[string]string      `protobuf:"bytes,5,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // names of the room's players when the game ended, by ID
+	WinnerId         string                 `protobuf:"bytes,6,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`                                                                                    // empty if nobody solved the game
+	OptimalMoveCount int32                  `protobuf:"varint,7,opt,name=optimal_move_count,json=optimalMoveCount,proto3" json:"optimal_move_count,omitempty"`                                                         // fewest moves that solve the game, 0 if unknown
+	AccountIds       map[string]string      `protobuf:"bytes,8,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`    // accounts of the signed-in players, by player ID
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GameRecord) Reset() {
+	*x = GameRecord{}
+	mi := &file_bouncebot_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRecord) ProtoMessage() {}
+
+func (x *GameRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRecord.ProtoReflect.Descriptor instead.
+func (*GameRecord) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GameRecord) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameRecord) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GameRecord) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *GameRecord) GetSolutions() []*PlayerSolution {
+	if x != nil {
+		return x.Solutions
+	}
+	return nil
+}
+
+func (x *GameRecord) GetPlayerNames() map[string]string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *GameRecord) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *GameRecord) GetOptimalMoveCount() int32 {
+	if x != nil {
+		return x.OptimalMoveCount
+	}
+	return 0
+}
+
+func (x *GameRecord) GetAccountIds() map[string]string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+type ExportReplayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	GameIndex     int32                  `protobuf:"varint,2,opt,name=game_index,json=gameIndex,proto3" json:"game_index,omitempty"` // index in GetRoomHistory; negative counts from the end (-1 is the latest game)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReplayRequest) Reset() {
+	*x = ExportReplayRequest{}
+	mi := &file_bouncebot_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReplayRequest) ProtoMessage() {}
+
+func (x *ExportReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReplayRequest.ProtoReflect.Descriptor instead.
+func (*ExportReplayRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportReplayRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ExportReplayRequest) GetGameIndex() int32 {
+	if x != nil {
+		return x.GameIndex
+	}
+	return 0
+}
+
+// Self-contained record of one game, for sharing and re-watching.
+// Replay files are this message in its protobuf JSON encoding.
+type Replay struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FormatVersion    uint32                 `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"` // replay format version, currently 1
+	RoomId           string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Game             *Game                  `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`  // board, starting bot positions and target
+	Seed             int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"` // seed the game was generated from, 0 if unknown
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Players          []*Player              `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty"`
+	Events           []*ReplayEvent         `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`                                                 // submissions and retractions, oldest first
+	WinnerId         string                 `protobuf:"bytes,9,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`                             // empty if nobody solved the game
+	OptimalMoveCount int32                  `protobuf:"varint,10,opt,name=optimal_move_count,json=optimalMoveCount,proto3" json:"optimal_move_count,omitempty"` // fewest moves that solve the game, 0 if unknown
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Replay) Reset() {
+	*x = Replay{}
+	mi := &file_bouncebot_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Replay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Replay) ProtoMessage() {}
+
+func (x *Replay) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Replay.ProtoReflect.Descriptor instead.
+func (*Replay) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Replay) GetFormatVersion() uint32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *Replay) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *Replay) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *Replay) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Replay) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Replay) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *Replay) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Replay) GetEvents() []*ReplayEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Replay) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *Replay) GetOptimalMoveCount() int32 {
+	if x != nil {
+		return x.OptimalMoveCount
+	}
+	return 0
+}
+
+// Submission or retraction of a solution during a replayed game
+type ReplayEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Action        ReplayEvent_Action     `protobuf:"varint,3,opt,name=action,proto3,enum=bouncebot.ReplayEvent_Action" json:"action,omitempty"`
+	Moves         []*BotPos              `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"` // moves submitted or retracted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayEvent) Reset() {
+	*x = ReplayEvent{}
+	mi := &file_bouncebot_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEvent) ProtoMessage() {}
+
+func (x *ReplayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEvent.ProtoReflect.Descriptor instead.
+func (*ReplayEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReplayEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetAction() ReplayEvent_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ReplayEvent_ACTION_UNSPECIFIED
+}
+
+func (x *ReplayEvent) GetMoves() []*BotPos {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type WatchRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRoomRequest) Reset() {
+	*x = WatchRoomRequest{}
+	mi := &file_bouncebot_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRoomRequest) ProtoMessage() {}
+
+func (x *WatchRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRoomRequest.ProtoReflect.Descriptor instead.
+func (*WatchRoomRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WatchRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// Event in a room, mirroring the WebSocket events.
+type RoomEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Seq    uint64                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"` // per-room sequence number, increases by one per event
+	// Types that are valid to be assigned to Event:
+	//
+	//	*RoomEvent_PlayerJoined
+	//	*RoomEvent_PlayerLeft
+	//	*RoomEvent_GameStarted
+	//	*RoomEvent_PlayerFinishedSolving
+	//	*RoomEvent_PlayerReadyForNext
+	//	*RoomEvent_PlayerSolved
+	//	*RoomEvent_SolutionRetracted
+	//	*RoomEvent_GameEnded
+	//	*RoomEvent_SpectatorJoined
+	//	*RoomEvent_SpectatorLeft
+	//	*RoomEvent_RoomClosed
+	//	*RoomEvent_Ack
+	//	*RoomEvent_Resync
+	//	*RoomEvent_TeamsChanged
+	//	*RoomEvent_MatchStarted
+	//	*RoomEvent_MatchOver
+	//	*RoomEvent_HandicapChanged
+	//	*RoomEvent_GameAnalysed
+	Event         isRoomEvent_Event `protobuf_oneof:"event"`
+	Room          *Room             `protobuf:"bytes,16,opt,name=room,proto3" json:"room,omitempty"` // WebSocket only: room state after the event, unset if the room is gone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomEvent) Reset() {
+	*x = RoomEvent{}
+	mi := &file_bouncebot_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomEvent) ProtoMessage() {}
+
+func (x *RoomEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
+func (*RoomEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RoomEvent) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RoomEvent) GetEvent() isRoomEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPlayerJoined() *PlayerJoinedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_PlayerJoined); ok {
+			return x.PlayerJoined
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPlayerLeft() *PlayerLeftEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_PlayerLeft); ok {
+			return x.PlayerLeft
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetGameStarted() *GameStartedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_GameStarted); ok {
+			return x.GameStarted
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPlayerFinishedSolving() *PlayerFinishedSolvingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_PlayerFinishedSolving); ok {
+			return x.PlayerFinishedSolving
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPlayerReadyForNext() *PlayerReadyForNextEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_PlayerReadyForNext); ok {
+			return x.PlayerReadyForNext
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPlayerSolved() *PlayerSolvedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_PlayerSolved); ok {
+			return x.PlayerSolved
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetSolutionRetracted() *SolutionRetractedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_SolutionRetracted); ok {
+			return x.SolutionRetracted
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetGameEnded() *GameEndedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_GameEnded); ok {
+			return x.GameEnded
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetSpectatorJoined() *SpectatorJoinedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_SpectatorJoined); ok {
+			return x.SpectatorJoined
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetSpectatorLeft() *SpectatorLeftEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_SpectatorLeft); ok {
+			return x.SpectatorLeft
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetRoomClosed() *RoomClosedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_RoomClosed); ok {
+			return x.RoomClosed
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetAck() *ActionAck {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetResync() *ResyncEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Resync); ok {
+			return x.Resync
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetTeamsChanged() *TeamsChangedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_TeamsChanged); ok {
+			return x.TeamsChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMatchStarted() *MatchStartedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_MatchStarted); ok {
+			return x.MatchStarted
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMatchOver() *MatchOverEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_MatchOver); ok {
+			return x.MatchOver
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetHandicapChanged() *HandicapChangedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_HandicapChanged); ok {
+			return x.HandicapChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetGameAnalysed() *GameAnalysedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_GameAnalysed); ok {
+			return x.GameAnalysed
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type isRoomEvent_Event interface {
+	isRoomEvent_Event()
+}
+
+type RoomEvent_PlayerJoined struct {
+	PlayerJoined *PlayerJoinedEvent `protobuf:"bytes,3,opt,name=player_joined,json=playerJoined,proto3,oneof"`
+}
+
+type RoomEvent_PlayerLeft struct {
+	PlayerLeft *PlayerLeftEvent `protobuf:"bytes,4,opt,name=player_left,json=playerLeft,proto3,oneof"`
+}
+
+type RoomEvent_GameStarted struct {
+	GameStarted *GameStartedEvent `protobuf:"bytes,5,opt,name=game_started,json=gameStarted,proto3,oneof"`
+}
+
+type RoomEvent_PlayerFinishedSolving struct {
+	PlayerFinishedSolving *PlayerFinishedSolvingEvent `protobuf:"bytes,6,opt,name=player_finished_solving,json=playerFinishedSolving,proto3,oneof"`
+}
+
+type RoomEvent_PlayerReadyForNext struct {
+	PlayerReadyForNext *PlayerReadyForNextEvent `protobuf:"bytes,7,opt,name=player_ready_for_next,json=playerReadyForNext,proto3,oneof"`
+}
+
+type RoomEvent_PlayerSolved struct {
+	PlayerSolved *PlayerSolvedEvent `protobuf:"bytes,8,opt,name=player_solved,json=playerSolved,proto3,oneof"`
+}
+
+type RoomEvent_SolutionRetracted struct {
+	SolutionRetracted *SolutionRetractedEvent `protobuf:"bytes,9,opt,name=solution_retracted,json=solutionRetracted,proto3,oneof"`
+}
+
+type RoomEvent_GameEnded struct {
+	GameEnded *GameEndedEvent `protobuf:"bytes,10,opt,name=game_ended,json=gameEnded,proto3,oneof"`
+}
+
+type RoomEvent_SpectatorJoined struct {
+	SpectatorJoined *SpectatorJoinedEvent `protobuf:"bytes,11,opt,name=spectator_joined,json=spectatorJoined,proto3,oneof"`
+}
+
+type RoomEvent_SpectatorLeft struct {
+	SpectatorLeft *SpectatorLeftEvent `protobuf:"bytes,12,opt,name=spectator_left,json=spectatorLeft,proto3,oneof"`
+}
+
+type RoomEvent_RoomClosed struct {
+	RoomClosed *RoomClosedEvent `protobuf:"bytes,13,opt,name=room_closed,json=roomClosed,proto3,oneof"`
+}
+
+type RoomEvent_Ack struct {
+	Ack *ActionAck `protobuf:"bytes,14,opt,name=ack,proto3,oneof"` // WebSocket only: reply to a client message
+}
+
+type RoomEvent_Resync struct {
+	Resync *ResyncEvent `protobuf:"bytes,15,opt,name=resync,proto3,oneof"` // WebSocket only: missed events unavailable on resume
+}
+
+type RoomEvent_TeamsChanged struct {
+	TeamsChanged *TeamsChangedEvent `protobuf:"bytes,17,opt,name=teams_changed,json=teamsChanged,proto3,oneof"`
+}
+
+type RoomEvent_MatchStarted struct {
+	MatchStarted *MatchStartedEvent `protobuf:"bytes,18,opt,name=match_started,json=matchStarted,proto3,oneof"`
+}
+
+type RoomEvent_MatchOver struct {
+	MatchOver *MatchOverEvent `protobuf:"bytes,19,opt,name=match_over,json=matchOver,proto3,oneof"`
+}
+
+type RoomEvent_HandicapChanged struct {
+	HandicapChanged *HandicapChangedEvent `protobuf:"bytes,20,opt,name=handicap_changed,json=handicapChanged,proto3,oneof"`
+}
+
+type RoomEvent_GameAnalysed struct {
+	GameAnalysed *GameAnalysedEvent `protobuf:"bytes,21,opt,name=game_analysed,json=gameAnalysed,proto3,oneof"`
+}
+
+func (*RoomEvent_PlayerJoined) isRoomEvent_Event() {}
+
+func (*RoomEvent_PlayerLeft) isRoomEvent_Event() {}
+
+func (*RoomEvent_GameStarted) isRoomEvent_Event() {}
+
+func (*RoomEvent_PlayerFinishedSolving) isRoomEvent_Event() {}
+
+func (*RoomEvent_PlayerReadyForNext) isRoomEvent_Event() {}
+
+func (*RoomEvent_PlayerSolved) isRoomEvent_Event() {}
+
+func (*RoomEvent_SolutionRetracted) isRoomEvent_Event() {}
+
+func (*RoomEvent_GameEnded) isRoomEvent_Event() {}
+
+func (*RoomEvent_SpectatorJoined) isRoomEvent_Event() {}
+
+func (*RoomEvent_SpectatorLeft) isRoomEvent_Event() {}
+
+func (*RoomEvent_RoomClosed) isRoomEvent_Event() {}
+
+func (*RoomEvent_Ack) isRoomEvent_Event() {}
+
+func (*RoomEvent_Resync) isRoomEvent_Event() {}
+
+func (*RoomEvent_TeamsChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_MatchStarted) isRoomEvent_Event() {}
+
+func (*RoomEvent_MatchOver) isRoomEvent_Event() {}
+
+func (*RoomEvent_HandicapChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_GameAnalysed) isRoomEvent_Event() {}
+
+type PlayerJoinedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerJoinedEvent) Reset() {
+	*x = PlayerJoinedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerJoinedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerJoinedEvent) ProtoMessage() {}
+
+func (x *PlayerJoinedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerJoinedEvent.ProtoReflect.Descriptor instead.
+func (*PlayerJoinedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PlayerJoinedEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerJoinedEvent) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type PlayerLeftEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLeftEvent) Reset() {
+	*x = PlayerLeftEvent{}
+	mi := &file_bouncebot_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLeftEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLeftEvent) ProtoMessage() {}
+
+func (x *PlayerLeftEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLeftEvent.ProtoReflect.Descriptor instead.
+func (*PlayerLeftEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PlayerLeftEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GameStartedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"` // the new game
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameStartedEvent) Reset() {
+	*x = GameStartedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStartedEvent) ProtoMessage() {}
+
+func (x *GameStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStartedEvent.ProtoReflect.Descriptor instead.
+func (*GameStartedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GameStartedEvent) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type PlayerFinishedSolvingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerFinishedSolvingEvent) Reset() {
+	*x = PlayerFinishedSolvingEvent{}
+	mi := &file_bouncebot_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerFinishedSolvingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerFinishedSolvingEvent) ProtoMessage() {}
+
+func (x *PlayerFinishedSolvingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerFinishedSolvingEvent.ProtoReflect.Descriptor instead.
+func (*PlayerFinishedSolvingEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PlayerFinishedSolvingEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type PlayerReadyForNextEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerReadyForNextEvent) Reset() {
+	*x = PlayerReadyForNextEvent{}
+	mi := &file_bouncebot_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerReadyForNextEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerReadyForNextEvent) ProtoMessage() {}
+
+func (x *PlayerReadyForNextEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerReadyForNextEvent.ProtoReflect.Descriptor instead.
+func (*PlayerReadyForNextEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PlayerReadyForNextEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type PlayerSolvedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	MoveCount     int32                  `protobuf:"varint,2,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSolvedEvent) Reset() {
+	*x = PlayerSolvedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSolvedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSolvedEvent) ProtoMessage() {}
+
+func (x *PlayerSolvedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSolvedEvent.ProtoReflect.Descriptor instead.
+func (*PlayerSolvedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PlayerSolvedEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerSolvedEvent) GetMoveCount() int32 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+type SolutionRetractedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolutionRetractedEvent) Reset() {
+	*x = SolutionRetractedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolutionRetractedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionRetractedEvent) ProtoMessage() {}
+
+func (x *SolutionRetractedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionRetractedEvent.ProtoReflect.Descriptor instead.
+func (*SolutionRetractedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SolutionRetractedEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GameEndedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // empty if nobody solved
+	WinnerName    string                 `protobuf:"bytes,2,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	Moves         []*BotPos              `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"` // winning moves
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEndedEvent) Reset() {
+	*x = GameEndedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEndedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEndedEvent) ProtoMessage() {}
+
+func (x *GameEndedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEndedEvent.ProtoReflect.Descriptor instead.
+func (*GameEndedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GameEndedEvent) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *GameEndedEvent) GetWinnerName() string {
+	if x != nil {
+		return x.WinnerName
+	}
+	return ""
+}
+
+func (x *GameEndedEvent) GetMoves() []*BotPos {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+// Follows GameEndedEvent once the solver has analysed the game.
+type GameAnalysedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analysis      *GameAnalysis          `protobuf:"bytes,1,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameAnalysedEvent) Reset() {
+	*x = GameAnalysedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameAnalysedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameAnalysedEvent) ProtoMessage() {}
+
+func (x *GameAnalysedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameAnalysedEvent.ProtoReflect.Descriptor instead.
+func (*GameAnalysedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GameAnalysedEvent) GetAnalysis() *GameAnalysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
+// How each player's best solution compares to the game's optimal ones.
+type GameAnalysis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptimalMoves  int32                  `protobuf:"varint,1,opt,name=optimal_moves,json=optimalMoves,proto3" json:"optimal_moves,omitempty"` // 0 if the solver couldn't find it
+	Optimal       []*SolutionPath        `protobuf:"bytes,2,rep,name=optimal,proto3" json:"optimal,omitempty"`                                // different optimal solutions
+	Players       []*SolutionAnalysis    `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`                                // best solutions, best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameAnalysis) Reset() {
+	*x = GameAnalysis{}
+	mi := &file_bouncebot_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameAnalysis) ProtoMessage() {}
+
+func (x *GameAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameAnalysis.ProtoReflect.Descriptor instead.
+func (*GameAnalysis) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GameAnalysis) GetOptimalMoves() int32 {
+	if x != nil {
+		return x.OptimalMoves
+	}
+	return 0
+}
+
+func (x *GameAnalysis) GetOptimal() []*SolutionPath {
+	if x != nil {
+		return x.Optimal
+	}
+	return nil
+}
+
+func (x *GameAnalysis) GetPlayers() []*SolutionAnalysis {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type SolutionPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*BotPos              `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // the board with each move's destination marked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolutionPath) Reset() {
+	*x = SolutionPath{}
+	mi := &file_bouncebot_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolutionPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionPath) ProtoMessage() {}
+
+func (x *SolutionPath) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionPath.ProtoReflect.Descriptor instead.
+func (*SolutionPath) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SolutionPath) GetMoves() []*BotPos {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *SolutionPath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type SolutionAnalysis struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId         string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName       string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Solution         *SolutionPath          `protobuf:"bytes,3,opt,name=solution,proto3" json:"solution,omitempty"`
+	MovesOverOptimal int32                  `protobuf:"varint,4,opt,name=moves_over_optimal,json=movesOverOptimal,proto3" json:"moves_over_optimal,omitempty"`
+	Divergence       int32                  `protobuf:"varint,5,opt,name=divergence,proto3" json:"divergence,omitempty"` // index of the first move off every optimal line, -1 if none
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SolutionAnalysis) Reset() {
+	*x = SolutionAnalysis{}
+	mi := &file_bouncebot_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolutionAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionAnalysis) ProtoMessage() {}
+
+func (x *SolutionAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionAnalysis.ProtoReflect.Descriptor instead.
+func (*SolutionAnalysis) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SolutionAnalysis) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SolutionAnalysis) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SolutionAnalysis) GetSolution() *SolutionPath {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+func (x *SolutionAnalysis) GetMovesOverOptimal() int32 {
+	if x != nil {
+		return x.MovesOverOptimal
+	}
+	return 0
+}
+
+func (x *SolutionAnalysis) GetDivergence() int32 {
+	if x != nil {
+		return x.Divergence
+	}
+	return 0
+}
+
+type SpectatorJoinedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpectatorId   string                 `protobuf:"bytes,1,opt,name=spectator_id,json=spectatorId,proto3" json:"spectator_id,omitempty"`
+	SpectatorName string                 `protobuf:"bytes,2,opt,name=spectator_name,json=spectatorName,proto3" json:"spectator_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorJoinedEvent) Reset() {
+	*x = SpectatorJoinedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorJoinedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorJoinedEvent) ProtoMessage() {}
+
+func (x *SpectatorJoinedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorJoinedEvent.ProtoReflect.Descriptor instead.
+func (*SpectatorJoinedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SpectatorJoinedEvent) GetSpectatorId() string {
+	if x != nil {
+		return x.SpectatorId
+	}
+	return ""
+}
+
+func (x *SpectatorJoinedEvent) GetSpectatorName() string {
+	if x != nil {
+		return x.SpectatorName
+	}
+	return ""
+}
+
+type SpectatorLeftEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpectatorId   string                 `protobuf:"bytes,1,opt,name=spectator_id,json=spectatorId,proto3" json:"spectator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorLeftEvent) Reset() {
+	*x = SpectatorLeftEvent{}
+	mi := &file_bouncebot_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorLeftEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorLeftEvent) ProtoMessage() {}
+
+func (x *SpectatorLeftEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorLeftEvent.ProtoReflect.Descriptor instead.
+func (*SpectatorLeftEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SpectatorLeftEvent) GetSpectatorId() string {
+	if x != nil {
+		return x.SpectatorId
+	}
+	return ""
+}
+
+type RoomClosedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomClosedEvent) Reset() {
+	*x = RoomClosedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomClosedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomClosedEvent) ProtoMessage() {}
+
+func (x *RoomClosedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomClosedEvent.ProtoReflect.Descriptor instead.
+func (*RoomClosedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{66}
+}
+
+type TeamsChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         map[string]string      `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // team of each player on one, by player ID
+	TeamQuorum    bool                   `protobuf:"varint,2,opt,name=team_quorum,json=teamQuorum,proto3" json:"team_quorum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamsChangedEvent) Reset() {
+	*x = TeamsChangedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamsChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamsChangedEvent) ProtoMessage() {}
+
+func (x *TeamsChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamsChangedEvent.ProtoReflect.Descriptor instead.
+func (*TeamsChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TeamsChangedEvent) GetTeams() map[string]string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *TeamsChangedEvent) GetTeamQuorum() bool {
+	if x != nil {
+		return x.TeamQuorum
+	}
+	return false
+}
+
+type MatchStartedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rounds        int32                  `protobuf:"varint,1,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	FirstTo       int32                  `protobuf:"varint,2,opt,name=first_to,json=firstTo,proto3" json:"first_to,omitempty"`
+	Seed          int64                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchStartedEvent) Reset() {
+	*x = MatchStartedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchStartedEvent) ProtoMessage() {}
+
+func (x *MatchStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchStartedEvent.ProtoReflect.Descriptor instead.
+func (*MatchStartedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MatchStartedEvent) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *MatchStartedEvent) GetFirstTo() int32 {
+	if x != nil {
+		return x.FirstTo
+	}
+	return 0
+}
+
+func (x *MatchStartedEvent) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type MatchOverEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChampionId    string                 `protobuf:"bytes,1,opt,name=champion_id,json=championId,proto3" json:"champion_id,omitempty"` // empty if tied or stopped early
+	ChampionName  string                 `protobuf:"bytes,2,opt,name=champion_name,json=championName,proto3" json:"champion_name,omitempty"`
+	GamesPlayed   int32                  `protobuf:"varint,3,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Standings     []*MatchStanding       `protobuf:"bytes,4,rep,name=standings,proto3" json:"standings,omitempty"` // most wins first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchOverEvent) Reset() {
+	*x = MatchOverEvent{}
+	mi := &file_bouncebot_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchOverEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchOverEvent) ProtoMessage() {}
+
+func (x *MatchOverEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchOverEvent.ProtoReflect.Descriptor instead.
+func (*MatchOverEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MatchOverEvent) GetChampionId() string {
+	if x != nil {
+		return x.ChampionId
+	}
+	return ""
+}
+
+func (x *MatchOverEvent) GetChampionName() string {
+	if x != nil {
+		return x.ChampionName
+	}
+	return ""
+}
+
+func (x *MatchOverEvent) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *MatchOverEvent) GetStandings() []*MatchStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type HandicapChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Handicap      *Handicap              `protobuf:"bytes,2,opt,name=handicap,proto3" json:"handicap,omitempty"` // unset if removed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandicapChangedEvent) Reset() {
+	*x = HandicapChangedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandicapChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandicapChangedEvent) ProtoMessage() {}
+
+func (x *HandicapChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandicapChangedEvent.ProtoReflect.Descriptor instead.
+func (*HandicapChangedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *HandicapChangedEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *HandicapChangedEvent) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+type ActionAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	MoveCount     int32                  `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"` // for submitted solutions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionAck) Reset() {
+	*x = ActionAck{}
+	mi := &file_bouncebot_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionAck) ProtoMessage() {}
+
+func (x *ActionAck) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionAck.ProtoReflect.Descriptor instead.
+func (*ActionAck) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ActionAck) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ActionAck) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *ActionAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ActionAck) GetMoveCount() int32 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+type ResyncEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // latest sequence number; resume after this
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncEvent) Reset() {
+	*x = ResyncEvent{}
+	mi := &file_bouncebot_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncEvent) ProtoMessage() {}
+
+func (x *ResyncEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncEvent.ProtoReflect.Descriptor instead.
+func (*ResyncEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ResyncEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// Player account shared across rooms
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Wins          int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	GamesPlayed   int32                  `protobuf:"varint,5,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_bouncebot_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Account) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_bouncebot_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // claim token; shown only once, keep it to sign in from any device
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_bouncebot_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateAccountResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ClaimAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimAccountRequest) Reset() {
+	*x = ClaimAccountRequest{}
+	mi := &file_bouncebot_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAccountRequest) ProtoMessage() {}
+
+func (x *ClaimAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAccountRequest.ProtoReflect.Descriptor instead.
+func (*ClaimAccountRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ClaimAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Elo rating of a player account
+type Rating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rating        float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	GamesPlayed   int32                  `protobuf:"varint,4,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"` // rated games
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`        // unset if the account hasn't played a rated game
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_bouncebot_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Rating) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Rating) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rating) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Rating) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *Rating) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountIds    []string               `protobuf:"bytes,1,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // accounts to look up; empty for the top of the ladder
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                            // ladder length when account_ids is empty, default 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsRequest) Reset() {
+	*x = GetRatingsRequest{}
+	mi := &file_bouncebot_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsRequest) ProtoMessage() {}
+
+func (x *GetRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetRatingsRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GetRatingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*Rating              `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"` // in request order, or highest first for the ladder
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsResponse) Reset() {
+	*x = GetRatingsResponse{}
+	mi := &file_bouncebot_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsResponse) ProtoMessage() {}
+
+func (x *GetRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetRatingsResponse) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+// A player account's results across every room over a time window
+type PlayerStats struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AccountId           string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Wins                int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	RoundsPlayed        int32                  `protobuf:"varint,4,opt,name=rounds_played,json=roundsPlayed,proto3" json:"rounds_played,omitempty"`
+	RoundsSolved        int32                  `protobuf:"varint,5,opt,name=rounds_solved,json=roundsSolved,proto3" json:"rounds_solved,omitempty"`
+	SolveRate           float64                `protobuf:"fixed64,6,opt,name=solve_rate,json=solveRate,proto3" json:"solve_rate,omitempty"`                                   // rounds_solved / rounds_played
+	AvgMovesOverOptimal float64                `protobuf:"fixed64,7,opt,name=avg_moves_over_optimal,json=avgMovesOverOptimal,proto3" json:"avg_moves_over_optimal,omitempty"` // over solved rounds with a known optimal solution
+	FastestSolve        *durationpb.Duration   `protobuf:"bytes,8,opt,name=fastest_solve,json=fastestSolve,proto3" json:"fastest_solve,omitempty"`                            // unset if no solve time is known
+	WinStreak           int32                  `protobuf:"varint,9,opt,name=win_streak,json=winStreak,proto3" json:"win_streak,omitempty"`                                    // consecutive wins up to the latest round
+	BestWinStreak       int32                  `protobuf:"varint,10,opt,name=best_win_streak,json=bestWinStreak,proto3" json:"best_win_streak,omitempty"`
+	LastPlayedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_played_at,json=lastPlayedAt,proto3" json:"last_played_at,omitempty"` // unset if no rounds in the window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	mi := &file_bouncebot_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PlayerStats) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRoundsPlayed() int32 {
+	if x != nil {
+		return x.RoundsPlayed
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRoundsSolved() int32 {
+	if x != nil {
+		return x.RoundsSolved
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSolveRate() float64 {
+	if x != nil {
+		return x.SolveRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgMovesOverOptimal() float64 {
+	if x != nil {
+		return x.AvgMovesOverOptimal
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFastestSolve() *durationpb.Duration {
+	if x != nil {
+		return x.FastestSolve
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetWinStreak() int32 {
+	if x != nil {
+		return x.WinStreak
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBestWinStreak() int32 {
+	if x != nil {
+		return x.BestWinStreak
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLastPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPlayedAt
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        StatsWindow            `protobuf:"varint,1,opt,name=window,proto3,enum=bouncebot.StatsWindow" json:"window,omitempty"`
+	Order         LeaderboardOrder       `protobuf:"varint,2,opt,name=order,proto3,enum=bouncebot.LeaderboardOrder" json:"order,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // default 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_bouncebot_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetLeaderboardRequest) GetWindow() StatsWindow {
+	if x != nil {
+		return x.Window
+	}
+	return StatsWindow_STATS_WINDOW_ALL_TIME
+}
+
+func (x *GetLeaderboardRequest) GetOrder() LeaderboardOrder {
+	if x != nil {
+		return x.Order
+	}
+	return LeaderboardOrder_LEADERBOARD_ORDER_WINS
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerStats         `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // accounts that played in the window, best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_bouncebot_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetLeaderboardResponse) GetPlayers() []*PlayerStats {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type GetPlayerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Window        StatsWindow            `protobuf:"varint,2,opt,name=window,proto3,enum=bouncebot.StatsWindow" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
+	mi := &file_bouncebot_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetPlayerStatsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetPlayerStatsRequest) GetWindow() StatsWindow {
+	if x != nil {
+		return x.Window
+	}
+	return StatsWindow_STATS_WINDOW_ALL_TIME
+}
+
+// The server-wide puzzle for one UTC day, the same for every player
+type DailyPuzzle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Game          *Game                  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	OptimalMoves  int32                  `protobuf:"varint,3,opt,name=optimal_moves,json=optimalMoves,proto3" json:"optimal_moves,omitempty"` // fewest moves that solve it, 0 if unknown
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`           // when the signed-in player first fetched it; unset for guests
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzle) Reset() {
+	*x = DailyPuzzle{}
+	mi := &file_bouncebot_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzle) ProtoMessage() {}
+
+func (x *DailyPuzzle) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzle.ProtoReflect.Descriptor instead.
+func (*DailyPuzzle) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DailyPuzzle) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPuzzle) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *DailyPuzzle) GetOptimalMoves() int32 {
+	if x != nil {
+		return x.OptimalMoves
+	}
+	return 0
+}
+
+func (x *DailyPuzzle) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+type GetDailyPuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountToken  string                 `protobuf:"bytes,1,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // optional; signed-in players' times start from their first fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyPuzzleRequest) Reset() {
+	*x = GetDailyPuzzleRequest{}
+	mi := &file_bouncebot_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyPuzzleRequest) ProtoMessage() {}
+
+func (x *GetDailyPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetDailyPuzzleRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+type SubmitDailySolutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountToken  string                 `protobuf:"bytes,1,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // required; results are kept per account
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                     // puzzle date, default today; past puzzles are closed
+	Moves         []*BotPos              `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDailySolutionRequest) Reset() {
+	*x = SubmitDailySolutionRequest{}
+	mi := &file_bouncebot_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDailySolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDailySolutionRequest) ProtoMessage() {}
+
+func (x *SubmitDailySolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDailySolutionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDailySolutionRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SubmitDailySolutionRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *SubmitDailySolutionRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SubmitDailySolutionRequest) GetMoves() []*BotPos {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+// An account's best solution to a daily puzzle
+type DailyEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MoveCount     int32                  `protobuf:"varint,3,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	Time          *durationpb.Duration   `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"` // from first fetching the puzzle to submitting
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Rank          int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"` // on the day's leaderboard, from 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyEntry) Reset() {
+	*x = DailyEntry{}
+	mi := &file_bouncebot_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyEntry) ProtoMessage() {}
+
+func (x *DailyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyEntry.ProtoReflect.Descriptor instead.
+func (*DailyEntry) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DailyEntry) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DailyEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DailyEntry) GetMoveCount() int32 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+func (x *DailyEntry) GetTime() *durationpb.Duration {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *DailyEntry) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *DailyEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type GetDailyLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // YYYY-MM-DD, default today; the last 30 days are kept
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // default 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyLeaderboardRequest) Reset() {
+	*x = GetDailyLeaderboardRequest{}
+	mi := &file_bouncebot_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetDailyLeaderboardRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDailyLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Entries       []*DailyEntry          `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // fewest moves first, then fastest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyLeaderboardResponse) Reset() {
+	*x = GetDailyLeaderboardResponse{}
+	mi := &file_bouncebot_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetDailyLeaderboardResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardResponse) GetEntries() []*DailyEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A pre-generated puzzle with a solver-verified optimal solution
+type ArchivePuzzle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"` // the seed the game was generated from
+	Game          *Game                  `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`
+	OptimalMoves  int32                  `protobuf:"varint,4,opt,name=optimal_moves,json=optimalMoves,proto3" json:"optimal_moves,omitempty"`
+	RequiredBots  []int32                `protobuf:"varint,5,rep,packed,name=required_bots,json=requiredBots,proto3" json:"required_bots,omitempty"` // bots the optimal solution moves
+	NeedsHelpers  bool                   `protobuf:"varint,6,opt,name=needs_helpers,json=needsHelpers,proto3" json:"needs_helpers,omitempty"`        // no optimal solution moves only the target bot
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                             // difficulty (easy, medium, hard, expert) and helpers or target-only
+	Solution      []*BotPos              `protobuf:"bytes,8,rep,name=solution,proto3" json:"solution,omitempty"`                                     // an optimal solution; only set when requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePuzzle) Reset() {
+	*x = ArchivePuzzle{}
+	mi := &file_bouncebot_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePuzzle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePuzzle) ProtoMessage() {}
+
+func (x *ArchivePuzzle) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePuzzle.ProtoReflect.Descriptor instead.
+func (*ArchivePuzzle) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ArchivePuzzle) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArchivePuzzle) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *ArchivePuzzle) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *ArchivePuzzle) GetOptimalMoves() int32 {
+	if x != nil {
+		return x.OptimalMoves
+	}
+	return 0
+}
+
+func (x *ArchivePuzzle) GetRequiredBots() []int32 {
+	if x != nil {
+		return x.RequiredBots
+	}
+	return nil
+}
+
+func (x *ArchivePuzzle) GetNeedsHelpers() bool {
+	if x != nil {
+		return x.NeedsHelpers
+	}
+	return false
+}
+
+func (x *ArchivePuzzle) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ArchivePuzzle) GetSolution() []*BotPos {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+type SearchArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinMoves      int32                  `protobuf:"varint,1,opt,name=min_moves,json=minMoves,proto3" json:"min_moves,omitempty"` // 0 for no bound
+	MaxMoves      int32                  `protobuf:"varint,2,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"` // 0 for no bound
+	Bots          []int32                `protobuf:"varint,3,rep,packed,name=bots,proto3" json:"bots,omitempty"`                  // bots the optimal solution must move
+	Helpers       HelperFilter           `protobuf:"varint,4,opt,name=helpers,proto3,enum=bouncebot.HelperFilter" json:"helpers,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"` // tags puzzles must all have
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"` // default 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArchiveRequest) Reset() {
+	*x = SearchArchiveRequest{}
+	mi := &file_bouncebot_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArchiveRequest) ProtoMessage() {}
+
+func (x *SearchArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArchiveRequest.ProtoReflect.Descriptor instead.
+func (*SearchArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SearchArchiveRequest) GetMinMoves() int32 {
+	if x != nil {
+		return x.MinMoves
+	}
+	return 0
+}
+
+func (x *SearchArchiveRequest) GetMaxMoves() int32 {
+	if x != nil {
+		return x.MaxMoves
+	}
+	return 0
+}
+
+func (x *SearchArchiveRequest) GetBots() []int32 {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+func (x *SearchArchiveRequest) GetHelpers() HelperFilter {
+	if x != nil {
+		return x.Helpers
+	}
+	return HelperFilter_HELPER_FILTER_ANY
+}
+
+func (x *SearchArchiveRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchArchiveRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchArchiveRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzles       []*ArchivePuzzle       `protobuf:"bytes,1,rep,name=puzzles,proto3" json:"puzzles,omitempty"` // fewest moves first, without solutions
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`    // matching puzzles, for paging
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArchiveResponse) Reset() {
+	*x = SearchArchiveResponse{}
+	mi := &file_bouncebot_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArchiveResponse) ProtoMessage() {}
+
+func (x *SearchArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArchiveResponse.ProtoReflect.Descriptor instead.
+func (*SearchArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SearchArchiveResponse) GetPuzzles() []*ArchivePuzzle {
+	if x != nil {
+		return x.Puzzles
+	}
+	return nil
+}
+
+func (x *SearchArchiveResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetArchivePuzzleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeSolution bool                   `protobuf:"varint,2,opt,name=include_solution,json=includeSolution,proto3" json:"include_solution,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetArchivePuzzleRequest) Reset() {
+	*x = GetArchivePuzzleRequest{}
+	mi := &file_bouncebot_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivePuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivePuzzleRequest) ProtoMessage() {}
+
+func (x *GetArchivePuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivePuzzleRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivePuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetArchivePuzzleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetArchivePuzzleRequest) GetIncludeSolution() bool {
+	if x != nil {
+		return x.IncludeSolution
+	}
+	return false
+}
+
+type CheckArchiveSolutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Moves         []*BotPos              `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckArchiveSolutionRequest) Reset() {
+	*x = CheckArchiveSolutionRequest{}
+	mi := &file_bouncebot_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckArchiveSolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckArchiveSolutionRequest) ProtoMessage() {}
+
+func (x *CheckArchiveSolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckArchiveSolutionRequest.ProtoReflect.Descriptor instead.
+func (*CheckArchiveSolutionRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CheckArchiveSolutionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckArchiveSolutionRequest) GetMoves() []*BotPos {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type CheckArchiveSolutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Solved        bool                   `protobuf:"varint,1,opt,name=solved,proto3" json:"solved,omitempty"`
+	MoveCount     int32                  `protobuf:"varint,2,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	OptimalMoves  int32                  `protobuf:"varint,3,opt,name=optimal_moves,json=optimalMoves,proto3" json:"optimal_moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckArchiveSolutionResponse) Reset() {
+	*x = CheckArchiveSolutionResponse{}
+	mi := &file_bouncebot_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckArchiveSolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckArchiveSolutionResponse) ProtoMessage() {}
+
+func (x *CheckArchiveSolutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckArchiveSolutionResponse.ProtoReflect.Descriptor instead.
+func (*CheckArchiveSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CheckArchiveSolutionResponse) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+func (x *CheckArchiveSolutionResponse) GetMoveCount() int32 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+func (x *CheckArchiveSolutionResponse) GetOptimalMoves() int32 {
+	if x != nil {
+		return x.OptimalMoves
+	}
+	return 0
+}
+
+// An account playing in a tournament
+type TournamentEntrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Seed          int32                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`     // from 1, best first
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"` // tables won so far, byes included
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentEntrant) Reset() {
+	*x = TournamentEntrant{}
+	mi := &file_bouncebot_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentEntrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentEntrant) ProtoMessage() {}
+
+func (x *TournamentEntrant) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentEntrant.ProtoReflect.Descriptor instead.
+func (*TournamentEntrant) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TournamentEntrant) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TournamentEntrant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentEntrant) GetSeed() int32 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *TournamentEntrant) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// One pairing in a tournament round, played as a match in its own room
+type TournamentTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`             // empty for a bye
+	AccountIds    []string               `protobuf:"bytes,2,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // one for a bye
+	WinnerId      string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`       // account that won the table, empty until decided
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentTable) Reset() {
+	*x = TournamentTable{}
+	mi := &file_bouncebot_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTable) ProtoMessage() {}
+
+func (x *TournamentTable) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentTable.ProtoReflect.Descriptor instead.
+func (*TournamentTable) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *TournamentTable) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *TournamentTable) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *TournamentTable) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type TournamentRound struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"` // from 1
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`     // match seed shared by every table, so all play the same puzzles
+	Tables        []*TournamentTable     `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRound) Reset() {
+	*x = TournamentRound{}
+	mi := &file_bouncebot_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRound) ProtoMessage() {}
+
+func (x *TournamentRound) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRound.ProtoReflect.Descriptor instead.
+func (*TournamentRound) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TournamentRound) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *TournamentRound) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *TournamentRound) GetTables() []*TournamentTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// A bracket of rounds played across rooms
+type Tournament struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                           // "single_elimination" or "swiss"
+	FirstTo       int32                  `protobuf:"varint,4,opt,name=first_to,json=firstTo,proto3" json:"first_to,omitempty"`         // wins that take each table's match
+	Rounds        int32                  `protobuf:"varint,5,opt,name=rounds,proto3" json:"rounds,omitempty"`                          // rounds a Swiss tournament plays; 0 for elimination
+	Entrants      []*TournamentEntrant   `protobuf:"bytes,6,rep,name=entrants,proto3" json:"entrants,omitempty"`                       // in seed order
+	Bracket       []*TournamentRound     `protobuf:"bytes,7,rep,name=bracket,proto3" json:"bracket,omitempty"`                         // rounds started so far
+	ChampionId    string                 `protobuf:"bytes,8,opt,name=champion_id,json=championId,proto3" json:"champion_id,omitempty"` // empty until the tournament ends
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // unset until started
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`       // unset until a champion is crowned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	mi := &file_bouncebot_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Tournament) GetFirstTo() int32 {
+	if x != nil {
+		return x.FirstTo
+	}
+	return 0
+}
+
+func (x *Tournament) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *Tournament) GetEntrants() []*TournamentEntrant {
+	if x != nil {
+		return x.Entrants
+	}
+	return nil
+}
+
+func (x *Tournament) GetBracket() []*TournamentRound {
+	if x != nil {
+		return x.Bracket
+	}
+	return nil
+}
+
+func (x *Tournament) GetChampionId() string {
+	if x != nil {
+		return x.ChampionId
+	}
+	return ""
+}
+
+func (x *Tournament) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tournament) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Tournament) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminToken    string                 `protobuf:"bytes,1,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                           // "single_elimination" (default) or "swiss"
+	AccountIds    []string               `protobuf:"bytes,4,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // entrants in seed order, best first
+	FirstTo       int32                  `protobuf:"varint,5,opt,name=first_to,json=firstTo,proto3" json:"first_to,omitempty"`         // wins that take each table's match, default 1
+	Rounds        int32                  `protobuf:"varint,6,opt,name=rounds,proto3" json:"rounds,omitempty"`                          // Swiss rounds, default enough to find a sole winner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	mi := &file_bouncebot_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateTournamentRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetFirstTo() int32 {
+	if x != nil {
+		return x.FirstTo
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+type StartTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminToken    string                 `protobuf:"bytes,1,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTournamentRequest) Reset() {
+	*x = StartTournamentRequest{}
+	mi := &file_bouncebot_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTournamentRequest) ProtoMessage() {}
+
+func (x *StartTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTournamentRequest.ProtoReflect.Descriptor instead.
+func (*StartTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *StartTournamentRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *StartTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+// Decides a table by hand, e.g. for a no-show or a match stopped without a champion
+type ReportTableResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminToken    string                 `protobuf:"bytes,1,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	WinnerId      string                 `protobuf:"bytes,4,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // account ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTableResultRequest) Reset() {
+	*x = ReportTableResultRequest{}
+	mi := &file_bouncebot_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTableResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTableResultRequest) ProtoMessage() {}
+
+func (x *ReportTableResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTableResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportTableResultRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReportTableResultRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *ReportTableResultRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *ReportTableResultRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReportTableResultRequest) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type GetTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	mi := &file_bouncebot_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRequest) ProtoMessage() {}
+
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type WatchTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTournamentRequest) Reset() {
+	*x = WatchTournamentRequest{}
+	mi := &file_bouncebot_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTournamentRequest) ProtoMessage() {}
+
+func (x *WatchTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTournamentRequest.ProtoReflect.Descriptor instead.
+func (*WatchTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *WatchTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type RoundStartedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundStartedEvent) Reset() {
+	*x = RoundStartedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundStartedEvent) ProtoMessage() {}
+
+func (x *RoundStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundStartedEvent.ProtoReflect.Descriptor instead.
+func (*RoundStartedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RoundStartedEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type TableDecidedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	WinnerId      string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableDecidedEvent) Reset() {
+	*x = TableDecidedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableDecidedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableDecidedEvent) ProtoMessage() {}
+
+func (x *TableDecidedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableDecidedEvent.ProtoReflect.Descriptor instead.
+func (*TableDecidedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *TableDecidedEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TableDecidedEvent) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *TableDecidedEvent) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type TournamentFinishedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChampionId    string                 `protobuf:"bytes,1,opt,name=champion_id,json=championId,proto3" json:"champion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentFinishedEvent) Reset() {
+	*x = TournamentFinishedEvent{}
+	mi := &file_bouncebot_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentFinishedEvent) ProtoMessage() {}
+
+func (x *TournamentFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentFinishedEvent.ProtoReflect.Descriptor instead.
+func (*TournamentFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TournamentFinishedEvent) GetChampionId() string {
+	if x != nil {
+		return x.ChampionId
+	}
+	return ""
+}
+
+// Change in a tournament, with the bracket after it
+type TournamentEvent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Seq          uint64                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"` // per-tournament sequence number, increases by one per event
+	// Types that are valid to be assigned to Event:
+	//
+	//	*TournamentEvent_RoundStarted
+	//	*TournamentEvent_TableDecided
+	//	*TournamentEvent_Finished
+	Event         isTournamentEvent_Event `protobuf_oneof:"event"`
+	Tournament    *Tournament             `protobuf:"bytes,6,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentEvent) Reset() {
+	*x = TournamentEvent{}
+	mi := &file_bouncebot_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentEvent) ProtoMessage() {}
+
+func (x *TournamentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bouncebot_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentEvent.ProtoReflect.Descriptor instead.
+func (*TournamentEvent) Descriptor() ([]byte, []int) {
+	return file_bouncebot_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *TournamentEvent) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *TournamentEvent) GetEvent() isTournamentEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetRoundStarted() *RoundStartedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*TournamentEvent_RoundStarted); ok {
+			return x.RoundStarted
+		}
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetTableDecided() *TableDecidedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*TournamentEvent_TableDecided); ok {
+			return x.TableDecided
+		}
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetFinished() *TournamentFinishedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*TournamentEvent_Finished); ok {
+			return x.Finished
+		}
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type isTournamentEvent_Event interface {
+	isTournamentEvent_Event()
+}
+
+type TournamentEvent_RoundStarted struct {
+	RoundStarted *RoundStartedEvent `protobuf:"bytes,3,opt,name=round_started,json=roundStarted,proto3,oneof"`
+}
+
+type TournamentEvent_TableDecided struct {
+	TableDecided *TableDecidedEvent `protobuf:"bytes,4,opt,name=table_decided,json=tableDecided,proto3,oneof"`
+}
+
+type TournamentEvent_Finished struct {
+	Finished *TournamentFinishedEvent `protobuf:"bytes,5,opt,name=finished,proto3,oneof"`
+}
+
+func (*TournamentEvent_RoundStarted) isTournamentEvent_Event() {}
+
+func (*TournamentEvent_TableDecided) isTournamentEvent_Event() {}
+
+func (*TournamentEvent_Finished) isTournamentEvent_Event() {}
+
+var File_bouncebot_proto protoreflect.FileDescriptor
+
+const file_bouncebot_proto_rawDesc = "" +
+	"\n" +
+	"\x0fbouncebot.proto\x12\tbouncebot\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"&\n" +
+	"\bPosition\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"w\n" +
+	"\x05Board\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12,\n" +
+	"\av_walls\x18\x02 \x03(\v2\x13.bouncebot.PositionR\x06vWalls\x12,\n" +
+	"\ah_walls\x18\x03 \x03(\v2\x13.bouncebot.PositionR\x06hWalls\"?\n" +
+	"\x06BotPos\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12%\n" +
+	"\x03pos\x18\x02 \x01(\v2\x13.bouncebot.PositionR\x03pos\"\x80\x01\n" +
+	"\x04Game\x12&\n" +
+	"\x05board\x18\x01 \x01(\v2\x10.bouncebot.BoardR\x05board\x12%\n" +
+	"\x04bots\x18\x02 \x03(\v2\x11.bouncebot.BotPosR\x04bots\x12)\n" +
+	"\x06target\x18\x03 \x01(\v2\x11.bouncebot.BotPosR\x06target\"\xa2\x01\n" +
+	"\x06Player\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x10\n" +
+	"\x03bot\x18\x04 \x01(\tR\x03bot\x12\x12\n" +
+	"\x04team\x18\x05 \x01(\tR\x04team\x12/\n" +
+	"\bhandicap\x18\x06 \x01(\v2\x13.bouncebot.HandicapR\bhandicap\"\\\n" +
+	"\bHandicap\x12\x1f\n" +
+	"\vextra_moves\x18\x01 \x01(\x05R\n" +
+	"extraMoves\x12/\n" +
+	"\x05delay\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05delay\"/\n" +
+	"\tSpectator\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd6\x01\n" +
+	"\x0ePlayerSolution\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x127\n" +
+	"\tsolved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bsolvedAt\x12'\n" +
+	"\x05moves\x18\x03 \x03(\v2\x11.bouncebot.BotPosR\x05moves\x12\x14\n" +
+	"\x05hints\x18\x04 \x01(\x05R\x05hints\x12/\n" +
+	"\bhandicap\x18\x05 \x01(\v2\x13.bouncebot.HandicapR\bhandicap\">\n" +
+	"\vPlayerScore\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04wins\x18\x02 \x01(\x05R\x04wins\"3\n" +
+	"\tTeamScore\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\tR\x04team\x12\x12\n" +
+	"\x04wins\x18\x02 \x01(\x05R\x04wins\"\x89\x05\n" +
+	"\x04Room\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\aplayers\x18\x02 \x03(\v2\x11.bouncebot.PlayerR\aplayers\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x122\n" +
+	"\fcurrent_game\x18\x04 \x01(\v2\x0f.bouncebot.GameR\vcurrentGame\x12B\n" +
+	"\x0fgame_started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rgameStartedAt\x127\n" +
+	"\tsolutions\x18\x06 \x03(\v2\x19.bouncebot.PlayerSolutionR\tsolutions\x12.\n" +
+	"\x06scores\x18\a \x03(\v2\x16.bouncebot.PlayerScoreR\x06scores\x12!\n" +
+	"\fgames_played\x18\b \x01(\x05R\vgamesPlayed\x12)\n" +
 	"\x10finished_solving\x18\t \x03(\tR\x0ffinishedSolving\x12$\n" +
 	"\x0eready_for_next\x18\n" +
-	" \x03(\tR\freadyForNext\"4\n" +
+	" \x03(\tR\freadyForNext\x124\n" +
+	"\n" +
+	"spectators\x18\v \x03(\v2\x14.bouncebot.SpectatorR\n" +
+	"spectators\x125\n" +
+	"\vteam_scores\x18\f \x03(\v2\x14.bouncebot.TeamScoreR\n" +
+	"teamScores\x12\x1f\n" +
+	"\vteam_quorum\x18\r \x01(\bR\n" +
+	"teamQuorum\x12&\n" +
+	"\x05match\x18\x0e \x01(\v2\x10.bouncebot.MatchR\x05match\"\xbc\x02\n" +
+	"\x05Match\x12\x16\n" +
+	"\x06rounds\x18\x01 \x01(\x05R\x06rounds\x12\x19\n" +
+	"\bfirst_to\x18\x02 \x01(\x05R\afirstTo\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12!\n" +
+	"\fgames_played\x18\x06 \x01(\x05R\vgamesPlayed\x126\n" +
+	"\tstandings\x18\a \x03(\v2\x18.bouncebot.MatchStandingR\tstandings\x12\x1f\n" +
+	"\vchampion_id\x18\b \x01(\tR\n" +
+	"championId\"a\n" +
+	"\rMatchStanding\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\"Y\n" +
 	"\x11CreateRoomRequest\x12\x1f\n" +
 	"\vplayer_name\x18\x01 \x01(\tR\n" +
-	"playerName\"K\n" +
+	"playerName\x12#\n" +
+	"\raccount_token\x18\x02 \x01(\tR\faccountToken\"p\n" +
 	"\x0fJoinRoomRequest\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1f\n" +
 	"\vplayer_name\x18\x02 \x01(\tR\n" +
-	"playerName\")\n" +
+	"playerName\x12#\n" +
+	"\raccount_token\x18\x03 \x01(\tR\faccountToken\")\n" +
 	"\x0eGetRoomRequest\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"+\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"W\n" +
 	"\x10StartGameRequest\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"v\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12*\n" +
+	"\x11archive_puzzle_id\x18\x02 \x01(\tR\x0farchivePuzzleId\"v\n" +
 	"\x15SubmitSolutionRequest\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12'\n" +
@@ -1187,7 +7132,425 @@ const file_bouncebot_proto_rawDesc = "" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"4\n" +
 	"\x18MarkReadyForNextResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf7\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\">\n" +
+	"\rAddBotRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\"R\n" +
+	"\x0eAddBotResponse\x12#\n" +
+	"\x04room\x18\x01 \x01(\v2\x0f.bouncebot.RoomR\x04room\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"H\n" +
+	"\x10RemoveBotRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"J\n" +
+	"\x12RequestHintRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"|\n" +
+	"\x04Hint\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\x05R\x04tier\x12#\n" +
+	"\roptimal_moves\x18\x02 \x01(\x05R\foptimalMoves\x12\x12\n" +
+	"\x04bots\x18\x03 \x03(\x05R\x04bots\x12'\n" +
+	"\x05moves\x18\x04 \x03(\v2\x11.bouncebot.BotPosR\x05moves\"U\n" +
+	"\x13SpectateRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12%\n" +
+	"\x0espectator_name\x18\x02 \x01(\tR\rspectatorName\"^\n" +
+	"\x14SpectateRoomResponse\x12#\n" +
+	"\x04room\x18\x01 \x01(\v2\x0f.bouncebot.RoomR\x04room\x12!\n" +
+	"\fspectator_id\x18\x02 \x01(\tR\vspectatorId\"Z\n" +
+	"\x0eSetTeamRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04team\x18\x03 \x01(\tR\x04team\"C\n" +
+	"\x12AssignTeamsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x14\n" +
+	"\x05teams\x18\x02 \x03(\tR\x05teams\"I\n" +
+	"\x14SetTeamQuorumRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"s\n" +
+	"\x11StartMatchRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x16\n" +
+	"\x06rounds\x18\x02 \x01(\x05R\x06rounds\x12\x19\n" +
+	"\bfirst_to\x18\x03 \x01(\x05R\afirstTo\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\"+\n" +
+	"\x10StopMatchRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"{\n" +
+	"\x12SetHandicapRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12/\n" +
+	"\bhandicap\x18\x03 \x01(\v2\x13.bouncebot.HandicapR\bhandicap\"2\n" +
+	"\x17SuggestHandicapsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"V\n" +
+	"\x18SuggestHandicapsResponse\x12:\n" +
+	"\thandicaps\x18\x01 \x03(\v2\x1c.bouncebot.SuggestedHandicapR\thandicaps\"y\n" +
+	"\x11SuggestedHandicap\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x01R\x06rating\x12/\n" +
+	"\bhandicap\x18\x03 \x01(\v2\x13.bouncebot.HandicapR\bhandicap\"*\n" +
+	"\x0fGetTeamsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"A\n" +
+	"\x10GetTeamsResponse\x12-\n" +
+	"\x05teams\x18\x01 \x03(\v2\x17.bouncebot.TeamStandingR\x05teams\"\x95\x01\n" +
+	"\fTeamStanding\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\tR\x04team\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x02 \x03(\tR\tplayerIds\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12>\n" +
+	"\rbest_solution\x18\x04 \x01(\v2\x19.bouncebot.PlayerSolutionR\fbestSolution\"0\n" +
+	"\x15GetRoomHistoryRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"E\n" +
+	"\x16GetRoomHistoryResponse\x12+\n" +
+	"\x05games\x18\x01 \x03(\v2\x15.bouncebot.GameRecordR\x05games\"\xb9\x04\n" +
+	"\n" +
+	"GameRecord\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.bouncebot.GameR\x04game\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x127\n" +
+	"\tsolutions\x18\x04 \x03(\v2\x19.bouncebot.PlayerSolutionR\tsolutions\x12I\n" +
+	"\fplayer_names\x18\x05 \x03(\v2&.bouncebot.GameRecord.PlayerNamesEntryR\vplayerNames\x12\x1b\n" +
+	"\twinner_id\x18\x06 \x01(\tR\bwinnerId\x12,\n" +
+	"\x12optimal_move_count\x18\a \x01(\x05R\x10optimalMoveCount\x12F\n" +
+	"\vaccount_ids\x18\b \x03(\v2%.bouncebot.GameRecord.AccountIdsEntryR\n" +
+	"accountIds\x1a>\n" +
+	"\x10PlayerNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fAccountIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"M\n" +
+	"\x13ExportReplayRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1d\n" +
+	"\n" +
+	"game_index\x18\x02 \x01(\x05R\tgameIndex\"\x9b\x03\n" +
+	"\x06Replay\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\rR\rformatVersion\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12#\n" +
+	"\x04game\x18\x03 \x01(\v2\x0f.bouncebot.GameR\x04game\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12+\n" +
+	"\aplayers\x18\a \x03(\v2\x11.bouncebot.PlayerR\aplayers\x12.\n" +
+	"\x06events\x18\b \x03(\v2\x16.bouncebot.ReplayEventR\x06events\x12\x1b\n" +
+	"\twinner_id\x18\t \x01(\tR\bwinnerId\x12,\n" +
+	"\x12optimal_move_count\x18\n" +
+	" \x01(\x05R\x10optimalMoveCount\"\xff\x01\n" +
+	"\vReplayEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x125\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1d.bouncebot.ReplayEvent.ActionR\x06action\x12'\n" +
+	"\x05moves\x18\x04 \x03(\v2\x11.bouncebot.BotPosR\x05moves\"G\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rACTION_SUBMIT\x10\x01\x12\x12\n" +
+	"\x0eACTION_RETRACT\x10\x02\"+\n" +
+	"\x10WatchRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"\xc3\n" +
+	"\n" +
+	"\tRoomEvent\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x04R\x03seq\x12C\n" +
+	"\rplayer_joined\x18\x03 \x01(\v2\x1c.bouncebot.PlayerJoinedEventH\x00R\fplayerJoined\x12=\n" +
+	"\vplayer_left\x18\x04 \x01(\v2\x1a.bouncebot.PlayerLeftEventH\x00R\n" +
+	"playerLeft\x12@\n" +
+	"\fgame_started\x18\x05 \x01(\v2\x1b.bouncebot.GameStartedEventH\x00R\vgameStarted\x12_\n" +
+	"\x17player_finished_solving\x18\x06 \x01(\v2%.bouncebot.PlayerFinishedSolvingEventH\x00R\x15playerFinishedSolving\x12W\n" +
+	"\x15player_ready_for_next\x18\a \x01(\v2\".bouncebot.PlayerReadyForNextEventH\x00R\x12playerReadyForNext\x12C\n" +
+	"\rplayer_solved\x18\b \x01(\v2\x1c.bouncebot.PlayerSolvedEventH\x00R\fplayerSolved\x12R\n" +
+	"\x12solution_retracted\x18\t \x01(\v2!.bouncebot.SolutionRetractedEventH\x00R\x11solutionRetracted\x12:\n" +
+	"\n" +
+	"game_ended\x18\n" +
+	" \x01(\v2\x19.bouncebot.GameEndedEventH\x00R\tgameEnded\x12L\n" +
+	"\x10spectator_joined\x18\v \x01(\v2\x1f.bouncebot.SpectatorJoinedEventH\x00R\x0fspectatorJoined\x12F\n" +
+	"\x0espectator_left\x18\f \x01(\v2\x1d.bouncebot.SpectatorLeftEventH\x00R\rspectatorLeft\x12=\n" +
+	"\vroom_closed\x18\r \x01(\v2\x1a.bouncebot.RoomClosedEventH\x00R\n" +
+	"roomClosed\x12(\n" +
+	"\x03ack\x18\x0e \x01(\v2\x14.bouncebot.ActionAckH\x00R\x03ack\x120\n" +
+	"\x06resync\x18\x0f \x01(\v2\x16.bouncebot.ResyncEventH\x00R\x06resync\x12C\n" +
+	"\rteams_changed\x18\x11 \x01(\v2\x1c.bouncebot.TeamsChangedEventH\x00R\fteamsChanged\x12C\n" +
+	"\rmatch_started\x18\x12 \x01(\v2\x1c.bouncebot.MatchStartedEventH\x00R\fmatchStarted\x12:\n" +
+	"\n" +
+	"match_over\x18\x13 \x01(\v2\x19.bouncebot.MatchOverEventH\x00R\tmatchOver\x12L\n" +
+	"\x10handicap_changed\x18\x14 \x01(\v2\x1f.bouncebot.HandicapChangedEventH\x00R\x0fhandicapChanged\x12C\n" +
+	"\rgame_analysed\x18\x15 \x01(\v2\x1c.bouncebot.GameAnalysedEventH\x00R\fgameAnalysed\x12#\n" +
+	"\x04room\x18\x10 \x01(\v2\x0f.bouncebot.RoomR\x04roomB\a\n" +
+	"\x05event\"Q\n" +
+	"\x11PlayerJoinedEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\".\n" +
+	"\x0fPlayerLeftEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"7\n" +
+	"\x10GameStartedEvent\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.bouncebot.GameR\x04game\"9\n" +
+	"\x1aPlayerFinishedSolvingEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"6\n" +
+	"\x17PlayerReadyForNextEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"O\n" +
+	"\x11PlayerSolvedEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1d\n" +
+	"\n" +
+	"move_count\x18\x02 \x01(\x05R\tmoveCount\"5\n" +
+	"\x16SolutionRetractedEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"}\n" +
+	"\x0eGameEndedEvent\x12\x1b\n" +
+	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\x12\x1f\n" +
+	"\vwinner_name\x18\x02 \x01(\tR\n" +
+	"winnerName\x12'\n" +
+	"\x05moves\x18\x03 \x03(\v2\x11.bouncebot.BotPosR\x05movesJ\x04\b\x04\x10\x05\"H\n" +
+	"\x11GameAnalysedEvent\x123\n" +
+	"\banalysis\x18\x01 \x01(\v2\x17.bouncebot.GameAnalysisR\banalysis\"\x9d\x01\n" +
+	"\fGameAnalysis\x12#\n" +
+	"\roptimal_moves\x18\x01 \x01(\x05R\foptimalMoves\x121\n" +
+	"\aoptimal\x18\x02 \x03(\v2\x17.bouncebot.SolutionPathR\aoptimal\x125\n" +
+	"\aplayers\x18\x03 \x03(\v2\x1b.bouncebot.SolutionAnalysisR\aplayers\"K\n" +
+	"\fSolutionPath\x12'\n" +
+	"\x05moves\x18\x01 \x03(\v2\x11.bouncebot.BotPosR\x05moves\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\xd3\x01\n" +
+	"\x10SolutionAnalysis\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x123\n" +
+	"\bsolution\x18\x03 \x01(\v2\x17.bouncebot.SolutionPathR\bsolution\x12,\n" +
+	"\x12moves_over_optimal\x18\x04 \x01(\x05R\x10movesOverOptimal\x12\x1e\n" +
+	"\n" +
+	"divergence\x18\x05 \x01(\x05R\n" +
+	"divergence\"`\n" +
+	"\x14SpectatorJoinedEvent\x12!\n" +
+	"\fspectator_id\x18\x01 \x01(\tR\vspectatorId\x12%\n" +
+	"\x0espectator_name\x18\x02 \x01(\tR\rspectatorName\"7\n" +
+	"\x12SpectatorLeftEvent\x12!\n" +
+	"\fspectator_id\x18\x01 \x01(\tR\vspectatorId\"\x11\n" +
+	"\x0fRoomClosedEvent\"\xad\x01\n" +
+	"\x11TeamsChangedEvent\x12=\n" +
+	"\x05teams\x18\x01 \x03(\v2'.bouncebot.TeamsChangedEvent.TeamsEntryR\x05teams\x12\x1f\n" +
+	"\vteam_quorum\x18\x02 \x01(\bR\n" +
+	"teamQuorum\x1a8\n" +
+	"\n" +
+	"TeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\x11MatchStartedEvent\x12\x16\n" +
+	"\x06rounds\x18\x01 \x01(\x05R\x06rounds\x12\x19\n" +
+	"\bfirst_to\x18\x02 \x01(\x05R\afirstTo\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\"\xb1\x01\n" +
+	"\x0eMatchOverEvent\x12\x1f\n" +
+	"\vchampion_id\x18\x01 \x01(\tR\n" +
+	"championId\x12#\n" +
+	"\rchampion_name\x18\x02 \x01(\tR\fchampionName\x12!\n" +
+	"\fgames_played\x18\x03 \x01(\x05R\vgamesPlayed\x126\n" +
+	"\tstandings\x18\x04 \x03(\v2\x18.bouncebot.MatchStandingR\tstandings\"d\n" +
+	"\x14HandicapChangedEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12/\n" +
+	"\bhandicap\x18\x02 \x01(\v2\x13.bouncebot.HandicapR\bhandicap\"o\n" +
+	"\tActionAck\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"move_count\x18\x04 \x01(\x05R\tmoveCount\"\x1f\n" +
+	"\vResyncEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\"\x9f\x01\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12!\n" +
+	"\fgames_played\x18\x05 \x01(\x05R\vgamesPlayed\"*\n" +
+	"\x14CreateAccountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"[\n" +
+	"\x15CreateAccountResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.bouncebot.AccountR\aaccount\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"+\n" +
+	"\x13ClaimAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xb1\x01\n" +
+	"\x06Rating\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12!\n" +
+	"\fgames_played\x18\x04 \x01(\x05R\vgamesPlayed\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x11GetRatingsRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\tR\n" +
+	"accountIds\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"A\n" +
+	"\x12GetRatingsResponse\x12+\n" +
+	"\aratings\x18\x01 \x03(\v2\x11.bouncebot.RatingR\aratings\"\xbb\x03\n" +
+	"\vPlayerStats\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12#\n" +
+	"\rrounds_played\x18\x04 \x01(\x05R\froundsPlayed\x12#\n" +
+	"\rrounds_solved\x18\x05 \x01(\x05R\froundsSolved\x12\x1d\n" +
+	"\n" +
+	"solve_rate\x18\x06 \x01(\x01R\tsolveRate\x123\n" +
+	"\x16avg_moves_over_optimal\x18\a \x01(\x01R\x13avgMovesOverOptimal\x12>\n" +
+	"\rfastest_solve\x18\b \x01(\v2\x19.google.protobuf.DurationR\ffastestSolve\x12\x1d\n" +
+	"\n" +
+	"win_streak\x18\t \x01(\x05R\twinStreak\x12&\n" +
+	"\x0fbest_win_streak\x18\n" +
+	" \x01(\x05R\rbestWinStreak\x12@\n" +
+	"\x0elast_played_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\flastPlayedAt\"\x90\x01\n" +
+	"\x15GetLeaderboardRequest\x12.\n" +
+	"\x06window\x18\x01 \x01(\x0e2\x16.bouncebot.StatsWindowR\x06window\x121\n" +
+	"\x05order\x18\x02 \x01(\x0e2\x1b.bouncebot.LeaderboardOrderR\x05order\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
+	"\x16GetLeaderboardResponse\x120\n" +
+	"\aplayers\x18\x01 \x03(\v2\x16.bouncebot.PlayerStatsR\aplayers\"f\n" +
+	"\x15GetPlayerStatsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12.\n" +
+	"\x06window\x18\x02 \x01(\x0e2\x16.bouncebot.StatsWindowR\x06window\"\xa6\x01\n" +
+	"\vDailyPuzzle\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12#\n" +
+	"\x04game\x18\x02 \x01(\v2\x0f.bouncebot.GameR\x04game\x12#\n" +
+	"\roptimal_moves\x18\x03 \x01(\x05R\foptimalMoves\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\"<\n" +
+	"\x15GetDailyPuzzleRequest\x12#\n" +
+	"\raccount_token\x18\x01 \x01(\tR\faccountToken\"~\n" +
+	"\x1aSubmitDailySolutionRequest\x12#\n" +
+	"\raccount_token\x18\x01 \x01(\tR\faccountToken\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12'\n" +
+	"\x05moves\x18\x03 \x03(\v2\x11.bouncebot.BotPosR\x05moves\"\xe0\x01\n" +
+	"\n" +
+	"DailyEntry\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"move_count\x18\x03 \x01(\x05R\tmoveCount\x12-\n" +
+	"\x04time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x04time\x12=\n" +
+	"\fsubmitted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\"F\n" +
+	"\x1aGetDailyLeaderboardRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"b\n" +
+	"\x1bGetDailyLeaderboardResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12/\n" +
+	"\aentries\x18\x02 \x03(\v2\x15.bouncebot.DailyEntryR\aentries\"\x8a\x02\n" +
+	"\rArchivePuzzle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12#\n" +
+	"\x04game\x18\x03 \x01(\v2\x0f.bouncebot.GameR\x04game\x12#\n" +
+	"\roptimal_moves\x18\x04 \x01(\x05R\foptimalMoves\x12#\n" +
+	"\rrequired_bots\x18\x05 \x03(\x05R\frequiredBots\x12#\n" +
+	"\rneeds_helpers\x18\x06 \x01(\bR\fneedsHelpers\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12-\n" +
+	"\bsolution\x18\b \x03(\v2\x11.bouncebot.BotPosR\bsolution\"\xd9\x01\n" +
+	"\x14SearchArchiveRequest\x12\x1b\n" +
+	"\tmin_moves\x18\x01 \x01(\x05R\bminMoves\x12\x1b\n" +
+	"\tmax_moves\x18\x02 \x01(\x05R\bmaxMoves\x12\x12\n" +
+	"\x04bots\x18\x03 \x03(\x05R\x04bots\x121\n" +
+	"\ahelpers\x18\x04 \x01(\x0e2\x17.bouncebot.HelperFilterR\ahelpers\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"a\n" +
+	"\x15SearchArchiveResponse\x122\n" +
+	"\apuzzles\x18\x01 \x03(\v2\x18.bouncebot.ArchivePuzzleR\apuzzles\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"T\n" +
+	"\x17GetArchivePuzzleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10include_solution\x18\x02 \x01(\bR\x0fincludeSolution\"V\n" +
+	"\x1bCheckArchiveSolutionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x05moves\x18\x02 \x03(\v2\x11.bouncebot.BotPosR\x05moves\"z\n" +
+	"\x1cCheckArchiveSolutionResponse\x12\x16\n" +
+	"\x06solved\x18\x01 \x01(\bR\x06solved\x12\x1d\n" +
+	"\n" +
+	"move_count\x18\x02 \x01(\x05R\tmoveCount\x12#\n" +
+	"\roptimal_moves\x18\x03 \x01(\x05R\foptimalMoves\"r\n" +
+	"\x11TournamentEntrant\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x05R\x04seed\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\"h\n" +
+	"\x0fTournamentTable\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1f\n" +
+	"\vaccount_ids\x18\x02 \x03(\tR\n" +
+	"accountIds\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\"q\n" +
+	"\x0fTournamentRound\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x122\n" +
+	"\x06tables\x18\x03 \x03(\v2\x1a.bouncebot.TournamentTableR\x06tables\"\xb9\x03\n" +
+	"\n" +
+	"Tournament\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x19\n" +
+	"\bfirst_to\x18\x04 \x01(\x05R\afirstTo\x12\x16\n" +
+	"\x06rounds\x18\x05 \x01(\x05R\x06rounds\x128\n" +
+	"\bentrants\x18\x06 \x03(\v2\x1c.bouncebot.TournamentEntrantR\bentrants\x124\n" +
+	"\abracket\x18\a \x03(\v2\x1a.bouncebot.TournamentRoundR\abracket\x12\x1f\n" +
+	"\vchampion_id\x18\b \x01(\tR\n" +
+	"championId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\"\xba\x01\n" +
+	"\x17CreateTournamentRequest\x12\x1f\n" +
+	"\vadmin_token\x18\x01 \x01(\tR\n" +
+	"adminToken\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1f\n" +
+	"\vaccount_ids\x18\x04 \x03(\tR\n" +
+	"accountIds\x12\x19\n" +
+	"\bfirst_to\x18\x05 \x01(\x05R\afirstTo\x12\x16\n" +
+	"\x06rounds\x18\x06 \x01(\x05R\x06rounds\"^\n" +
+	"\x16StartTournamentRequest\x12\x1f\n" +
+	"\vadmin_token\x18\x01 \x01(\tR\n" +
+	"adminToken\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"\x96\x01\n" +
+	"\x18ReportTableResultRequest\x12\x1f\n" +
+	"\vadmin_token\x18\x01 \x01(\tR\n" +
+	"adminToken\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\twinner_id\x18\x04 \x01(\tR\bwinnerId\";\n" +
+	"\x14GetTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\"=\n" +
+	"\x16WatchTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\")\n" +
+	"\x11RoundStartedEvent\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\"_\n" +
+	"\x11TableDecidedEvent\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\":\n" +
+	"\x17TournamentFinishedEvent\x12\x1f\n" +
+	"\vchampion_id\x18\x01 \x01(\tR\n" +
+	"championId\"\xd4\x02\n" +
+	"\x0fTournamentEvent\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x04R\x03seq\x12C\n" +
+	"\rround_started\x18\x03 \x01(\v2\x1c.bouncebot.RoundStartedEventH\x00R\froundStarted\x12C\n" +
+	"\rtable_decided\x18\x04 \x01(\v2\x1c.bouncebot.TableDecidedEventH\x00R\ftableDecided\x12@\n" +
+	"\bfinished\x18\x05 \x01(\v2\".bouncebot.TournamentFinishedEventH\x00R\bfinished\x125\n" +
+	"\n" +
+	"tournament\x18\x06 \x01(\v2\x15.bouncebot.TournamentR\n" +
+	"tournamentB\a\n" +
+	"\x05event*Y\n" +
+	"\vStatsWindow\x12\x19\n" +
+	"\x15STATS_WINDOW_ALL_TIME\x10\x00\x12\x16\n" +
+	"\x12STATS_WINDOW_DAILY\x10\x01\x12\x17\n" +
+	"\x13STATS_WINDOW_WEEKLY\x10\x02*\x9c\x01\n" +
+	"\x10LeaderboardOrder\x12\x1a\n" +
+	"\x16LEADERBOARD_ORDER_WINS\x10\x00\x12 \n" +
+	"\x1cLEADERBOARD_ORDER_SOLVE_RATE\x10\x01\x12#\n" +
+	"\x1fLEADERBOARD_ORDER_FASTEST_SOLVE\x10\x02\x12%\n" +
+	"!LEADERBOARD_ORDER_BEST_WIN_STREAK\x10\x03*a\n" +
+	"\fHelperFilter\x12\x15\n" +
+	"\x11HELPER_FILTER_ANY\x10\x00\x12\x1a\n" +
+	"\x16HELPER_FILTER_REQUIRED\x10\x01\x12\x1e\n" +
+	"\x1aHELPER_FILTER_NOT_REQUIRED\x10\x022\xd3\x17\n" +
 	"\tBounceBot\x12=\n" +
 	"\n" +
 	"CreateRoom\x12\x1c.bouncebot.CreateRoomRequest\x1a\x0f.bouncebot.Room\"\x00\x129\n" +
@@ -1197,7 +7560,40 @@ const file_bouncebot_proto_rawDesc = "" +
 	"\x0eSubmitSolution\x12 .bouncebot.SubmitSolutionRequest\x1a!.bouncebot.SubmitSolutionResponse\"\x00\x12Z\n" +
 	"\x0fRetractSolution\x12!.bouncebot.RetractSolutionRequest\x1a\".bouncebot.RetractSolutionResponse\"\x00\x12f\n" +
 	"\x13MarkFinishedSolving\x12%.bouncebot.MarkFinishedSolvingRequest\x1a&.bouncebot.MarkFinishedSolvingResponse\"\x00\x12]\n" +
-	"\x10MarkReadyForNext\x12\".bouncebot.MarkReadyForNextRequest\x1a#.bouncebot.MarkReadyForNextResponse\"\x00B(Z&github.com/srsalisbury/bouncebot/protob\x06proto3"
+	"\x10MarkReadyForNext\x12\".bouncebot.MarkReadyForNextRequest\x1a#.bouncebot.MarkReadyForNextResponse\"\x00\x12Q\n" +
+	"\fSpectateRoom\x12\x1e.bouncebot.SpectateRoomRequest\x1a\x1f.bouncebot.SpectateRoomResponse\"\x00\x12W\n" +
+	"\x0eGetRoomHistory\x12 .bouncebot.GetRoomHistoryRequest\x1a!.bouncebot.GetRoomHistoryResponse\"\x00\x12C\n" +
+	"\fExportReplay\x12\x1e.bouncebot.ExportReplayRequest\x1a\x11.bouncebot.Replay\"\x00\x12?\n" +
+	"\x06AddBot\x12\x18.bouncebot.AddBotRequest\x1a\x19.bouncebot.AddBotResponse\"\x00\x12;\n" +
+	"\tRemoveBot\x12\x1b.bouncebot.RemoveBotRequest\x1a\x0f.bouncebot.Room\"\x00\x12?\n" +
+	"\vRequestHint\x12\x1d.bouncebot.RequestHintRequest\x1a\x0f.bouncebot.Hint\"\x00\x127\n" +
+	"\aSetTeam\x12\x19.bouncebot.SetTeamRequest\x1a\x0f.bouncebot.Room\"\x00\x12?\n" +
+	"\vAssignTeams\x12\x1d.bouncebot.AssignTeamsRequest\x1a\x0f.bouncebot.Room\"\x00\x12C\n" +
+	"\rSetTeamQuorum\x12\x1f.bouncebot.SetTeamQuorumRequest\x1a\x0f.bouncebot.Room\"\x00\x12E\n" +
+	"\bGetTeams\x12\x1a.bouncebot.GetTeamsRequest\x1a\x1b.bouncebot.GetTeamsResponse\"\x00\x12=\n" +
+	"\n" +
+	"StartMatch\x12\x1c.bouncebot.StartMatchRequest\x1a\x0f.bouncebot.Room\"\x00\x12;\n" +
+	"\tStopMatch\x12\x1b.bouncebot.StopMatchRequest\x1a\x0f.bouncebot.Room\"\x00\x12?\n" +
+	"\vSetHandicap\x12\x1d.bouncebot.SetHandicapRequest\x1a\x0f.bouncebot.Room\"\x00\x12]\n" +
+	"\x10SuggestHandicaps\x12\".bouncebot.SuggestHandicapsRequest\x1a#.bouncebot.SuggestHandicapsResponse\"\x00\x12T\n" +
+	"\rCreateAccount\x12\x1f.bouncebot.CreateAccountRequest\x1a .bouncebot.CreateAccountResponse\"\x00\x12D\n" +
+	"\fClaimAccount\x12\x1e.bouncebot.ClaimAccountRequest\x1a\x12.bouncebot.Account\"\x00\x12K\n" +
+	"\n" +
+	"GetRatings\x12\x1c.bouncebot.GetRatingsRequest\x1a\x1d.bouncebot.GetRatingsResponse\"\x00\x12W\n" +
+	"\x0eGetLeaderboard\x12 .bouncebot.GetLeaderboardRequest\x1a!.bouncebot.GetLeaderboardResponse\"\x00\x12L\n" +
+	"\x0eGetPlayerStats\x12 .bouncebot.GetPlayerStatsRequest\x1a\x16.bouncebot.PlayerStats\"\x00\x12L\n" +
+	"\x0eGetDailyPuzzle\x12 .bouncebot.GetDailyPuzzleRequest\x1a\x16.bouncebot.DailyPuzzle\"\x00\x12U\n" +
+	"\x13SubmitDailySolution\x12%.bouncebot.SubmitDailySolutionRequest\x1a\x15.bouncebot.DailyEntry\"\x00\x12f\n" +
+	"\x13GetDailyLeaderboard\x12%.bouncebot.GetDailyLeaderboardRequest\x1a&.bouncebot.GetDailyLeaderboardResponse\"\x00\x12T\n" +
+	"\rSearchArchive\x12\x1f.bouncebot.SearchArchiveRequest\x1a .bouncebot.SearchArchiveResponse\"\x00\x12R\n" +
+	"\x10GetArchivePuzzle\x12\".bouncebot.GetArchivePuzzleRequest\x1a\x18.bouncebot.ArchivePuzzle\"\x00\x12i\n" +
+	"\x14CheckArchiveSolution\x12&.bouncebot.CheckArchiveSolutionRequest\x1a'.bouncebot.CheckArchiveSolutionResponse\"\x00\x12B\n" +
+	"\tWatchRoom\x12\x1b.bouncebot.WatchRoomRequest\x1a\x14.bouncebot.RoomEvent\"\x000\x01\x12O\n" +
+	"\x10CreateTournament\x12\".bouncebot.CreateTournamentRequest\x1a\x15.bouncebot.Tournament\"\x00\x12M\n" +
+	"\x0fStartTournament\x12!.bouncebot.StartTournamentRequest\x1a\x15.bouncebot.Tournament\"\x00\x12Q\n" +
+	"\x11ReportTableResult\x12#.bouncebot.ReportTableResultRequest\x1a\x15.bouncebot.Tournament\"\x00\x12I\n" +
+	"\rGetTournament\x12\x1f.bouncebot.GetTournamentRequest\x1a\x15.bouncebot.Tournament\"\x00\x12T\n" +
+	"\x0fWatchTournament\x12!.bouncebot.WatchTournamentRequest\x1a\x1a.bouncebot.TournamentEvent\"\x000\x01B(Z&github.com/srsalisbury/bouncebot/protob\x06proto3"
 
 var (
 	file_bouncebot_proto_rawDescOnce sync.Once
@@ -1211,68 +7607,320 @@ func file_bouncebot_proto_rawDescGZIP() []byte {
 	return file_bouncebot_proto_rawDescData
 }
 
-var file_bouncebot_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_bouncebot_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_bouncebot_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_bouncebot_proto_goTypes = []any{
-	(*Position)(nil),                    // 0: bouncebot.Position
-	(*Board)(nil),                       // 1: bouncebot.Board
-	(*BotPos)(nil),                      // 2: bouncebot.BotPos
-	(*Game)(nil),                        // 3: bouncebot.Game
-	(*Player)(nil),                      // 4: bouncebot.Player
-	(*PlayerSolution)(nil),              // 5: bouncebot.PlayerSolution
-	(*PlayerScore)(nil),                 // 6: bouncebot.PlayerScore
-	(*Room)(nil),                        // 7: bouncebot.Room
-	(*CreateRoomRequest)(nil),           // 8: bouncebot.CreateRoomRequest
-	(*JoinRoomRequest)(nil),             // 9: bouncebot.JoinRoomRequest
-	(*GetRoomRequest)(nil),              // 10: bouncebot.GetRoomRequest
-	(*StartGameRequest)(nil),            // 11: bouncebot.StartGameRequest
-	(*SubmitSolutionRequest)(nil),       // 12: bouncebot.SubmitSolutionRequest
-	(*SubmitSolutionResponse)(nil),      // 13: bouncebot.SubmitSolutionResponse
-	(*RetractSolutionRequest)(nil),      // 14: bouncebot.RetractSolutionRequest
-	(*RetractSolutionResponse)(nil),     // 15: bouncebot.RetractSolutionResponse
-	(*MarkFinishedSolvingRequest)(nil),  // 16: bouncebot.MarkFinishedSolvingRequest
-	(*MarkFinishedSolvingResponse)(nil), // 17: bouncebot.MarkFinishedSolvingResponse
-	(*MarkReadyForNextRequest)(nil),     // 18: bouncebot.MarkReadyForNextRequest
-	(*MarkReadyForNextResponse)(nil),    // 19: bouncebot.MarkReadyForNextResponse
-	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
+	(StatsWindow)(0),                     // 0: bouncebot.StatsWindow
+	(LeaderboardOrder)(0),                // 1: bouncebot.LeaderboardOrder
+	(HelperFilter)(0),                    // 2: bouncebot.HelperFilter
+	(ReplayEvent_Action)(0),              // 3: bouncebot.ReplayEvent.Action
+	(*Position)(nil),                     // 4: bouncebot.Position
+	(*Board)(nil),                        // 5: bouncebot.Board
+	(*BotPos)(nil),                       // 6: bouncebot.BotPos
+	(*Game)(nil),                         // 7: bouncebot.Game
+	(*Player)(nil),                       // 8: bouncebot.Player
+	(*Handicap)(nil),                     // 9: bouncebot.Handicap
+	(*Spectator)(nil),                    // 10: bouncebot.Spectator
+	(*PlayerSolution)(nil),               // 11: bouncebot.PlayerSolution
+	(*PlayerScore)(nil),                  // 12: bouncebot.PlayerScore
+	(*TeamScore)(nil),                    // 13: bouncebot.TeamScore
+	(*Room)(nil),                         // 14: bouncebot.Room
+	(*Match)(nil),                        // 15: bouncebot.Match
+	(*MatchStanding)(nil),                // 16: bouncebot.MatchStanding
+	(*CreateRoomRequest)(nil),            // 17: bouncebot.CreateRoomRequest
+	(*JoinRoomRequest)(nil),              // 18: bouncebot.JoinRoomRequest
+	(*GetRoomRequest)(nil),               // 19: bouncebot.GetRoomRequest
+	(*StartGameRequest)(nil),             // 20: bouncebot.StartGameRequest
+	(*SubmitSolutionRequest)(nil),        // 21: bouncebot.SubmitSolutionRequest
+	(*SubmitSolutionResponse)(nil),       // 22: bouncebot.SubmitSolutionResponse
+	(*RetractSolutionRequest)(nil),       // 23: bouncebot.RetractSolutionRequest
+	(*RetractSolutionResponse)(nil),      // 24: bouncebot.RetractSolutionResponse
+	(*MarkFinishedSolvingRequest)(nil),   // 25: bouncebot.MarkFinishedSolvingRequest
+	(*MarkFinishedSolvingResponse)(nil),  // 26: bouncebot.MarkFinishedSolvingResponse
+	(*MarkReadyForNextRequest)(nil),      // 27: bouncebot.MarkReadyForNextRequest
+	(*MarkReadyForNextResponse)(nil),     // 28: bouncebot.MarkReadyForNextResponse
+	(*AddBotRequest)(nil),                // 29: bouncebot.AddBotRequest
+	(*AddBotResponse)(nil),               // 30: bouncebot.AddBotResponse
+	(*RemoveBotRequest)(nil),             // 31: bouncebot.RemoveBotRequest
+	(*RequestHintRequest)(nil),           // 32: bouncebot.RequestHintRequest
+	(*Hint)(nil),                         // 33: bouncebot.Hint
+	(*SpectateRoomRequest)(nil),          // 34: bouncebot.SpectateRoomRequest
+	(*SpectateRoomResponse)(nil),         // 35: bouncebot.SpectateRoomResponse
+	(*SetTeamRequest)(nil),               // 36: bouncebot.SetTeamRequest
+	(*AssignTeamsRequest)(nil),           // 37: bouncebot.AssignTeamsRequest
+	(*SetTeamQuorumRequest)(nil),         // 38: bouncebot.SetTeamQuorumRequest
+	(*StartMatchRequest)(nil),            // 39: bouncebot.StartMatchRequest
+	(*StopMatchRequest)(nil),             // 40: bouncebot.StopMatchRequest
+	(*SetHandicapRequest)(nil),           // 41: bouncebot.SetHandicapRequest
+	(*SuggestHandicapsRequest)(nil),      // 42: bouncebot.SuggestHandicapsRequest
+	(*SuggestHandicapsResponse)(nil),     // 43: bouncebot.SuggestHandicapsResponse
+	(*SuggestedHandicap)(nil),            // 44: bouncebot.SuggestedHandicap
+	(*GetTeamsRequest)(nil),              // 45: bouncebot.GetTeamsRequest
+	(*GetTeamsResponse)(nil),             // 46: bouncebot.GetTeamsResponse
+	(*TeamStanding)(nil),                 // 47: bouncebot.TeamStanding
+	(*GetRoomHistoryRequest)(nil),        // 48: bouncebot.GetRoomHistoryRequest
+	(*GetRoomHistoryResponse)(nil),       // 49: bouncebot.GetRoomHistoryResponse
+	(*GameRecord)(nil),                   // 50: bouncebot.GameRecord
+	(*ExportReplayRequest)(nil),          // 51: bouncebot.ExportReplayRequest
+	(*Replay)(nil),                       // 52: bouncebot.Replay
+	(*ReplayEvent)(nil),                  // 53: bouncebot.ReplayEvent
+	(*WatchRoomRequest)(nil),             // 54: bouncebot.WatchRoomRequest
+	(*RoomEvent)(nil),                    // 55: bouncebot.RoomEvent
+	(*PlayerJoinedEvent)(nil),            // 56: bouncebot.PlayerJoinedEvent
+	(*PlayerLeftEvent)(nil),              // 57: bouncebot.PlayerLeftEvent
+	(*GameStartedEvent)(nil),             // 58: bouncebot.GameStartedEvent
+	(*PlayerFinishedSolvingEvent)(nil),   // 59: bouncebot.PlayerFinishedSolvingEvent
+	(*PlayerReadyForNextEvent)(nil),      // 60: bouncebot.PlayerReadyForNextEvent
+	(*PlayerSolvedEvent)(nil),            // 61: bouncebot.PlayerSolvedEvent
+	(*SolutionRetractedEvent)(nil),       // 62: bouncebot.SolutionRetractedEvent
+	(*GameEndedEvent)(nil),               // 63: bouncebot.GameEndedEvent
+	(*GameAnalysedEvent)(nil),            // 64: bouncebot.GameAnalysedEvent
+	(*GameAnalysis)(nil),                 // 65: bouncebot.GameAnalysis
+	(*SolutionPath)(nil),                 // 66: bouncebot.SolutionPath
+	(*SolutionAnalysis)(nil),             // 67: bouncebot.SolutionAnalysis
+	(*SpectatorJoinedEvent)(nil),         // 68: bouncebot.SpectatorJoinedEvent
+	(*SpectatorLeftEvent)(nil),           // 69: bouncebot.SpectatorLeftEvent
+	(*RoomClosedEvent)(nil),              // 70: bouncebot.RoomClosedEvent
+	(*TeamsChangedEvent)(nil),            // 71: bouncebot.TeamsChangedEvent
+	(*MatchStartedEvent)(nil),            // 72: bouncebot.MatchStartedEvent
+	(*MatchOverEvent)(nil),               // 73: bouncebot.MatchOverEvent
+	(*HandicapChangedEvent)(nil),         // 74: bouncebot.HandicapChangedEvent
+	(*ActionAck)(nil),                    // 75: bouncebot.ActionAck
+	(*ResyncEvent)(nil),                  // 76: bouncebot.ResyncEvent
+	(*Account)(nil),                      // 77: bouncebot.Account
+	(*CreateAccountRequest)(nil),         // 78: bouncebot.CreateAccountRequest
+	(*CreateAccountResponse)(nil),        // 79: bouncebot.CreateAccountResponse
+	(*ClaimAccountRequest)(nil),          // 80: bouncebot.ClaimAccountRequest
+	(*Rating)(nil),                       // 81: bouncebot.Rating
+	(*GetRatingsRequest)(nil),            // 82: bouncebot.GetRatingsRequest
+	(*GetRatingsResponse)(nil),           // 83: bouncebot.GetRatingsResponse
+	(*PlayerStats)(nil),                  // 84: bouncebot.PlayerStats
+	(*GetLeaderboardRequest)(nil),        // 85: bouncebot.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil),       // 86: bouncebot.GetLeaderboardResponse
+	(*GetPlayerStatsRequest)(nil),        // 87: bouncebot.GetPlayerStatsRequest
+	(*DailyPuzzle)(nil),                  // 88: bouncebot.DailyPuzzle
+	(*GetDailyPuzzleRequest)(nil),        // 89: bouncebot.GetDailyPuzzleRequest
+	(*SubmitDailySolutionRequest)(nil),   // 90: bouncebot.SubmitDailySolutionRequest
+	(*DailyEntry)(nil),                   // 91: bouncebot.DailyEntry
+	(*GetDailyLeaderboardRequest)(nil),   // 92: bouncebot.GetDailyLeaderboardRequest
+	(*GetDailyLeaderboardResponse)(nil),  // 93: bouncebot.GetDailyLeaderboardResponse
+	(*ArchivePuzzle)(nil),                // 94: bouncebot.ArchivePuzzle
+	(*SearchArchiveRequest)(nil),         // 95: bouncebot.SearchArchiveRequest
+	(*SearchArchiveResponse)(nil),        // 96: bouncebot.SearchArchiveResponse
+	(*GetArchivePuzzleRequest)(nil),      // 97: bouncebot.GetArchivePuzzleRequest
+	(*CheckArchiveSolutionRequest)(nil),  // 98: bouncebot.CheckArchiveSolutionRequest
+	(*CheckArchiveSolutionResponse)(nil), // 99: bouncebot.CheckArchiveSolutionResponse
+	(*TournamentEntrant)(nil),            // 100: bouncebot.TournamentEntrant
+	(*TournamentTable)(nil),              // 101: bouncebot.TournamentTable
+	(*TournamentRound)(nil),              // 102: bouncebot.TournamentRound
+	(*Tournament)(nil),                   // 103: bouncebot.Tournament
+	(*CreateTournamentRequest)(nil),      // 104: bouncebot.CreateTournamentRequest
+	(*StartTournamentRequest)(nil),       // 105: bouncebot.StartTournamentRequest
+	(*ReportTableResultRequest)(nil),     // 106: bouncebot.ReportTableResultRequest
+	(*GetTournamentRequest)(nil),         // 107: bouncebot.GetTournamentRequest
+	(*WatchTournamentRequest)(nil),       // 108: bouncebot.WatchTournamentRequest
+	(*RoundStartedEvent)(nil),            // 109: bouncebot.RoundStartedEvent
+	(*TableDecidedEvent)(nil),            // 110: bouncebot.TableDecidedEvent
+	(*TournamentFinishedEvent)(nil),      // 111: bouncebot.TournamentFinishedEvent
+	(*TournamentEvent)(nil),              // 112: bouncebot.TournamentEvent
+	nil,                                  // 113: bouncebot.GameRecord.PlayerNamesEntry
+	nil,                                  // 114: bouncebot.GameRecord.AccountIdsEntry
+	nil,                                  // 115: bouncebot.TeamsChangedEvent.TeamsEntry
+	(*durationpb.Duration)(nil),          // 116: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 117: google.protobuf.Timestamp
 }
 var file_bouncebot_proto_depIdxs = []int32{
-	0,  // 0: bouncebot.Board.v_walls:type_name -> bouncebot.Position
-	0,  // 1: bouncebot.Board.h_walls:type_name -> bouncebot.Position
-	0,  // 2: bouncebot.BotPos.pos:type_name -> bouncebot.Position
-	1,  // 3: bouncebot.Game.board:type_name -> bouncebot.Board
-	2,  // 4: bouncebot.Game.bots:type_name -> bouncebot.BotPos
-	2,  // 5: bouncebot.Game.target:type_name -> bouncebot.BotPos
-	20, // 6: bouncebot.PlayerSolution.solved_at:type_name -> google.protobuf.Timestamp
-	2,  // 7: bouncebot.PlayerSolution.moves:type_name -> bouncebot.BotPos
-	4,  // 8: bouncebot.Room.players:type_name -> bouncebot.Player
-	20, // 9: bouncebot.Room.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 10: bouncebot.Room.current_game:type_name -> bouncebot.Game
-	20, // 11: bouncebot.Room.game_started_at:type_name -> google.protobuf.Timestamp
-	5,  // 12: bouncebot.Room.solutions:type_name -> bouncebot.PlayerSolution
-	6,  // 13: bouncebot.Room.scores:type_name -> bouncebot.PlayerScore
-	2,  // 14: bouncebot.SubmitSolutionRequest.moves:type_name -> bouncebot.BotPos
-	5,  // 15: bouncebot.SubmitSolutionResponse.solution:type_name -> bouncebot.PlayerSolution
-	8,  // 16: bouncebot.BounceBot.CreateRoom:input_type -> bouncebot.CreateRoomRequest
-	9,  // 17: bouncebot.BounceBot.JoinRoom:input_type -> bouncebot.JoinRoomRequest
-	10, // 18: bouncebot.BounceBot.GetRoom:input_type -> bouncebot.GetRoomRequest
-	11, // 19: bouncebot.BounceBot.StartGame:input_type -> bouncebot.StartGameRequest
-	12, // 20: bouncebot.BounceBot.SubmitSolution:input_type -> bouncebot.SubmitSolutionRequest
-	14, // 21: bouncebot.BounceBot.RetractSolution:input_type -> bouncebot.RetractSolutionRequest
-	16, // 22: bouncebot.BounceBot.MarkFinishedSolving:input_type -> bouncebot.MarkFinishedSolvingRequest
-	18, // 23: bouncebot.BounceBot.MarkReadyForNext:input_type -> bouncebot.MarkReadyForNextRequest
-	7,  // 24: bouncebot.BounceBot.CreateRoom:output_type -> bouncebot.Room
-	7,  // 25: bouncebot.BounceBot.JoinRoom:output_type -> bouncebot.Room
-	7,  // 26: bouncebot.BounceBot.GetRoom:output_type -> bouncebot.Room
-	7,  // 27: bouncebot.BounceBot.StartGame:output_type -> bouncebot.Room
-	13, // 28: bouncebot.BounceBot.SubmitSolution:output_type -> bouncebot.SubmitSolutionResponse
-	15, // 29: bouncebot.BounceBot.RetractSolution:output_type -> bouncebot.RetractSolutionResponse
-	17, // 30: bouncebot.BounceBot.MarkFinishedSolving:output_type -> bouncebot.MarkFinishedSolvingResponse
-	19, // 31: bouncebot.BounceBot.MarkReadyForNext:output_type -> bouncebot.MarkReadyForNextResponse
-	24, // [24:32] is the sub-list for method output_type
-	16, // [16:24] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	4,   // 0: bouncebot.Board.v_walls:type_name -> bouncebot.Position
+	4,   // 1: bouncebot.Board.h_walls:type_name -> bouncebot.Position
+	4,   // 2: bouncebot.BotPos.pos:type_name -> bouncebot.Position
+	5,   // 3: bouncebot.Game.board:type_name -> bouncebot.Board
+	6,   // 4: bouncebot.Game.bots:type_name -> bouncebot.BotPos
+	6,   // 5: bouncebot.Game.target:type_name -> bouncebot.BotPos
+	9,   // 6: bouncebot.Player.handicap:type_name -> bouncebot.Handicap
+	116, // 7: bouncebot.Handicap.delay:type_name -> google.protobuf.Duration
+	117, // 8: bouncebot.PlayerSolution.solved_at:type_name -> google.protobuf.Timestamp
+	6,   // 9: bouncebot.PlayerSolution.moves:type_name -> bouncebot.BotPos
+	9,   // 10: bouncebot.PlayerSolution.handicap:type_name -> bouncebot.Handicap
+	8,   // 11: bouncebot.Room.players:type_name -> bouncebot.Player
+	117, // 12: bouncebot.Room.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 13: bouncebot.Room.current_game:type_name -> bouncebot.Game
+	117, // 14: bouncebot.Room.game_started_at:type_name -> google.protobuf.Timestamp
+	11,  // 15: bouncebot.Room.solutions:type_name -> bouncebot.PlayerSolution
+	12,  // 16: bouncebot.Room.scores:type_name -> bouncebot.PlayerScore
+	10,  // 17: bouncebot.Room.spectators:type_name -> bouncebot.Spectator
+	13,  // 18: bouncebot.Room.team_scores:type_name -> bouncebot.TeamScore
+	15,  // 19: bouncebot.Room.match:type_name -> bouncebot.Match
+	117, // 20: bouncebot.Match.started_at:type_name -> google.protobuf.Timestamp
+	117, // 21: bouncebot.Match.ended_at:type_name -> google.protobuf.Timestamp
+	16,  // 22: bouncebot.Match.standings:type_name -> bouncebot.MatchStanding
+	6,   // 23: bouncebot.SubmitSolutionRequest.moves:type_name -> bouncebot.BotPos
+	11,  // 24: bouncebot.SubmitSolutionResponse.solution:type_name -> bouncebot.PlayerSolution
+	14,  // 25: bouncebot.AddBotResponse.room:type_name -> bouncebot.Room
+	6,   // 26: bouncebot.Hint.moves:type_name -> bouncebot.BotPos
+	14,  // 27: bouncebot.SpectateRoomResponse.room:type_name -> bouncebot.Room
+	9,   // 28: bouncebot.SetHandicapRequest.handicap:type_name -> bouncebot.Handicap
+	44,  // 29: bouncebot.SuggestHandicapsResponse.handicaps:type_name -> bouncebot.SuggestedHandicap
+	9,   // 30: bouncebot.SuggestedHandicap.handicap:type_name -> bouncebot.Handicap
+	47,  // 31: bouncebot.GetTeamsResponse.teams:type_name -> bouncebot.TeamStanding
+	11,  // 32: bouncebot.TeamStanding.best_solution:type_name -> bouncebot.PlayerSolution
+	50,  // 33: bouncebot.GetRoomHistoryResponse.games:type_name -> bouncebot.GameRecord
+	7,   // 34: bouncebot.GameRecord.game:type_name -> bouncebot.Game
+	117, // 35: bouncebot.GameRecord.started_at:type_name -> google.protobuf.Timestamp
+	117, // 36: bouncebot.GameRecord.ended_at:type_name -> google.protobuf.Timestamp
+	11,  // 37: bouncebot.GameRecord.solutions:type_name -> bouncebot.PlayerSolution
+	113, // 38: bouncebot.GameRecord.player_names:type_name -> bouncebot.GameRecord.PlayerNamesEntry
+	114, // 39: bouncebot.GameRecord.account_ids:type_name -> bouncebot.GameRecord.AccountIdsEntry
+	7,   // 40: bouncebot.Replay.game:type_name -> bouncebot.Game
+	117, // 41: bouncebot.Replay.started_at:type_name -> google.protobuf.Timestamp
+	117, // 42: bouncebot.Replay.ended_at:type_name -> google.protobuf.Timestamp
+	8,   // 43: bouncebot.Replay.players:type_name -> bouncebot.Player
+	53,  // 44: bouncebot.Replay.events:type_name -> bouncebot.ReplayEvent
+	117, // 45: bouncebot.ReplayEvent.at:type_name -> google.protobuf.Timestamp
+	3,   // 46: bouncebot.ReplayEvent.action:type_name -> bouncebot.ReplayEvent.Action
+	6,   // 47: bouncebot.ReplayEvent.moves:type_name -> bouncebot.BotPos
+	56,  // 48: bouncebot.RoomEvent.player_joined:type_name -> bouncebot.PlayerJoinedEvent
+	57,  // 49: bouncebot.RoomEvent.player_left:type_name -> bouncebot.PlayerLeftEvent
+	58,  // 50: bouncebot.RoomEvent.game_started:type_name -> bouncebot.GameStartedEvent
+	59,  // 51: bouncebot.RoomEvent.player_finished_solving:type_name -> bouncebot.PlayerFinishedSolvingEvent
+	60,  // 52: bouncebot.RoomEvent.player_ready_for_next:type_name -> bouncebot.PlayerReadyForNextEvent
+	61,  // 53: bouncebot.RoomEvent.player_solved:type_name -> bouncebot.PlayerSolvedEvent
+	62,  // 54: bouncebot.RoomEvent.solution_retracted:type_name -> bouncebot.SolutionRetractedEvent
+	63,  // 55: bouncebot.RoomEvent.game_ended:type_name -> bouncebot.GameEndedEvent
+	68,  // 56: bouncebot.RoomEvent.spectator_joined:type_name -> bouncebot.SpectatorJoinedEvent
+	69,  // 57: bouncebot.RoomEvent.spectator_left:type_name -> bouncebot.SpectatorLeftEvent
+	70,  // 58: bouncebot.RoomEvent.room_closed:type_name -> bouncebot.RoomClosedEvent
+	75,  // 59: bouncebot.RoomEvent.ack:type_name -> bouncebot.ActionAck
+	76,  // 60: bouncebot.RoomEvent.resync:type_name -> bouncebot.ResyncEvent
+	71,  // 61: bouncebot.RoomEvent.teams_changed:type_name -> bouncebot.TeamsChangedEvent
+	72,  // 62: bouncebot.RoomEvent.match_started:type_name -> bouncebot.MatchStartedEvent
+	73,  // 63: bouncebot.RoomEvent.match_over:type_name -> bouncebot.MatchOverEvent
+	74,  // 64: bouncebot.RoomEvent.handicap_changed:type_name -> bouncebot.HandicapChangedEvent
+	64,  // 65: bouncebot.RoomEvent.game_analysed:type_name -> bouncebot.GameAnalysedEvent
+	14,  // 66: bouncebot.RoomEvent.room:type_name -> bouncebot.Room
+	7,   // 67: bouncebot.GameStartedEvent.game:type_name -> bouncebot.Game
+	6,   // 68: bouncebot.GameEndedEvent.moves:type_name -> bouncebot.BotPos
+	65,  // 69: bouncebot.GameAnalysedEvent.analysis:type_name -> bouncebot.GameAnalysis
+	66,  // 70: bouncebot.GameAnalysis.optimal:type_name -> bouncebot.SolutionPath
+	67,  // 71: bouncebot.GameAnalysis.players:type_name -> bouncebot.SolutionAnalysis
+	6,   // 72: bouncebot.SolutionPath.moves:type_name -> bouncebot.BotPos
+	66,  // 73: bouncebot.SolutionAnalysis.solution:type_name -> bouncebot.SolutionPath
+	115, // 74: bouncebot.TeamsChangedEvent.teams:type_name -> bouncebot.TeamsChangedEvent.TeamsEntry
+	16,  // 75: bouncebot.MatchOverEvent.standings:type_name -> bouncebot.MatchStanding
+	9,   // 76: bouncebot.HandicapChangedEvent.handicap:type_name -> bouncebot.Handicap
+	117, // 77: bouncebot.Account.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 78: bouncebot.CreateAccountResponse.account:type_name -> bouncebot.Account
+	117, // 79: bouncebot.Rating.updated_at:type_name -> google.protobuf.Timestamp
+	81,  // 80: bouncebot.GetRatingsResponse.ratings:type_name -> bouncebot.Rating
+	116, // 81: bouncebot.PlayerStats.fastest_solve:type_name -> google.protobuf.Duration
+	117, // 82: bouncebot.PlayerStats.last_played_at:type_name -> google.protobuf.Timestamp
+	0,   // 83: bouncebot.GetLeaderboardRequest.window:type_name -> bouncebot.StatsWindow
+	1,   // 84: bouncebot.GetLeaderboardRequest.order:type_name -> bouncebot.LeaderboardOrder
+	84,  // 85: bouncebot.GetLeaderboardResponse.players:type_name -> bouncebot.PlayerStats
+	0,   // 86: bouncebot.GetPlayerStatsRequest.window:type_name -> bouncebot.StatsWindow
+	7,   // 87: bouncebot.DailyPuzzle.game:type_name -> bouncebot.Game
+	117, // 88: bouncebot.DailyPuzzle.started_at:type_name -> google.protobuf.Timestamp
+	6,   // 89: bouncebot.SubmitDailySolutionRequest.moves:type_name -> bouncebot.BotPos
+	116, // 90: bouncebot.DailyEntry.time:type_name -> google.protobuf.Duration
+	117, // 91: bouncebot.DailyEntry.submitted_at:type_name -> google.protobuf.Timestamp
+	91,  // 92: bouncebot.GetDailyLeaderboardResponse.entries:type_name -> bouncebot.DailyEntry
+	7,   // 93: bouncebot.ArchivePuzzle.game:type_name -> bouncebot.Game
+	6,   // 94: bouncebot.ArchivePuzzle.solution:type_name -> bouncebot.BotPos
+	2,   // 95: bouncebot.SearchArchiveRequest.helpers:type_name -> bouncebot.HelperFilter
+	94,  // 96: bouncebot.SearchArchiveResponse.puzzles:type_name -> bouncebot.ArchivePuzzle
+	6,   // 97: bouncebot.CheckArchiveSolutionRequest.moves:type_name -> bouncebot.BotPos
+	101, // 98: bouncebot.TournamentRound.tables:type_name -> bouncebot.TournamentTable
+	100, // 99: bouncebot.Tournament.entrants:type_name -> bouncebot.TournamentEntrant
+	102, // 100: bouncebot.Tournament.bracket:type_name -> bouncebot.TournamentRound
+	117, // 101: bouncebot.Tournament.created_at:type_name -> google.protobuf.Timestamp
+	117, // 102: bouncebot.Tournament.started_at:type_name -> google.protobuf.Timestamp
+	117, // 103: bouncebot.Tournament.ended_at:type_name -> google.protobuf.Timestamp
+	109, // 104: bouncebot.TournamentEvent.round_started:type_name -> bouncebot.RoundStartedEvent
+	110, // 105: bouncebot.TournamentEvent.table_decided:type_name -> bouncebot.TableDecidedEvent
+	111, // 106: bouncebot.TournamentEvent.finished:type_name -> bouncebot.TournamentFinishedEvent
+	103, // 107: bouncebot.TournamentEvent.tournament:type_name -> bouncebot.Tournament
+	17,  // 108: bouncebot.BounceBot.CreateRoom:input_type -> bouncebot.CreateRoomRequest
+	18,  // 109: bouncebot.BounceBot.JoinRoom:input_type -> bouncebot.JoinRoomRequest
+	19,  // 110: bouncebot.BounceBot.GetRoom:input_type -> bouncebot.GetRoomRequest
+	20,  // 111: bouncebot.BounceBot.StartGame:input_type -> bouncebot.StartGameRequest
+	21,  // 112: bouncebot.BounceBot.SubmitSolution:input_type -> bouncebot.SubmitSolutionRequest
+	23,  // 113: bouncebot.BounceBot.RetractSolution:input_type -> bouncebot.RetractSolutionRequest
+	25,  // 114: bouncebot.BounceBot.MarkFinishedSolving:input_type -> bouncebot.MarkFinishedSolvingRequest
+	27,  // 115: bouncebot.BounceBot.MarkReadyForNext:input_type -> bouncebot.MarkReadyForNextRequest
+	34,  // 116: bouncebot.BounceBot.SpectateRoom:input_type -> bouncebot.SpectateRoomRequest
+	48,  // 117: bouncebot.BounceBot.GetRoomHistory:input_type -> bouncebot.GetRoomHistoryRequest
+	51,  // 118: bouncebot.BounceBot.ExportReplay:input_type -> bouncebot.ExportReplayRequest
+	29,  // 119: bouncebot.BounceBot.AddBot:input_type -> bouncebot.AddBotRequest
+	31,  // 120: bouncebot.BounceBot.RemoveBot:input_type -> bouncebot.RemoveBotRequest
+	32,  // 121: bouncebot.BounceBot.RequestHint:input_type -> bouncebot.RequestHintRequest
+	36,  // 122: bouncebot.BounceBot.SetTeam:input_type -> bouncebot.SetTeamRequest
+	37,  // 123: bouncebot.BounceBot.AssignTeams:input_type -> bouncebot.AssignTeamsRequest
+	38,  // 124: bouncebot.BounceBot.SetTeamQuorum:input_type -> bouncebot.SetTeamQuorumRequest
+	45,  // 125: bouncebot.BounceBot.GetTeams:input_type -> bouncebot.GetTeamsRequest
+	39,  // 126: bouncebot.BounceBot.StartMatch:input_type -> bouncebot.StartMatchRequest
+	40,  // 127: bouncebot.BounceBot.StopMatch:input_type -> bouncebot.StopMatchRequest
+	41,  // 128: bouncebot.BounceBot.SetHandicap:input_type -> bouncebot.SetHandicapRequest
+	42,  // 129: bouncebot.BounceBot.SuggestHandicaps:input_type -> bouncebot.SuggestHandicapsRequest
+	78,  // 130: bouncebot.BounceBot.CreateAccount:input_type -> bouncebot.CreateAccountRequest
+	80,  // 131: bouncebot.BounceBot.ClaimAccount:input_type -> bouncebot.ClaimAccountRequest
+	82,  // 132: bouncebot.BounceBot.GetRatings:input_type -> bouncebot.GetRatingsRequest
+	85,  // 133: bouncebot.BounceBot.GetLeaderboard:input_type -> bouncebot.GetLeaderboardRequest
+	87,  // 134: bouncebot.BounceBot.GetPlayerStats:input_type -> bouncebot.GetPlayerStatsRequest
+	89,  // 135: bouncebot.BounceBot.GetDailyPuzzle:input_type -> bouncebot.GetDailyPuzzleRequest
+	90,  // 136: bouncebot.BounceBot.SubmitDailySolution:input_type -> bouncebot.SubmitDailySolutionRequest
+	92,  // 137: bouncebot.BounceBot.GetDailyLeaderboard:input_type -> bouncebot.GetDailyLeaderboardRequest
+	95,  // 138: bouncebot.BounceBot.SearchArchive:input_type -> bouncebot.SearchArchiveRequest
+	97,  // 139: bouncebot.BounceBot.GetArchivePuzzle:input_type -> bouncebot.GetArchivePuzzleRequest
+	98,  // 140: bouncebot.BounceBot.CheckArchiveSolution:input_type -> bouncebot.CheckArchiveSolutionRequest
+	54,  // 141: bouncebot.BounceBot.WatchRoom:input_type -> bouncebot.WatchRoomRequest
+	104, // 142: bouncebot.BounceBot.CreateTournament:input_type -> bouncebot.CreateTournamentRequest
+	105, // 143: bouncebot.BounceBot.StartTournament:input_type -> bouncebot.StartTournamentRequest
+	106, // 144: bouncebot.BounceBot.ReportTableResult:input_type -> bouncebot.ReportTableResultRequest
+	107, // 145: bouncebot.BounceBot.GetTournament:input_type -> bouncebot.GetTournamentRequest
+	108, // 146: bouncebot.BounceBot.WatchTournament:input_type -> bouncebot.WatchTournamentRequest
+	14,  // 147: bouncebot.BounceBot.CreateRoom:output_type -> bouncebot.Room
+	14,  // 148: bouncebot.BounceBot.JoinRoom:output_type -> bouncebot.Room
+	14,  // 149: bouncebot.BounceBot.GetRoom:output_type -> bouncebot.Room
+	14,  // 150: bouncebot.BounceBot.StartGame:output_type -> bouncebot.Room
+	22,  // 151: bouncebot.BounceBot.SubmitSolution:output_type -> bouncebot.SubmitSolutionResponse
+	24,  // 152: bouncebot.BounceBot.RetractSolution:output_type -> bouncebot.RetractSolutionResponse
+	26,  // 153: bouncebot.BounceBot.MarkFinishedSolving:output_type -> bouncebot.MarkFinishedSolvingResponse
+	28,  // 154: bouncebot.BounceBot.MarkReadyForNext:output_type -> bouncebot.MarkReadyForNextResponse
+	35,  // 155: bouncebot.BounceBot.SpectateRoom:output_type -> bouncebot.SpectateRoomResponse
+	49,  // 156: bouncebot.BounceBot.GetRoomHistory:output_type -> bouncebot.GetRoomHistoryResponse
+	52,  // 157: bouncebot.BounceBot.ExportReplay:output_type -> bouncebot.Replay
+	30,  // 158: bouncebot.BounceBot.AddBot:output_type -> bouncebot.AddBotResponse
+	14,  // 159: bouncebot.BounceBot.RemoveBot:output_type -> bouncebot.Room
+	33,  // 160: bouncebot.BounceBot.RequestHint:output_type -> bouncebot.Hint
+	14,  // 161: bouncebot.BounceBot.SetTeam:output_type -> bouncebot.Room
+	14,  // 162: bouncebot.BounceBot.AssignTeams:output_type -> bouncebot.Room
+	14,  // 163: bouncebot.BounceBot.SetTeamQuorum:output_type -> bouncebot.Room
+	46,  // 164: bouncebot.BounceBot.GetTeams:output_type -> bouncebot.GetTeamsResponse
+	14,  // 165: bouncebot.BounceBot.StartMatch:output_type -> bouncebot.Room
+	14,  // 166: bouncebot.BounceBot.StopMatch:output_type -> bouncebot.Room
+	14,  // 167: bouncebot.BounceBot.SetHandicap:output_type -> bouncebot.Room
+	43,  // 168: bouncebot.BounceBot.SuggestHandicaps:output_type -> bouncebot.SuggestHandicapsResponse
+	79,  // 169: bouncebot.BounceBot.CreateAccount:output_type -> bouncebot.CreateAccountResponse
+	77,  // 170: bouncebot.BounceBot.ClaimAccount:output_type -> bouncebot.Account
+	83,  // 171: bouncebot.BounceBot.GetRatings:output_type -> bouncebot.GetRatingsResponse
+	86,  // 172: bouncebot.BounceBot.GetLeaderboard:output_type -> bouncebot.GetLeaderboardResponse
+	84,  // 173: bouncebot.BounceBot.GetPlayerStats:output_type -> bouncebot.PlayerStats
+	88,  // 174: bouncebot.BounceBot.GetDailyPuzzle:output_type -> bouncebot.DailyPuzzle
+	91,  // 175: bouncebot.BounceBot.SubmitDailySolution:output_type -> bouncebot.DailyEntry
+	93,  // 176: bouncebot.BounceBot.GetDailyLeaderboard:output_type -> bouncebot.GetDailyLeaderboardResponse
+	96,  // 177: bouncebot.BounceBot.SearchArchive:output_type -> bouncebot.SearchArchiveResponse
+	94,  // 178: bouncebot.BounceBot.GetArchivePuzzle:output_type -> bouncebot.ArchivePuzzle
+	99,  // 179: bouncebot.BounceBot.CheckArchiveSolution:output_type -> bouncebot.CheckArchiveSolutionResponse
+	55,  // 180: bouncebot.BounceBot.WatchRoom:output_type -> bouncebot.RoomEvent
+	103, // 181: bouncebot.BounceBot.CreateTournament:output_type -> bouncebot.Tournament
+	103, // 182: bouncebot.BounceBot.StartTournament:output_type -> bouncebot.Tournament
+	103, // 183: bouncebot.BounceBot.ReportTableResult:output_type -> bouncebot.Tournament
+	103, // 184: bouncebot.BounceBot.GetTournament:output_type -> bouncebot.Tournament
+	112, // 185: bouncebot.BounceBot.WatchTournament:output_type -> bouncebot.TournamentEvent
+	147, // [147:186] is the sub-list for method output_type
+	108, // [108:147] is the sub-list for method input_type
+	108, // [108:108] is the sub-list for extension type_name
+	108, // [108:108] is the sub-list for extension extendee
+	0,   // [0:108] is the sub-list for field type_name
 }
 
 func init() { file_bouncebot_proto_init() }
@@ -1280,18 +7928,44 @@ func file_bouncebot_proto_init() {
 	if File_bouncebot_proto != nil {
 		return
 	}
+	file_bouncebot_proto_msgTypes[51].OneofWrappers = []any{
+		(*RoomEvent_PlayerJoined)(nil),
+		(*RoomEvent_PlayerLeft)(nil),
+		(*RoomEvent_GameStarted)(nil),
+		(*RoomEvent_PlayerFinishedSolving)(nil),
+		(*RoomEvent_PlayerReadyForNext)(nil),
+		(*RoomEvent_PlayerSolved)(nil),
+		(*RoomEvent_SolutionRetracted)(nil),
+		(*RoomEvent_GameEnded)(nil),
+		(*RoomEvent_SpectatorJoined)(nil),
+		(*RoomEvent_SpectatorLeft)(nil),
+		(*RoomEvent_RoomClosed)(nil),
+		(*RoomEvent_Ack)(nil),
+		(*RoomEvent_Resync)(nil),
+		(*RoomEvent_TeamsChanged)(nil),
+		(*RoomEvent_MatchStarted)(nil),
+		(*RoomEvent_MatchOver)(nil),
+		(*RoomEvent_HandicapChanged)(nil),
+		(*RoomEvent_GameAnalysed)(nil),
+	}
+	file_bouncebot_proto_msgTypes[108].OneofWrappers = []any{
+		(*TournamentEvent_RoundStarted)(nil),
+		(*TournamentEvent_TableDecided)(nil),
+		(*TournamentEvent_Finished)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bouncebot_proto_rawDesc), len(file_bouncebot_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      4,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_bouncebot_proto_goTypes,
 		DependencyIndexes: file_bouncebot_proto_depIdxs,
+		EnumInfos:         file_bouncebot_proto_enumTypes,
 		MessageInfos:      file_bouncebot_proto_msgTypes,
 	}.Build()
 	File_bouncebot_proto = out.File
@@ -19,14 +19,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BounceBot_CreateRoom_FullMethodName          = "/bouncebot.BounceBot/CreateRoom"
-	BounceBot_JoinRoom_FullMethodName            = "/bouncebot.BounceBot/JoinRoom"
-	BounceBot_GetRoom_FullMethodName             = "/bouncebot.BounceBot/GetRoom"
-	BounceBot_StartGame_FullMethodName           = "/bouncebot.BounceBot/StartGame"
-	BounceBot_SubmitSolution_FullMethodName      = "/bouncebot.BounceBot/SubmitSolution"
-	BounceBot_RetractSolution_FullMethodName     = "/bouncebot.BounceBot/RetractSolution"
-	BounceBot_MarkFinishedSolving_FullMethodName = "/bouncebot.BounceBot/MarkFinishedSolving"
-	BounceBot_MarkReadyForNext_FullMethodName    = "/bouncebot.BounceBot/MarkReadyForNext"
+	BounceBot_CreateRoom_FullMethodName           = "/bouncebot.BounceBot/CreateRoom"
+	BounceBot_JoinRoom_FullMethodName             = "/bouncebot.BounceBot/JoinRoom"
+	BounceBot_GetRoom_FullMethodName              = "/bouncebot.BounceBot/GetRoom"
+	BounceBot_StartGame_FullMethodName            = "/bouncebot.BounceBot/StartGame"
+	BounceBot_SubmitSolution_FullMethodName       = "/bouncebot.BounceBot/SubmitSolution"
+	BounceBot_RetractSolution_FullMethodName      = "/bouncebot.BounceBot/RetractSolution"
+	BounceBot_MarkFinishedSolving_FullMethodName  = "/bouncebot.BounceBot/MarkFinishedSolving"
+	BounceBot_MarkReadyForNext_FullMethodName     = "/bouncebot.BounceBot/MarkReadyForNext"
+	BounceBot_SpectateRoom_FullMethodName         = "/bouncebot.BounceBot/SpectateRoom"
+	BounceBot_GetRoomHistory_FullMethodName       = "/bouncebot.BounceBot/GetRoomHistory"
+	BounceBot_ExportReplay_FullMethodName         = "/bouncebot.BounceBot/ExportReplay"
+	BounceBot_AddBot_FullMethodName               = "/bouncebot.BounceBot/AddBot"
+	BounceBot_RemoveBot_FullMethodName            = "/bouncebot.BounceBot/RemoveBot"
+	BounceBot_RequestHint_FullMethodName          = "/bouncebot.BounceBot/RequestHint"
+	BounceBot_SetTeam_FullMethodName              = "/bouncebot.BounceBot/SetTeam"
+	BounceBot_AssignTeams_FullMethodName          = "/bouncebot.BounceBot/AssignTeams"
+	BounceBot_SetTeamQuorum_FullMethodName        = "/bouncebot.BounceBot/SetTeamQuorum"
+	BounceBot_GetTeams_FullMethodName             = "/bouncebot.BounceBot/GetTeams"
+	BounceBot_StartMatch_FullMethodName           = "/bouncebot.BounceBot/StartMatch"
+	BounceBot_StopMatch_FullMethodName            = "/bouncebot.BounceBot/StopMatch"
+	BounceBot_SetHandicap_FullMethodName          = "/bouncebot.BounceBot/SetHandicap"
+	BounceBot_SuggestHandicaps_FullMethodName     = "/bouncebot.BounceBot/SuggestHandicaps"
+	BounceBot_CreateAccount_FullMethodName        = "/bouncebot.BounceBot/CreateAccount"
+	BounceBot_ClaimAccount_FullMethodName         = "/bouncebot.BounceBot/ClaimAccount"
+	BounceBot_GetRatings_FullMethodName           = "/bouncebot.BounceBot/GetRatings"
+	BounceBot_GetLeaderboard_FullMethodName       = "/bouncebot.BounceBot/GetLeaderboard"
+	BounceBot_GetPlayerStats_FullMethodName       = "/bouncebot.BounceBot/GetPlayerStats"
+	BounceBot_GetDailyPuzzle_FullMethodName       = "/bouncebot.BounceBot/GetDailyPuzzle"
+	BounceBot_SubmitDailySolution_FullMethodName  = "/bouncebot.BounceBot/SubmitDailySolution"
+	BounceBot_GetDailyLeaderboard_FullMethodName  = "/bouncebot.BounceBot/GetDailyLeaderboard"
+	BounceBot_SearchArchive_FullMethodName        = "/bouncebot.BounceBot/SearchArchive"
+	BounceBot_GetArchivePuzzle_FullMethodName     = "/bouncebot.BounceBot/GetArchivePuzzle"
+	BounceBot_CheckArchiveSolution_FullMethodName = "/bouncebot.BounceBot/CheckArchiveSolution"
+	BounceBot_WatchRoom_FullMethodName            = "/bouncebot.BounceBot/WatchRoom"
+	BounceBot_CreateTournament_FullMethodName     = "/bouncebot.BounceBot/CreateTournament"
+	BounceBot_StartTournament_FullMethodName      = "/bouncebot.BounceBot/StartTournament"
+	BounceBot_ReportTableResult_FullMethodName    = "/bouncebot.BounceBot/ReportTableResult"
+	BounceBot_GetTournament_FullMethodName        = "/bouncebot.BounceBot/GetTournament"
+	BounceBot_WatchTournament_FullMethodName      = "/bouncebot.BounceBot/WatchTournament"
 )
 
 // BounceBotClient is the client API for BounceBot service.
@@ -44,6 +75,42 @@ type BounceBotClient interface {
 	RetractSolution(ctx context.Context, in *RetractSolutionRequest, opts ...grpc.CallOption) (*RetractSolutionResponse, error)
 	MarkFinishedSolving(ctx context.Context, in *MarkFinishedSolvingRequest, opts ...grpc.CallOption) (*MarkFinishedSolvingResponse, error)
 	MarkReadyForNext(ctx context.Context, in *MarkReadyForNextRequest, opts ...grpc.CallOption) (*MarkReadyForNextResponse, error)
+	SpectateRoom(ctx context.Context, in *SpectateRoomRequest, opts ...grpc.CallOption) (*SpectateRoomResponse, error)
+	GetRoomHistory(ctx context.Context, in *GetRoomHistoryRequest, opts ...grpc.CallOption) (*GetRoomHistoryResponse, error)
+	ExportReplay(ctx context.Context, in *ExportReplayRequest, opts ...grpc.CallOption) (*Replay, error)
+	AddBot(ctx context.Context, in *AddBotRequest, opts ...grpc.CallOption) (*AddBotResponse, error)
+	RemoveBot(ctx context.Context, in *RemoveBotRequest, opts ...grpc.CallOption) (*Room, error)
+	RequestHint(ctx context.Context, in *RequestHintRequest, opts ...grpc.CallOption) (*Hint, error)
+	SetTeam(ctx context.Context, in *SetTeamRequest, opts ...grpc.CallOption) (*Room, error)
+	AssignTeams(ctx context.Context, in *AssignTeamsRequest, opts ...grpc.CallOption) (*Room, error)
+	SetTeamQuorum(ctx context.Context, in *SetTeamQuorumRequest, opts ...grpc.CallOption) (*Room, error)
+	GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error)
+	StartMatch(ctx context.Context, in *StartMatchRequest, opts ...grpc.CallOption) (*Room, error)
+	StopMatch(ctx context.Context, in *StopMatchRequest, opts ...grpc.CallOption) (*Room, error)
+	SetHandicap(ctx context.Context, in *SetHandicapRequest, opts ...grpc.CallOption) (*Room, error)
+	SuggestHandicaps(ctx context.Context, in *SuggestHandicapsRequest, opts ...grpc.CallOption) (*SuggestHandicapsResponse, error)
+	// Player accounts
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	ClaimAccount(ctx context.Context, in *ClaimAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error)
+	// Daily puzzle
+	GetDailyPuzzle(ctx context.Context, in *GetDailyPuzzleRequest, opts ...grpc.CallOption) (*DailyPuzzle, error)
+	SubmitDailySolution(ctx context.Context, in *SubmitDailySolutionRequest, opts ...grpc.CallOption) (*DailyEntry, error)
+	GetDailyLeaderboard(ctx context.Context, in *GetDailyLeaderboardRequest, opts ...grpc.CallOption) (*GetDailyLeaderboardResponse, error)
+	// Puzzle archive
+	SearchArchive(ctx context.Context, in *SearchArchiveRequest, opts ...grpc.CallOption) (*SearchArchiveResponse, error)
+	GetArchivePuzzle(ctx context.Context, in *GetArchivePuzzleRequest, opts ...grpc.CallOption) (*ArchivePuzzle, error)
+	CheckArchiveSolution(ctx context.Context, in *CheckArchiveSolutionRequest, opts ...grpc.CallOption) (*CheckArchiveSolutionResponse, error)
+	// Room events (alternative to the WebSocket channel)
+	WatchRoom(ctx context.Context, in *WatchRoomRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RoomEvent], error)
+	// Tournaments; creating, starting and reporting results need the admin token
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	StartTournament(ctx context.Context, in *StartTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	ReportTableResult(ctx context.Context, in *ReportTableResultRequest, opts ...grpc.CallOption) (*Tournament, error)
+	GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	WatchTournament(ctx context.Context, in *WatchTournamentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TournamentEvent], error)
 }
 
 type bounceBotClient struct {
@@ -134,6 +201,334 @@ func (c *bounceBotClient) MarkReadyForNext(ctx context.Context, in *MarkReadyFor
 	return out, nil
 }
 
+func (c *bounceBotClient) SpectateRoom(ctx context.Context, in *SpectateRoomRequest, opts ...grpc.CallOption) (*SpectateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpectateRoomResponse)
+	err := c.cc.Invoke(ctx, BounceBot_SpectateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetRoomHistory(ctx context.Context, in *GetRoomHistoryRequest, opts ...grpc.CallOption) (*GetRoomHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomHistoryResponse)
+	err := c.cc.Invoke(ctx, BounceBot_GetRoomHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) ExportReplay(ctx context.Context, in *ExportReplayRequest, opts ...grpc.CallOption) (*Replay, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Replay)
+	err := c.cc.Invoke(ctx, BounceBot_ExportReplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) AddBot(ctx context.Context, in *AddBotRequest, opts ...grpc.CallOption) (*AddBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBotResponse)
+	err := c.cc.Invoke(ctx, BounceBot_AddBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) RemoveBot(ctx context.Context, in *RemoveBotRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_RemoveBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) RequestHint(ctx context.Context, in *RequestHintRequest, opts ...grpc.CallOption) (*Hint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hint)
+	err := c.cc.Invoke(ctx, BounceBot_RequestHint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) SetTeam(ctx context.Context, in *SetTeamRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_SetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) AssignTeams(ctx context.Context, in *AssignTeamsRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_AssignTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) SetTeamQuorum(ctx context.Context, in *SetTeamQuorumRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_SetTeamQuorum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamsResponse)
+	err := c.cc.Invoke(ctx, BounceBot_GetTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) StartMatch(ctx context.Context, in *StartMatchRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_StartMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) StopMatch(ctx context.Context, in *StopMatchRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_StopMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) SetHandicap(ctx context.Context, in *SetHandicapRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, BounceBot_SetHandicap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) SuggestHandicaps(ctx context.Context, in *SuggestHandicapsRequest, opts ...grpc.CallOption) (*SuggestHandicapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestHandicapsResponse)
+	err := c.cc.Invoke(ctx, BounceBot_SuggestHandicaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, BounceBot_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) ClaimAccount(ctx context.Context, in *ClaimAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, BounceBot_ClaimAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatingsResponse)
+	err := c.cc.Invoke(ctx, BounceBot_GetRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, BounceBot_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerStats)
+	err := c.cc.Invoke(ctx, BounceBot_GetPlayerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetDailyPuzzle(ctx context.Context, in *GetDailyPuzzleRequest, opts ...grpc.CallOption) (*DailyPuzzle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyPuzzle)
+	err := c.cc.Invoke(ctx, BounceBot_GetDailyPuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) SubmitDailySolution(ctx context.Context, in *SubmitDailySolutionRequest, opts ...grpc.CallOption) (*DailyEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyEntry)
+	err := c.cc.Invoke(ctx, BounceBot_SubmitDailySolution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetDailyLeaderboard(ctx context.Context, in *GetDailyLeaderboardRequest, opts ...grpc.CallOption) (*GetDailyLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyLeaderboardResponse)
+	err := c.cc.Invoke(ctx, BounceBot_GetDailyLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) SearchArchive(ctx context.Context, in *SearchArchiveRequest, opts ...grpc.CallOption) (*SearchArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArchiveResponse)
+	err := c.cc.Invoke(ctx, BounceBot_SearchArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetArchivePuzzle(ctx context.Context, in *GetArchivePuzzleRequest, opts ...grpc.CallOption) (*ArchivePuzzle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePuzzle)
+	err := c.cc.Invoke(ctx, BounceBot_GetArchivePuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) CheckArchiveSolution(ctx context.Context, in *CheckArchiveSolutionRequest, opts ...grpc.CallOption) (*CheckArchiveSolutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckArchiveSolutionResponse)
+	err := c.cc.Invoke(ctx, BounceBot_CheckArchiveSolution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) WatchRoom(ctx context.Context, in *WatchRoomRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RoomEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BounceBot_ServiceDesc.Streams[0], BounceBot_WatchRoom_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRoomRequest, RoomEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BounceBot_WatchRoomClient = grpc.ServerStreamingClient[RoomEvent]
+
+func (c *bounceBotClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, BounceBot_CreateTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) StartTournament(ctx context.Context, in *StartTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, BounceBot_StartTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) ReportTableResult(ctx context.Context, in *ReportTableResultRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, BounceBot_ReportTableResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, BounceBot_GetTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bounceBotClient) WatchTournament(ctx context.Context, in *WatchTournamentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TournamentEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BounceBot_ServiceDesc.Streams[1], BounceBot_WatchTournament_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTournamentRequest, TournamentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BounceBot_WatchTournamentClient = grpc.ServerStreamingClient[TournamentEvent]
+
 // BounceBotServer is the server API for BounceBot service.
 // All implementations must embed UnimplementedBounceBotServer
 // for forward compatibility.
@@ -149,205 +544,878 @@ type BounceBotServer interface {
 	RetractSolution(context.Context, *RetractSolutionRequest) (*RetractSolutionResponse, error)
 	MarkFinishedSolving(context.Context, *MarkFinishedSolvingRequest) (*MarkFinishedSolvingResponse, error)
 	MarkReadyForNext(context.Context, *MarkReadyForNextRequest) (*MarkReadyForNextResponse, error)
+	SpectateRoom(context.Context, *SpectateRoomRequest) (*SpectateRoomResponse, error)
+	GetRoomHistory(context.Context, *GetRoomHistoryRequest) (*GetRoomHistoryResponse, error)
+	ExportReplay(context.Context, *ExportReplayRequest) (*Replay, error)
+	AddBot(context.Context, *AddBotRequest) (*AddBotResponse, error)
+	RemoveBot(context.Context, *RemoveBotRequest) (*Room, error)
+	RequestHint(context.Context, *RequestHintRequest) (*Hint, error)
+	SetTeam(context.Context, *SetTeamRequest) (*Room, error)
+	AssignTeams(context.Context, *AssignTeamsRequest) (*Room, error)
+	SetTeamQuorum(context.Context, *SetTeamQuorumRequest) (*Room, error)
+	GetTeams(context.Context, *GetTeamsRequest) (*GetTeamsResponse, error)
+	StartMatch(context.Context, *StartMatchRequest) (*Room, error)
+	StopMatch(context.Context, *StopMatchRequest) (*Room, error)
+	SetHandicap(context.Context, *SetHandicapRequest) (*Room, error)
+	SuggestHandicaps(context.Context, *SuggestHandicapsRequest) (*SuggestHandicapsResponse, error)
+	// Player accounts
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	ClaimAccount(context.Context, *ClaimAccountRequest) (*Account, error)
+	GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*PlayerStats, error)
+	// Daily puzzle
+	GetDailyPuzzle(context.Context, *GetDailyPuzzleRequest) (*DailyPuzzle, error)
+	SubmitDailySolution(context.Context, *SubmitDailySolutionRequest) (*DailyEntry, error)
+	GetDailyLeaderboard(context.Context, *GetDailyLeaderboardRequest) (*GetDailyLeaderboardResponse, error)
+	// Puzzle archive
+	SearchArchive(context.Context, *SearchArchiveRequest) (*SearchArchiveResponse, error)
+	GetArchivePuzzle(context.Context, *GetArchivePuzzleRequest) (*ArchivePuzzle, error)
+	CheckArchiveSolution(context.Context, *CheckArchiveSolutionRequest) (*CheckArchiveSolutionResponse, error)
+	// Room events (alternative to the WebSocket channel)
+	WatchRoom(*WatchRoomRequest, grpc.ServerStreamingServer[RoomEvent]) error
+	// Tournaments; creating, starting and reporting results need the admin token
+	CreateTournament(context.Context, *CreateTournamentRequest) (*Tournament, error)
+	StartTournament(context.Context, *StartTournamentRequest) (*Tournament, error)
+	ReportTableResult(context.Context, *ReportTableResultRequest) (*Tournament, error)
+	GetTournament(context.Context, *GetTournamentRequest) (*Tournament, error)
+	WatchTournament(*WatchTournamentRequest, grpc.ServerStreamingServer[TournamentEvent]) error
 	mustEmbedUnimplementedBounceBotServer()
 }
 
-// UnimplementedBounceBotServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBounceBotServer struct{}
-
-func (UnimplementedBounceBotServer) CreateRoom(context.Context, *CreateRoomRequest) (*Room, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
-}
-func (UnimplementedBounceBotServer) JoinRoom(context.Context, *JoinRoomRequest) (*Room, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+// UnimplementedBounceBotServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBounceBotServer struct{}
+
+func (UnimplementedBounceBotServer) CreateRoom(context.Context, *CreateRoomRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedBounceBotServer) JoinRoom(context.Context, *JoinRoomRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (UnimplementedBounceBotServer) GetRoom(context.Context, *GetRoomRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoom not implemented")
+}
+func (UnimplementedBounceBotServer) StartGame(context.Context, *StartGameRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedBounceBotServer) SubmitSolution(context.Context, *SubmitSolutionRequest) (*SubmitSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
+}
+func (UnimplementedBounceBotServer) RetractSolution(context.Context, *RetractSolutionRequest) (*RetractSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractSolution not implemented")
+}
+func (UnimplementedBounceBotServer) MarkFinishedSolving(context.Context, *MarkFinishedSolvingRequest) (*MarkFinishedSolvingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkFinishedSolving not implemented")
+}
+func (UnimplementedBounceBotServer) MarkReadyForNext(context.Context, *MarkReadyForNextRequest) (*MarkReadyForNextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkReadyForNext not implemented")
+}
+func (UnimplementedBounceBotServer) SpectateRoom(context.Context, *SpectateRoomRequest) (*SpectateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpectateRoom not implemented")
+}
+func (UnimplementedBounceBotServer) GetRoomHistory(context.Context, *GetRoomHistoryRequest) (*GetRoomHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomHistory not implemented")
+}
+func (UnimplementedBounceBotServer) ExportReplay(context.Context, *ExportReplayRequest) (*Replay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReplay not implemented")
+}
+func (UnimplementedBounceBotServer) AddBot(context.Context, *AddBotRequest) (*AddBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBot not implemented")
+}
+func (UnimplementedBounceBotServer) RemoveBot(context.Context, *RemoveBotRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBot not implemented")
+}
+func (UnimplementedBounceBotServer) RequestHint(context.Context, *RequestHintRequest) (*Hint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestHint not implemented")
+}
+func (UnimplementedBounceBotServer) SetTeam(context.Context, *SetTeamRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeam not implemented")
+}
+func (UnimplementedBounceBotServer) AssignTeams(context.Context, *AssignTeamsRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTeams not implemented")
+}
+func (UnimplementedBounceBotServer) SetTeamQuorum(context.Context, *SetTeamQuorumRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamQuorum not implemented")
+}
+func (UnimplementedBounceBotServer) GetTeams(context.Context, *GetTeamsRequest) (*GetTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeams not implemented")
+}
+func (UnimplementedBounceBotServer) StartMatch(context.Context, *StartMatchRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMatch not implemented")
+}
+func (UnimplementedBounceBotServer) StopMatch(context.Context, *StopMatchRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMatch not implemented")
+}
+func (UnimplementedBounceBotServer) SetHandicap(context.Context, *SetHandicapRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHandicap not implemented")
+}
+func (UnimplementedBounceBotServer) SuggestHandicaps(context.Context, *SuggestHandicapsRequest) (*SuggestHandicapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestHandicaps not implemented")
+}
+func (UnimplementedBounceBotServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedBounceBotServer) ClaimAccount(context.Context, *ClaimAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAccount not implemented")
+}
+func (UnimplementedBounceBotServer) GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatings not implemented")
+}
+func (UnimplementedBounceBotServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedBounceBotServer) GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*PlayerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (UnimplementedBounceBotServer) GetDailyPuzzle(context.Context, *GetDailyPuzzleRequest) (*DailyPuzzle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyPuzzle not implemented")
+}
+func (UnimplementedBounceBotServer) SubmitDailySolution(context.Context, *SubmitDailySolutionRequest) (*DailyEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDailySolution not implemented")
+}
+func (UnimplementedBounceBotServer) GetDailyLeaderboard(context.Context, *GetDailyLeaderboardRequest) (*GetDailyLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyLeaderboard not implemented")
+}
+func (UnimplementedBounceBotServer) SearchArchive(context.Context, *SearchArchiveRequest) (*SearchArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArchive not implemented")
+}
+func (UnimplementedBounceBotServer) GetArchivePuzzle(context.Context, *GetArchivePuzzleRequest) (*ArchivePuzzle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivePuzzle not implemented")
+}
+func (UnimplementedBounceBotServer) CheckArchiveSolution(context.Context, *CheckArchiveSolutionRequest) (*CheckArchiveSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckArchiveSolution not implemented")
+}
+func (UnimplementedBounceBotServer) WatchRoom(*WatchRoomRequest, grpc.ServerStreamingServer[RoomEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRoom not implemented")
+}
+func (UnimplementedBounceBotServer) CreateTournament(context.Context, *CreateTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (UnimplementedBounceBotServer) StartTournament(context.Context, *StartTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTournament not implemented")
+}
+func (UnimplementedBounceBotServer) ReportTableResult(context.Context, *ReportTableResultRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTableResult not implemented")
+}
+func (UnimplementedBounceBotServer) GetTournament(context.Context, *GetTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournament not implemented")
+}
+func (UnimplementedBounceBotServer) WatchTournament(*WatchTournamentRequest, grpc.ServerStreamingServer[TournamentEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTournament not implemented")
+}
+func (UnimplementedBounceBotServer) mustEmbedUnimplementedBounceBotServer() {}
+func (UnimplementedBounceBotServer) testEmbeddedByValue()                   {}
+
+// UnsafeBounceBotServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BounceBotServer will
+// result in compilation errors.
+type UnsafeBounceBotServer interface {
+	mustEmbedUnimplementedBounceBotServer()
+}
+
+func RegisterBounceBotServer(s grpc.ServiceRegistrar, srv BounceBotServer) {
+	// If the following call pancis, it indicates UnimplementedBounceBotServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BounceBot_ServiceDesc, srv)
+}
+
+func _BounceBot_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_JoinRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetRoom(ctx, req.(*GetRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).SubmitSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SubmitSolution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SubmitSolution(ctx, req.(*SubmitSolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_RetractSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractSolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).RetractSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_RetractSolution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).RetractSolution(ctx, req.(*RetractSolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_MarkFinishedSolving_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkFinishedSolvingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).MarkFinishedSolving(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_MarkFinishedSolving_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).MarkFinishedSolving(ctx, req.(*MarkFinishedSolvingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_MarkReadyForNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadyForNextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).MarkReadyForNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_MarkReadyForNext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).MarkReadyForNext(ctx, req.(*MarkReadyForNextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_SpectateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).SpectateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SpectateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SpectateRoom(ctx, req.(*SpectateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_GetRoomHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetRoomHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetRoomHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetRoomHistory(ctx, req.(*GetRoomHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_ExportReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).ExportReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_ExportReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).ExportReplay(ctx, req.(*ExportReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_AddBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).AddBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_AddBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).AddBot(ctx, req.(*AddBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_RemoveBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).RemoveBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_RemoveBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).RemoveBot(ctx, req.(*RemoveBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_RequestHint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestHintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).RequestHint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_RequestHint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).RequestHint(ctx, req.(*RequestHintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_SetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).SetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SetTeam(ctx, req.(*SetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_AssignTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).AssignTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_AssignTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).AssignTeams(ctx, req.(*AssignTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_SetTeamQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamQuorumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).SetTeamQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SetTeamQuorum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SetTeamQuorum(ctx, req.(*SetTeamQuorumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_GetTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetTeams(ctx, req.(*GetTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_StartMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).StartMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_StartMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).StartMatch(ctx, req.(*StartMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_StopMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).StopMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_StopMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).StopMatch(ctx, req.(*StopMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BounceBot_SetHandicap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHandicapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).SetHandicap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SetHandicap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SetHandicap(ctx, req.(*SetHandicapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) GetRoom(context.Context, *GetRoomRequest) (*Room, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoom not implemented")
+
+func _BounceBot_SuggestHandicaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestHandicapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).SuggestHandicaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SuggestHandicaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SuggestHandicaps(ctx, req.(*SuggestHandicapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) StartGame(context.Context, *StartGameRequest) (*Room, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+
+func _BounceBot_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) SubmitSolution(context.Context, *SubmitSolutionRequest) (*SubmitSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
+
+func _BounceBot_ClaimAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).ClaimAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_ClaimAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).ClaimAccount(ctx, req.(*ClaimAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) RetractSolution(context.Context, *RetractSolutionRequest) (*RetractSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetractSolution not implemented")
+
+func _BounceBot_GetRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetRatings(ctx, req.(*GetRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) MarkFinishedSolving(context.Context, *MarkFinishedSolvingRequest) (*MarkFinishedSolvingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkFinishedSolving not implemented")
+
+func _BounceBot_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) MarkReadyForNext(context.Context, *MarkReadyForNextRequest) (*MarkReadyForNextResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkReadyForNext not implemented")
+
+func _BounceBot_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetPlayerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBounceBotServer) mustEmbedUnimplementedBounceBotServer() {}
-func (UnimplementedBounceBotServer) testEmbeddedByValue()                   {}
 
-// UnsafeBounceBotServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BounceBotServer will
-// result in compilation errors.
-type UnsafeBounceBotServer interface {
-	mustEmbedUnimplementedBounceBotServer()
+func _BounceBot_GetDailyPuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyPuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BounceBotServer).GetDailyPuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_GetDailyPuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).GetDailyPuzzle(ctx, req.(*GetDailyPuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBounceBotServer(s grpc.ServiceRegistrar, srv BounceBotServer) {
-	// If the following call pancis, it indicates UnimplementedBounceBotServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _BounceBot_SubmitDailySolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDailySolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BounceBot_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BounceBotServer).SubmitDailySolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BounceBot_SubmitDailySolution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BounceBotServer).SubmitDailySolution(ctx, req.(*SubmitDailySolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoomRequest)
+func _BounceBot_GetDailyLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyLeaderboardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).CreateRoom(ctx, in)
+		return srv.(BounceBotServer).GetDailyLeaderboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_CreateRoom_FullMethodName,
+		FullMethod: BounceBot_GetDailyLeaderboard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+		return srv.(BounceBotServer).GetDailyLeaderboard(ctx, req.(*GetDailyLeaderboardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinRoomRequest)
+func _BounceBot_SearchArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArchiveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).JoinRoom(ctx, in)
+		return srv.(BounceBotServer).SearchArchive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_JoinRoom_FullMethodName,
+		FullMethod: BounceBot_SearchArchive_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+		return srv.(BounceBotServer).SearchArchive(ctx, req.(*SearchArchiveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoomRequest)
+func _BounceBot_GetArchivePuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivePuzzleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).GetRoom(ctx, in)
+		return srv.(BounceBotServer).GetArchivePuzzle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_GetRoom_FullMethodName,
+		FullMethod: BounceBot_GetArchivePuzzle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).GetRoom(ctx, req.(*GetRoomRequest))
+		return srv.(BounceBotServer).GetArchivePuzzle(ctx, req.(*GetArchivePuzzleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartGameRequest)
+func _BounceBot_CheckArchiveSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckArchiveSolutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).StartGame(ctx, in)
+		return srv.(BounceBotServer).CheckArchiveSolution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_StartGame_FullMethodName,
+		FullMethod: BounceBot_CheckArchiveSolution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).StartGame(ctx, req.(*StartGameRequest))
+		return srv.(BounceBotServer).CheckArchiveSolution(ctx, req.(*CheckArchiveSolutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitSolutionRequest)
+func _BounceBot_WatchRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRoomRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BounceBotServer).WatchRoom(m, &grpc.GenericServerStream[WatchRoomRequest, RoomEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BounceBot_WatchRoomServer = grpc.ServerStreamingServer[RoomEvent]
+
+func _BounceBot_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).SubmitSolution(ctx, in)
+		return srv.(BounceBotServer).CreateTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_SubmitSolution_FullMethodName,
+		FullMethod: BounceBot_CreateTournament_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).SubmitSolution(ctx, req.(*SubmitSolutionRequest))
+		return srv.(BounceBotServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_RetractSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetractSolutionRequest)
+func _BounceBot_StartTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).RetractSolution(ctx, in)
+		return srv.(BounceBotServer).StartTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_RetractSolution_FullMethodName,
+		FullMethod: BounceBot_StartTournament_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).RetractSolution(ctx, req.(*RetractSolutionRequest))
+		return srv.(BounceBotServer).StartTournament(ctx, req.(*StartTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_MarkFinishedSolving_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkFinishedSolvingRequest)
+func _BounceBot_ReportTableResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTableResultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).MarkFinishedSolving(ctx, in)
+		return srv.(BounceBotServer).ReportTableResult(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_MarkFinishedSolving_FullMethodName,
+		FullMethod: BounceBot_ReportTableResult_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).MarkFinishedSolving(ctx, req.(*MarkFinishedSolvingRequest))
+		return srv.(BounceBotServer).ReportTableResult(ctx, req.(*ReportTableResultRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BounceBot_MarkReadyForNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkReadyForNextRequest)
+func _BounceBot_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BounceBotServer).MarkReadyForNext(ctx, in)
+		return srv.(BounceBotServer).GetTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BounceBot_MarkReadyForNext_FullMethodName,
+		FullMethod: BounceBot_GetTournament_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BounceBotServer).MarkReadyForNext(ctx, req.(*MarkReadyForNextRequest))
+		return srv.(BounceBotServer).GetTournament(ctx, req.(*GetTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BounceBot_WatchTournament_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTournamentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BounceBotServer).WatchTournament(m, &grpc.GenericServerStream[WatchTournamentRequest, TournamentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BounceBot_WatchTournamentServer = grpc.ServerStreamingServer[TournamentEvent]
+
 // BounceBot_ServiceDesc is the grpc.ServiceDesc for BounceBot service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,7 +1455,134 @@ var BounceBot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkReadyForNext",
 			Handler:    _BounceBot_MarkReadyForNext_Handler,
 		},
+		{
+			MethodName: "SpectateRoom",
+			Handler:    _BounceBot_SpectateRoom_Handler,
+		},
+		{
+			MethodName: "GetRoomHistory",
+			Handler:    _BounceBot_GetRoomHistory_Handler,
+		},
+		{
+			MethodName: "ExportReplay",
+			Handler:    _BounceBot_ExportReplay_Handler,
+		},
+		{
+			MethodName: "AddBot",
+			Handler:    _BounceBot_AddBot_Handler,
+		},
+		{
+			MethodName: "RemoveBot",
+			Handler:    _BounceBot_RemoveBot_Handler,
+		},
+		{
+			MethodName: "RequestHint",
+			Handler:    _BounceBot_RequestHint_Handler,
+		},
+		{
+			MethodName: "SetTeam",
+			Handler:    _BounceBot_SetTeam_Handler,
+		},
+		{
+			MethodName: "AssignTeams",
+			Handler:    _BounceBot_AssignTeams_Handler,
+		},
+		{
+			MethodName: "SetTeamQuorum",
+			Handler:    _BounceBot_SetTeamQuorum_Handler,
+		},
+		{
+			MethodName: "GetTeams",
+			Handler:    _BounceBot_GetTeams_Handler,
+		},
+		{
+			MethodName: "StartMatch",
+			Handler:    _BounceBot_StartMatch_Handler,
+		},
+		{
+			MethodName: "StopMatch",
+			Handler:    _BounceBot_StopMatch_Handler,
+		},
+		{
+			MethodName: "SetHandicap",
+			Handler:    _BounceBot_SetHandicap_Handler,
+		},
+		{
+			MethodName: "SuggestHandicaps",
+			Handler:    _BounceBot_SuggestHandicaps_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _BounceBot_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ClaimAccount",
+			Handler:    _BounceBot_ClaimAccount_Handler,
+		},
+		{
+			MethodName: "GetRatings",
+			Handler:    _BounceBot_GetRatings_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _BounceBot_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _BounceBot_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "GetDailyPuzzle",
+			Handler:    _BounceBot_GetDailyPuzzle_Handler,
+		},
+		{
+			MethodName: "SubmitDailySolution",
+			Handler:    _BounceBot_SubmitDailySolution_Handler,
+		},
+		{
+			MethodName: "GetDailyLeaderboard",
+			Handler:    _BounceBot_GetDailyLeaderboard_Handler,
+		},
+		{
+			MethodName: "SearchArchive",
+			Handler:    _BounceBot_SearchArchive_Handler,
+		},
+		{
+			MethodName: "GetArchivePuzzle",
+			Handler:    _BounceBot_GetArchivePuzzle_Handler,
+		},
+		{
+			MethodName: "CheckArchiveSolution",
+			Handler:    _BounceBot_CheckArchiveSolution_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _BounceBot_CreateTournament_Handler,
+		},
+		{
+			MethodName: "StartTournament",
+			Handler:    _BounceBot_StartTournament_Handler,
+		},
+		{
+			MethodName: "ReportTableResult",
+			Handler:    _BounceBot_ReportTableResult_Handler,
+		},
+		{
+			MethodName: "GetTournament",
+			Handler:    _BounceBot_GetTournament_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRoom",
+			Handler:       _BounceBot_WatchRoom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTournament",
+			Handler:       _BounceBot_WatchTournament_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "bouncebot.proto",
 }
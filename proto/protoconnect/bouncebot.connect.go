@@ -53,6 +53,81 @@ const (
 	// BounceBotMarkReadyForNextProcedure is the fully-qualified name of the BounceBot's
 	// MarkReadyForNext RPC.
 	BounceBotMarkReadyForNextProcedure = "/bouncebot.BounceBot/MarkReadyForNext"
+	// BounceBotSpectateRoomProcedure is the fully-qualified name of the BounceBot's SpectateRoom RPC.
+	BounceBotSpectateRoomProcedure = "/bouncebot.BounceBot/SpectateRoom"
+	// BounceBotGetRoomHistoryProcedure is the fully-qualified name of the BounceBot's GetRoomHistory
+	// RPC.
+	BounceBotGetRoomHistoryProcedure = "/bouncebot.BounceBot/GetRoomHistory"
+	// BounceBotExportReplayProcedure is the fully-qualified name of the BounceBot's ExportReplay RPC.
+	BounceBotExportReplayProcedure = "/bouncebot.BounceBot/ExportReplay"
+	// BounceBotAddBotProcedure is the fully-qualified name of the BounceBot's AddBot RPC.
+	BounceBotAddBotProcedure = "/bouncebot.BounceBot/AddBot"
+	// BounceBotRemoveBotProcedure is the fully-qualified name of the BounceBot's RemoveBot RPC.
+	BounceBotRemoveBotProcedure = "/bouncebot.BounceBot/RemoveBot"
+	// BounceBotRequestHintProcedure is the fully-qualified name of the BounceBot's RequestHint RPC.
+	BounceBotRequestHintProcedure = "/bouncebot.BounceBot/RequestHint"
+	// BounceBotSetTeamProcedure is the fully-qualified name of the BounceBot's SetTeam RPC.
+	BounceBotSetTeamProcedure = "/bouncebot.BounceBot/SetTeam"
+	// BounceBotAssignTeamsProcedure is the fully-qualified name of the BounceBot's AssignTeams RPC.
+	BounceBotAssignTeamsProcedure = "/bouncebot.BounceBot/AssignTeams"
+	// BounceBotSetTeamQuorumProcedure is the fully-qualified name of the BounceBot's SetTeamQuorum RPC.
+	BounceBotSetTeamQuorumProcedure = "/bouncebot.BounceBot/SetTeamQuorum"
+	// BounceBotGetTeamsProcedure is the fully-qualified name of the BounceBot's GetTeams RPC.
+	BounceBotGetTeamsProcedure = "/bouncebot.BounceBot/GetTeams"
+	// BounceBotStartMatchProcedure is the fully-qualified name of the BounceBot's StartMatch RPC.
+	BounceBotStartMatchProcedure = "/bouncebot.BounceBot/StartMatch"
+	// BounceBotStopMatchProcedure is the fully-qualified name of the BounceBot's StopMatch RPC.
+	BounceBotStopMatchProcedure = "/bouncebot.BounceBot/StopMatch"
+	// BounceBotSetHandicapProcedure is the fully-qualified name of the BounceBot's SetHandicap RPC.
+	BounceBotSetHandicapProcedure = "/bouncebot.BounceBot/SetHandicap"
+	// BounceBotSuggestHandicapsProcedure is the fully-qualified name of the BounceBot's
+	// SuggestHandicaps RPC.
+	BounceBotSuggestHandicapsProcedure = "/bouncebot.BounceBot/SuggestHandicaps"
+	// BounceBotCreateAccountProcedure is the fully-qualified name of the BounceBot's CreateAccount RPC.
+	BounceBotCreateAccountProcedure = "/bouncebot.BounceBot/CreateAccount"
+	// BounceBotClaimAccountProcedure is the fully-qualified name of the BounceBot's ClaimAccount RPC.
+	BounceBotClaimAccountProcedure = "/bouncebot.BounceBot/ClaimAccount"
+	// BounceBotGetRatingsProcedure is the fully-qualified name of the BounceBot's GetRatings RPC.
+	BounceBotGetRatingsProcedure = "/bouncebot.BounceBot/GetRatings"
+	// BounceBotGetLeaderboardProcedure is the fully-qualified name of the BounceBot's GetLeaderboard
+	// RPC.
+	BounceBotGetLeaderboardProcedure = "/bouncebot.BounceBot/GetLeaderboard"
+	// BounceBotGetPlayerStatsProcedure is the fully-qualified name of the BounceBot's GetPlayerStats
+	// RPC.
+	BounceBotGetPlayerStatsProcedure = "/bouncebot.BounceBot/GetPlayerStats"
+	// BounceBotGetDailyPuzzleProcedure is the fully-qualified name of the BounceBot's GetDailyPuzzle
+	// RPC.
+	BounceBotGetDailyPuzzleProcedure = "/bouncebot.BounceBot/GetDailyPuzzle"
+	// BounceBotSubmitDailySolutionProcedure is the fully-qualified name of the BounceBot's
+	// SubmitDailySolution RPC.
+	BounceBotSubmitDailySolutionProcedure = "/bouncebot.BounceBot/SubmitDailySolution"
+	// BounceBotGetDailyLeaderboardProcedure is the fully-qualified name of the BounceBot's
+	// GetDailyLeaderboard RPC.
+	BounceBotGetDailyLeaderboardProcedure = "/bouncebot.BounceBot/GetDailyLeaderboard"
+	// BounceBotSearchArchiveProcedure is the fully-qualified name of the BounceBot's SearchArchive RPC.
+	BounceBotSearchArchiveProcedure = "/bouncebot.BounceBot/SearchArchive"
+	// BounceBotGetArchivePuzzleProcedure is the fully-qualified name of the BounceBot's
+	// GetArchivePuzzle RPC.
+	BounceBotGetArchivePuzzleProcedure = "/bouncebot.BounceBot/GetArchivePuzzle"
+	// BounceBotCheckArchiveSolutionProcedure is the fully-qualified name of the BounceBot's
+	// CheckArchiveSolution RPC.
+	BounceBotCheckArchiveSolutionProcedure = "/bouncebot.BounceBot/CheckArchiveSolution"
+	// BounceBotWatchRoomProcedure is the fully-qualified name of the BounceBot's WatchRoom RPC.
+	BounceBotWatchRoomProcedure = "/bouncebot.BounceBot/WatchRoom"
+	// BounceBotCreateTournamentProcedure is the fully-qualified name of the BounceBot's
+	// CreateTournament RPC.
+	BounceBotCreateTournamentProcedure = "/bouncebot.BounceBot/CreateTournament"
+	// BounceBotStartTournamentProcedure is the fully-qualified name of the BounceBot's StartTournament
+	// RPC.
+	BounceBotStartTournamentProcedure = "/bouncebot.BounceBot/StartTournament"
+	// BounceBotReportTableResultProcedure is the fully-qualified name of the BounceBot's
+	// ReportTableResult RPC.
+	BounceBotReportTableResultProcedure = "/bouncebot.BounceBot/ReportTableResult"
+	// BounceBotGetTournamentProcedure is the fully-qualified name of the BounceBot's GetTournament RPC.
+	BounceBotGetTournamentProcedure = "/bouncebot.BounceBot/GetTournament"
+	// BounceBotWatchTournamentProcedure is the fully-qualified name of the BounceBot's WatchTournament
+	// RPC.
+	BounceBotWatchTournamentProcedure = "/bouncebot.BounceBot/WatchTournament"
 )
 
 // BounceBotClient is a client for the bouncebot.BounceBot service.
@@ -66,6 +141,42 @@ type BounceBotClient interface {
 	RetractSolution(context.Context, *connect.Request[proto.RetractSolutionRequest]) (*connect.Response[proto.RetractSolutionResponse], error)
 	MarkFinishedSolving(context.Context, *connect.Request[proto.MarkFinishedSolvingRequest]) (*connect.Response[proto.MarkFinishedSolvingResponse], error)
 	MarkReadyForNext(context.Context, *connect.Request[proto.MarkReadyForNextRequest]) (*connect.Response[proto.MarkReadyForNextResponse], error)
+	SpectateRoom(context.Context, *connect.Request[proto.SpectateRoomRequest]) (*connect.Response[proto.SpectateRoomResponse], error)
+	GetRoomHistory(context.Context, *connect.Request[proto.GetRoomHistoryRequest]) (*connect.Response[proto.GetRoomHistoryResponse], error)
+	ExportReplay(context.Context, *connect.Request[proto.ExportReplayRequest]) (*connect.Response[proto.Replay], error)
+	AddBot(context.Context, *connect.Request[proto.AddBotRequest]) (*connect.Response[proto.AddBotResponse], error)
+	RemoveBot(context.Context, *connect.Request[proto.RemoveBotRequest]) (*connect.Response[proto.Room], error)
+	RequestHint(context.Context, *connect.Request[proto.RequestHintRequest]) (*connect.Response[proto.Hint], error)
+	SetTeam(context.Context, *connect.Request[proto.SetTeamRequest]) (*connect.Response[proto.Room], error)
+	AssignTeams(context.Context, *connect.Request[proto.AssignTeamsRequest]) (*connect.Response[proto.Room], error)
+	SetTeamQuorum(context.Context, *connect.Request[proto.SetTeamQuorumRequest]) (*connect.Response[proto.Room], error)
+	GetTeams(context.Context, *connect.Request[proto.GetTeamsRequest]) (*connect.Response[proto.GetTeamsResponse], error)
+	StartMatch(context.Context, *connect.Request[proto.StartMatchRequest]) (*connect.Response[proto.Room], error)
+	StopMatch(context.Context, *connect.Request[proto.StopMatchRequest]) (*connect.Response[proto.Room], error)
+	SetHandicap(context.Context, *connect.Request[proto.SetHandicapRequest]) (*connect.Response[proto.Room], error)
+	SuggestHandicaps(context.Context, *connect.Request[proto.SuggestHandicapsRequest]) (*connect.Response[proto.SuggestHandicapsResponse], error)
+	// Player accounts
+	CreateAccount(context.Context, *connect.Request[proto.CreateAccountRequest]) (*connect.Response[proto.CreateAccountResponse], error)
+	ClaimAccount(context.Context, *connect.Request[proto.ClaimAccountRequest]) (*connect.Response[proto.Account], error)
+	GetRatings(context.Context, *connect.Request[proto.GetRatingsRequest]) (*connect.Response[proto.GetRatingsResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[proto.GetLeaderboardRequest]) (*connect.Response[proto.GetLeaderboardResponse], error)
+	GetPlayerStats(context.Context, *connect.Request[proto.GetPlayerStatsRequest]) (*connect.Response[proto.PlayerStats], error)
+	// Daily puzzle
+	GetDailyPuzzle(context.Context, *connect.Request[proto.GetDailyPuzzleRequest]) (*connect.Response[proto.DailyPuzzle], error)
+	SubmitDailySolution(context.Context, *connect.Request[proto.SubmitDailySolutionRequest]) (*connect.Response[proto.DailyEntry], error)
+	GetDailyLeaderboard(context.Context, *connect.Request[proto.GetDailyLeaderboardRequest]) (*connect.Response[proto.GetDailyLeaderboardResponse], error)
+	// Puzzle archive
+	SearchArchive(context.Context, *connect.Request[proto.SearchArchiveRequest]) (*connect.Response[proto.SearchArchiveResponse], error)
+	GetArchivePuzzle(context.Context, *connect.Request[proto.GetArchivePuzzleRequest]) (*connect.Response[proto.ArchivePuzzle], error)
+	CheckArchiveSolution(context.Context, *connect.Request[proto.CheckArchiveSolutionRequest]) (*connect.Response[proto.CheckArchiveSolutionResponse], error)
+	// Room events (alternative to the WebSocket channel)
+	WatchRoom(context.Context, *connect.Request[proto.WatchRoomRequest]) (*connect.ServerStreamForClient[proto.RoomEvent], error)
+	// Tournaments; creating, starting and reporting results need the admin token
+	CreateTournament(context.Context, *connect.Request[proto.CreateTournamentRequest]) (*connect.Response[proto.Tournament], error)
+	StartTournament(context.Context, *connect.Request[proto.StartTournamentRequest]) (*connect.Response[proto.Tournament], error)
+	ReportTableResult(context.Context, *connect.Request[proto.ReportTableResultRequest]) (*connect.Response[proto.Tournament], error)
+	GetTournament(context.Context, *connect.Request[proto.GetTournamentRequest]) (*connect.Response[proto.Tournament], error)
+	WatchTournament(context.Context, *connect.Request[proto.WatchTournamentRequest]) (*connect.ServerStreamForClient[proto.TournamentEvent], error)
 }
 
 // NewBounceBotClient constructs a client for the bouncebot.BounceBot service. By default, it uses
@@ -127,19 +238,236 @@ func NewBounceBotClient(httpClient connect.HTTPClient, baseURL string, opts ...c
 			connect.WithSchema(bounceBotMethods.ByName("MarkReadyForNext")),
 			connect.WithClientOptions(opts...),
 		),
+		spectateRoom: connect.NewClient[proto.SpectateRoomRequest, proto.SpectateRoomResponse](
+			httpClient,
+			baseURL+BounceBotSpectateRoomProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SpectateRoom")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoomHistory: connect.NewClient[proto.GetRoomHistoryRequest, proto.GetRoomHistoryResponse](
+			httpClient,
+			baseURL+BounceBotGetRoomHistoryProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetRoomHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		exportReplay: connect.NewClient[proto.ExportReplayRequest, proto.Replay](
+			httpClient,
+			baseURL+BounceBotExportReplayProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("ExportReplay")),
+			connect.WithClientOptions(opts...),
+		),
+		addBot: connect.NewClient[proto.AddBotRequest, proto.AddBotResponse](
+			httpClient,
+			baseURL+BounceBotAddBotProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("AddBot")),
+			connect.WithClientOptions(opts...),
+		),
+		removeBot: connect.NewClient[proto.RemoveBotRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotRemoveBotProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("RemoveBot")),
+			connect.WithClientOptions(opts...),
+		),
+		requestHint: connect.NewClient[proto.RequestHintRequest, proto.Hint](
+			httpClient,
+			baseURL+BounceBotRequestHintProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("RequestHint")),
+			connect.WithClientOptions(opts...),
+		),
+		setTeam: connect.NewClient[proto.SetTeamRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotSetTeamProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SetTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		assignTeams: connect.NewClient[proto.AssignTeamsRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotAssignTeamsProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("AssignTeams")),
+			connect.WithClientOptions(opts...),
+		),
+		setTeamQuorum: connect.NewClient[proto.SetTeamQuorumRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotSetTeamQuorumProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SetTeamQuorum")),
+			connect.WithClientOptions(opts...),
+		),
+		getTeams: connect.NewClient[proto.GetTeamsRequest, proto.GetTeamsResponse](
+			httpClient,
+			baseURL+BounceBotGetTeamsProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetTeams")),
+			connect.WithClientOptions(opts...),
+		),
+		startMatch: connect.NewClient[proto.StartMatchRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotStartMatchProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("StartMatch")),
+			connect.WithClientOptions(opts...),
+		),
+		stopMatch: connect.NewClient[proto.StopMatchRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotStopMatchProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("StopMatch")),
+			connect.WithClientOptions(opts...),
+		),
+		setHandicap: connect.NewClient[proto.SetHandicapRequest, proto.Room](
+			httpClient,
+			baseURL+BounceBotSetHandicapProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SetHandicap")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestHandicaps: connect.NewClient[proto.SuggestHandicapsRequest, proto.SuggestHandicapsResponse](
+			httpClient,
+			baseURL+BounceBotSuggestHandicapsProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SuggestHandicaps")),
+			connect.WithClientOptions(opts...),
+		),
+		createAccount: connect.NewClient[proto.CreateAccountRequest, proto.CreateAccountResponse](
+			httpClient,
+			baseURL+BounceBotCreateAccountProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("CreateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		claimAccount: connect.NewClient[proto.ClaimAccountRequest, proto.Account](
+			httpClient,
+			baseURL+BounceBotClaimAccountProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("ClaimAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getRatings: connect.NewClient[proto.GetRatingsRequest, proto.GetRatingsResponse](
+			httpClient,
+			baseURL+BounceBotGetRatingsProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetRatings")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[proto.GetLeaderboardRequest, proto.GetLeaderboardResponse](
+			httpClient,
+			baseURL+BounceBotGetLeaderboardProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlayerStats: connect.NewClient[proto.GetPlayerStatsRequest, proto.PlayerStats](
+			httpClient,
+			baseURL+BounceBotGetPlayerStatsProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetPlayerStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getDailyPuzzle: connect.NewClient[proto.GetDailyPuzzleRequest, proto.DailyPuzzle](
+			httpClient,
+			baseURL+BounceBotGetDailyPuzzleProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetDailyPuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		submitDailySolution: connect.NewClient[proto.SubmitDailySolutionRequest, proto.DailyEntry](
+			httpClient,
+			baseURL+BounceBotSubmitDailySolutionProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SubmitDailySolution")),
+			connect.WithClientOptions(opts...),
+		),
+		getDailyLeaderboard: connect.NewClient[proto.GetDailyLeaderboardRequest, proto.GetDailyLeaderboardResponse](
+			httpClient,
+			baseURL+BounceBotGetDailyLeaderboardProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetDailyLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		searchArchive: connect.NewClient[proto.SearchArchiveRequest, proto.SearchArchiveResponse](
+			httpClient,
+			baseURL+BounceBotSearchArchiveProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("SearchArchive")),
+			connect.WithClientOptions(opts...),
+		),
+		getArchivePuzzle: connect.NewClient[proto.GetArchivePuzzleRequest, proto.ArchivePuzzle](
+			httpClient,
+			baseURL+BounceBotGetArchivePuzzleProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetArchivePuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		checkArchiveSolution: connect.NewClient[proto.CheckArchiveSolutionRequest, proto.CheckArchiveSolutionResponse](
+			httpClient,
+			baseURL+BounceBotCheckArchiveSolutionProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("CheckArchiveSolution")),
+			connect.WithClientOptions(opts...),
+		),
+		watchRoom: connect.NewClient[proto.WatchRoomRequest, proto.RoomEvent](
+			httpClient,
+			baseURL+BounceBotWatchRoomProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("WatchRoom")),
+			connect.WithClientOptions(opts...),
+		),
+		createTournament: connect.NewClient[proto.CreateTournamentRequest, proto.Tournament](
+			httpClient,
+			baseURL+BounceBotCreateTournamentProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("CreateTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		startTournament: connect.NewClient[proto.StartTournamentRequest, proto.Tournament](
+			httpClient,
+			baseURL+BounceBotStartTournamentProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("StartTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		reportTableResult: connect.NewClient[proto.ReportTableResultRequest, proto.Tournament](
+			httpClient,
+			baseURL+BounceBotReportTableResultProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("ReportTableResult")),
+			connect.WithClientOptions(opts...),
+		),
+		getTournament: connect.NewClient[proto.GetTournamentRequest, proto.Tournament](
+			httpClient,
+			baseURL+BounceBotGetTournamentProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("GetTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		watchTournament: connect.NewClient[proto.WatchTournamentRequest, proto.TournamentEvent](
+			httpClient,
+			baseURL+BounceBotWatchTournamentProcedure,
+			connect.WithSchema(bounceBotMethods.ByName("WatchTournament")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // bounceBotClient implements BounceBotClient.
 type bounceBotClient struct {
-	createRoom          *connect.Client[proto.CreateRoomRequest, proto.Room]
-	joinRoom            *connect.Client[proto.JoinRoomRequest, proto.Room]
-	getRoom             *connect.Client[proto.GetRoomRequest, proto.Room]
-	startGame           *connect.Client[proto.StartGameRequest, proto.Room]
-	submitSolution      *connect.Client[proto.SubmitSolutionRequest, proto.SubmitSolutionResponse]
-	retractSolution     *connect.Client[proto.RetractSolutionRequest, proto.RetractSolutionResponse]
-	markFinishedSolving *connect.Client[proto.MarkFinishedSolvingRequest, proto.MarkFinishedSolvingResponse]
-	markReadyForNext    *connect.Client[proto.MarkReadyForNextRequest, proto.MarkReadyForNextResponse]
+	createRoom           *connect.Client[proto.CreateRoomRequest, proto.Room]
+	joinRoom             *connect.Client[proto.JoinRoomRequest, proto.Room]
+	getRoom              *connect.Client[proto.GetRoomRequest, proto.Room]
+	startGame            *connect.Client[proto.StartGameRequest, proto.Room]
+	submitSolution       *connect.Client[proto.SubmitSolutionRequest, proto.SubmitSolutionResponse]
+	retractSolution      *connect.Client[proto.RetractSolutionRequest, proto.RetractSolutionResponse]
+	markFinishedSolving  *connect.Client[proto.MarkFinishedSolvingRequest, proto.MarkFinishedSolvingResponse]
+	markReadyForNext     *connect.Client[proto.MarkReadyForNextRequest, proto.MarkReadyForNextResponse]
+	spectateRoom         *connect.Client[proto.SpectateRoomRequest, proto.SpectateRoomResponse]
+	getRoomHistory       *connect.Client[proto.GetRoomHistoryRequest, proto.GetRoomHistoryResponse]
+	exportReplay         *connect.Client[proto.ExportReplayRequest, proto.Replay]
+	addBot               *connect.Client[proto.AddBotRequest, proto.AddBotResponse]
+	removeBot            *connect.Client[proto.RemoveBotRequest, proto.Room]
+	requestHint          *connect.Client[proto.RequestHintRequest, proto.Hint]
+	setTeam              *connect.Client[proto.SetTeamRequest, proto.Room]
+	assignTeams          *connect.Client[proto.AssignTeamsRequest, proto.Room]
+	setTeamQuorum        *connect.Client[proto.SetTeamQuorumRequest, proto.Room]
+	getTeams             *connect.Client[proto.GetTeamsRequest, proto.GetTeamsResponse]
+	startMatch           *connect.Client[proto.StartMatchRequest, proto.Room]
+	stopMatch            *connect.Client[proto.StopMatchRequest, proto.Room]
+	setHandicap          *connect.Client[proto.SetHandicapRequest, proto.Room]
+	suggestHandicaps     *connect.Client[proto.SuggestHandicapsRequest, proto.SuggestHandicapsResponse]
+	createAccount        *connect.Client[proto.CreateAccountRequest, proto.CreateAccountResponse]
+	claimAccount         *connect.Client[proto.ClaimAccountRequest, proto.Account]
+	getRatings           *connect.Client[proto.GetRatingsRequest, proto.GetRatingsResponse]
+	getLeaderboard       *connect.Client[proto.GetLeaderboardRequest, proto.GetLeaderboardResponse]
+	getPlayerStats       *connect.Client[proto.GetPlayerStatsRequest, proto.PlayerStats]
+	getDailyPuzzle       *connect.Client[proto.GetDailyPuzzleRequest, proto.DailyPuzzle]
+	submitDailySolution  *connect.Client[proto.SubmitDailySolutionRequest, proto.DailyEntry]
+	getDailyLeaderboard  *connect.Client[proto.GetDailyLeaderboardRequest, proto.GetDailyLeaderboardResponse]
+	searchArchive        *connect.Client[proto.SearchArchiveRequest, proto.SearchArchiveResponse]
+	getArchivePuzzle     *connect.Client[proto.GetArchivePuzzleRequest, proto.ArchivePuzzle]
+	checkArchiveSolution *connect.Client[proto.CheckArchiveSolutionRequest, proto.CheckArchiveSolutionResponse]
+	watchRoom            *connect.Client[proto.WatchRoomRequest, proto.RoomEvent]
+	createTournament     *connect.Client[proto.CreateTournamentRequest, proto.Tournament]
+	startTournament      *connect.Client[proto.StartTournamentRequest, proto.Tournament]
+	reportTableResult    *connect.Client[proto.ReportTableResultRequest, proto.Tournament]
+	getTournament        *connect.Client[proto.GetTournamentRequest, proto.Tournament]
+	watchTournament      *connect.Client[proto.WatchTournamentRequest, proto.TournamentEvent]
 }
 
 // CreateRoom calls bouncebot.BounceBot.CreateRoom.
@@ -182,6 +510,161 @@ func (c *bounceBotClient) MarkReadyForNext(ctx context.Context, req *connect.Req
 	return c.markReadyForNext.CallUnary(ctx, req)
 }
 
+// SpectateRoom calls bouncebot.BounceBot.SpectateRoom.
+func (c *bounceBotClient) SpectateRoom(ctx context.Context, req *connect.Request[proto.SpectateRoomRequest]) (*connect.Response[proto.SpectateRoomResponse], error) {
+	return c.spectateRoom.CallUnary(ctx, req)
+}
+
+// GetRoomHistory calls bouncebot.BounceBot.GetRoomHistory.
+func (c *bounceBotClient) GetRoomHistory(ctx context.Context, req *connect.Request[proto.GetRoomHistoryRequest]) (*connect.Response[proto.GetRoomHistoryResponse], error) {
+	return c.getRoomHistory.CallUnary(ctx, req)
+}
+
+// ExportReplay calls bouncebot.BounceBot.ExportReplay.
+func (c *bounceBotClient) ExportReplay(ctx context.Context, req *connect.Request[proto.ExportReplayRequest]) (*connect.Response[proto.Replay], error) {
+	return c.exportReplay.CallUnary(ctx, req)
+}
+
+// AddBot calls bouncebot.BounceBot.AddBot.
+func (c *bounceBotClient) AddBot(ctx context.Context, req *connect.Request[proto.AddBotRequest]) (*connect.Response[proto.AddBotResponse], error) {
+	return c.addBot.CallUnary(ctx, req)
+}
+
+// RemoveBot calls bouncebot.BounceBot.RemoveBot.
+func (c *bounceBotClient) RemoveBot(ctx context.Context, req *connect.Request[proto.RemoveBotRequest]) (*connect.Response[proto.Room], error) {
+	return c.removeBot.CallUnary(ctx, req)
+}
+
+// RequestHint calls bouncebot.BounceBot.RequestHint.
+func (c *bounceBotClient) RequestHint(ctx context.Context, req *connect.Request[proto.RequestHintRequest]) (*connect.Response[proto.Hint], error) {
+	return c.requestHint.CallUnary(ctx, req)
+}
+
+// SetTeam calls bouncebot.BounceBot.SetTeam.
+func (c *bounceBotClient) SetTeam(ctx context.Context, req *connect.Request[proto.SetTeamRequest]) (*connect.Response[proto.Room], error) {
+	return c.setTeam.CallUnary(ctx, req)
+}
+
+// AssignTeams calls bouncebot.BounceBot.AssignTeams.
+func (c *bounceBotClient) AssignTeams(ctx context.Context, req *connect.Request[proto.AssignTeamsRequest]) (*connect.Response[proto.Room], error) {
+	return c.assignTeams.CallUnary(ctx, req)
+}
+
+// SetTeamQuorum calls bouncebot.BounceBot.SetTeamQuorum.
+func (c *bounceBotClient) SetTeamQuorum(ctx context.Context, req *connect.Request[proto.SetTeamQuorumRequest]) (*connect.Response[proto.Room], error) {
+	return c.setTeamQuorum.CallUnary(ctx, req)
+}
+
+// GetTeams calls bouncebot.BounceBot.GetTeams.
+func (c *bounceBotClient) GetTeams(ctx context.Context, req *connect.Request[proto.GetTeamsRequest]) (*connect.Response[proto.GetTeamsResponse], error) {
+	return c.getTeams.CallUnary(ctx, req)
+}
+
+// StartMatch calls bouncebot.BounceBot.StartMatch.
+func (c *bounceBotClient) StartMatch(ctx context.Context, req *connect.Request[proto.StartMatchRequest]) (*connect.Response[proto.Room], error) {
+	return c.startMatch.CallUnary(ctx, req)
+}
+
+// StopMatch calls bouncebot.BounceBot.StopMatch.
+func (c *bounceBotClient) StopMatch(ctx context.Context, req *connect.Request[proto.StopMatchRequest]) (*connect.Response[proto.Room], error) {
+	return c.stopMatch.CallUnary(ctx, req)
+}
+
+// SetHandicap calls bouncebot.BounceBot.SetHandicap.
+func (c *bounceBotClient) SetHandicap(ctx context.Context, req *connect.Request[proto.SetHandicapRequest]) (*connect.Response[proto.Room], error) {
+	return c.setHandicap.CallUnary(ctx, req)
+}
+
+// SuggestHandicaps calls bouncebot.BounceBot.SuggestHandicaps.
+func (c *bounceBotClient) SuggestHandicaps(ctx context.Context, req *connect.Request[proto.SuggestHandicapsRequest]) (*connect.Response[proto.SuggestHandicapsResponse], error) {
+	return c.suggestHandicaps.CallUnary(ctx, req)
+}
+
+// CreateAccount calls bouncebot.BounceBot.CreateAccount.
+func (c *bounceBotClient) CreateAccount(ctx context.Context, req *connect.Request[proto.CreateAccountRequest]) (*connect.Response[proto.CreateAccountResponse], error) {
+	return c.createAccount.CallUnary(ctx, req)
+}
+
+// ClaimAccount calls bouncebot.BounceBot.ClaimAccount.
+func (c *bounceBotClient) ClaimAccount(ctx context.Context, req *connect.Request[proto.ClaimAccountRequest]) (*connect.Response[proto.Account], error) {
+	return c.claimAccount.CallUnary(ctx, req)
+}
+
+// GetRatings calls bouncebot.BounceBot.GetRatings.
+func (c *bounceBotClient) GetRatings(ctx context.Context, req *connect.Request[proto.GetRatingsRequest]) (*connect.Response[proto.GetRatingsResponse], error) {
+	return c.getRatings.CallUnary(ctx, req)
+}
+
+// GetLeaderboard calls bouncebot.BounceBot.GetLeaderboard.
+func (c *bounceBotClient) GetLeaderboard(ctx context.Context, req *connect.Request[proto.GetLeaderboardRequest]) (*connect.Response[proto.GetLeaderboardResponse], error) {
+	return c.getLeaderboard.CallUnary(ctx, req)
+}
+
+// GetPlayerStats calls bouncebot.BounceBot.GetPlayerStats.
+func (c *bounceBotClient) GetPlayerStats(ctx context.Context, req *connect.Request[proto.GetPlayerStatsRequest]) (*connect.Response[proto.PlayerStats], error) {
+	return c.getPlayerStats.CallUnary(ctx, req)
+}
+
+// GetDailyPuzzle calls bouncebot.BounceBot.GetDailyPuzzle.
+func (c *bounceBotClient) GetDailyPuzzle(ctx context.Context, req *connect.Request[proto.GetDailyPuzzleRequest]) (*connect.Response[proto.DailyPuzzle], error) {
+	return c.getDailyPuzzle.CallUnary(ctx, req)
+}
+
+// SubmitDailySolution calls bouncebot.BounceBot.SubmitDailySolution.
+func (c *bounceBotClient) SubmitDailySolution(ctx context.Context, req *connect.Request[proto.SubmitDailySolutionRequest]) (*connect.Response[proto.DailyEntry], error) {
+	return c.submitDailySolution.CallUnary(ctx, req)
+}
+
+// GetDailyLeaderboard calls bouncebot.BounceBot.GetDailyLeaderboard.
+func (c *bounceBotClient) GetDailyLeaderboard(ctx context.Context, req *connect.Request[proto.GetDailyLeaderboardRequest]) (*connect.Response[proto.GetDailyLeaderboardResponse], error) {
+	return c.getDailyLeaderboard.CallUnary(ctx, req)
+}
+
+// SearchArchive calls bouncebot.BounceBot.SearchArchive.
+func (c *bounceBotClient) SearchArchive(ctx context.Context, req *connect.Request[proto.SearchArchiveRequest]) (*connect.Response[proto.SearchArchiveResponse], error) {
+	return c.searchArchive.CallUnary(ctx, req)
+}
+
+// GetArchivePuzzle calls bouncebot.BounceBot.GetArchivePuzzle.
+func (c *bounceBotClient) GetArchivePuzzle(ctx context.Context, req *connect.Request[proto.GetArchivePuzzleRequest]) (*connect.Response[proto.ArchivePuzzle], error) {
+	return c.getArchivePuzzle.CallUnary(ctx, req)
+}
+
+// CheckArchiveSolution calls bouncebot.BounceBot.CheckArchiveSolution.
+func (c *bounceBotClient) CheckArchiveSolution(ctx context.Context, req *connect.Request[proto.CheckArchiveSolutionRequest]) (*connect.Response[proto.CheckArchiveSolutionResponse], error) {
+	return c.checkArchiveSolution.CallUnary(ctx, req)
+}
+
+// WatchRoom calls bouncebot.BounceBot.WatchRoom.
+func (c *bounceBotClient) WatchRoom(ctx context.Context, req *connect.Request[proto.WatchRoomRequest]) (*connect.ServerStreamForClient[proto.RoomEvent], error) {
+	return c.watchRoom.CallServerStream(ctx, req)
+}
+
+// CreateTournament calls bouncebot.BounceBot.CreateTournament.
+func (c *bounceBotClient) CreateTournament(ctx context.Context, req *connect.Request[proto.CreateTournamentRequest]) (*connect.Response[proto.Tournament], error) {
+	return c.createTournament.CallUnary(ctx, req)
+}
+
+// StartTournament calls bouncebot.BounceBot.StartTournament.
+func (c *bounceBotClient) StartTournament(ctx context.Context, req *connect.Request[proto.StartTournamentRequest]) (*connect.Response[proto.Tournament], error) {
+	return c.startTournament.CallUnary(ctx, req)
+}
+
+// ReportTableResult calls bouncebot.BounceBot.ReportTableResult.
+func (c *bounceBotClient) ReportTableResult(ctx context.Context, req *connect.Request[proto.ReportTableResultRequest]) (*connect.Response[proto.Tournament], error) {
+	return c.reportTableResult.CallUnary(ctx, req)
+}
+
+// GetTournament calls bouncebot.BounceBot.GetTournament.
+func (c *bounceBotClient) GetTournament(ctx context.Context, req *connect.Request[proto.GetTournamentRequest]) (*connect.Response[proto.Tournament], error) {
+	return c.getTournament.CallUnary(ctx, req)
+}
+
+// WatchTournament calls bouncebot.BounceBot.WatchTournament.
+func (c *bounceBotClient) WatchTournament(ctx context.Context, req *connect.Request[proto.WatchTournamentRequest]) (*connect.ServerStreamForClient[proto.TournamentEvent], error) {
+	return c.watchTournament.CallServerStream(ctx, req)
+}
+
 // BounceBotHandler is an implementation of the bouncebot.BounceBot service.
 type BounceBotHandler interface {
 	// Room management
@@ -193,6 +676,42 @@ type BounceBotHandler interface {
 	RetractSolution(context.Context, *connect.Request[proto.RetractSolutionRequest]) (*connect.Response[proto.RetractSolutionResponse], error)
 	MarkFinishedSolving(context.Context, *connect.Request[proto.MarkFinishedSolvingRequest]) (*connect.Response[proto.MarkFinishedSolvingResponse], error)
 	MarkReadyForNext(context.Context, *connect.Request[proto.MarkReadyForNextRequest]) (*connect.Response[proto.MarkReadyForNextResponse], error)
+	SpectateRoom(context.Context, *connect.Request[proto.SpectateRoomRequest]) (*connect.Response[proto.SpectateRoomResponse], error)
+	GetRoomHistory(context.Context, *connect.Request[proto.GetRoomHistoryRequest]) (*connect.Response[proto.GetRoomHistoryResponse], error)
+	ExportReplay(context.Context, *connect.Request[proto.ExportReplayRequest]) (*connect.Response[proto.Replay], error)
+	AddBot(context.Context, *connect.Request[proto.AddBotRequest]) (*connect.Response[proto.AddBotResponse], error)
+	RemoveBot(context.Context, *connect.Request[proto.RemoveBotRequest]) (*connect.Response[proto.Room], error)
+	RequestHint(context.Context, *connect.Request[proto.RequestHintRequest]) (*connect.Response[proto.Hint], error)
+	SetTeam(context.Context, *connect.Request[proto.SetTeamRequest]) (*connect.Response[proto.Room], error)
+	AssignTeams(context.Context, *connect.Request[proto.AssignTeamsRequest]) (*connect.Response[proto.Room], error)
+	SetTeamQuorum(context.Context, *connect.Request[proto.SetTeamQuorumRequest]) (*connect.Response[proto.Room], error)
+	GetTeams(context.Context, *connect.Request[proto.GetTeamsRequest]) (*connect.Response[proto.GetTeamsResponse], error)
+	StartMatch(context.Context, *connect.Request[proto.StartMatchRequest]) (*connect.Response[proto.Room], error)
+	StopMatch(context.Context, *connect.Request[proto.StopMatchRequest]) (*connect.Response[proto.Room], error)
+	SetHandicap(context.Context, *connect.Request[proto.SetHandicapRequest]) (*connect.Response[proto.Room], error)
+	SuggestHandicaps(context.Context, *connect.Request[proto.SuggestHandicapsRequest]) (*connect.Response[proto.SuggestHandicapsResponse], error)
+	// Player accounts
+	CreateAccount(context.Context, *connect.Request[proto.CreateAccountRequest]) (*connect.Response[proto.CreateAccountResponse], error)
+	ClaimAccount(context.Context, *connect.Request[proto.ClaimAccountRequest]) (*connect.Response[proto.Account], error)
+	GetRatings(context.Context, *connect.Request[proto.GetRatingsRequest]) (*connect.Response[proto.GetRatingsResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[proto.GetLeaderboardRequest]) (*connect.Response[proto.GetLeaderboardResponse], error)
+	GetPlayerStats(context.Context, *connect.Request[proto.GetPlayerStatsRequest]) (*connect.Response[proto.PlayerStats], error)
+	// Daily puzzle
+	GetDailyPuzzle(context.Context, *connect.Request[proto.GetDailyPuzzleRequest]) (*connect.Response[proto.DailyPuzzle], error)
+	SubmitDailySolution(context.Context, *connect.Request[proto.SubmitDailySolutionRequest]) (*connect.Response[proto.DailyEntry], error)
+	GetDailyLeaderboard(context.Context, *connect.Request[proto.GetDailyLeaderboardRequest]) (*connect.Response[proto.GetDailyLeaderboardResponse], error)
+	// Puzzle archive
+	SearchArchive(context.Context, *connect.Request[proto.SearchArchiveRequest]) (*connect.Response[proto.SearchArchiveResponse], error)
+	GetArchivePuzzle(context.Context, *connect.Request[proto.GetArchivePuzzleRequest]) (*connect.Response[proto.ArchivePuzzle], error)
+	CheckArchiveSolution(context.Context, *connect.Request[proto.CheckArchiveSolutionRequest]) (*connect.Response[proto.CheckArchiveSolutionResponse], error)
+	// Room events (alternative to the WebSocket channel)
+	WatchRoom(context.Context, *connect.Request[proto.WatchRoomRequest], *connect.ServerStream[proto.RoomEvent]) error
+	// Tournaments; creating, starting and reporting results need the admin token
+	CreateTournament(context.Context, *connect.Request[proto.CreateTournamentRequest]) (*connect.Response[proto.Tournament], error)
+	StartTournament(context.Context, *connect.Request[proto.StartTournamentRequest]) (*connect.Response[proto.Tournament], error)
+	ReportTableResult(context.Context, *connect.Request[proto.ReportTableResultRequest]) (*connect.Response[proto.Tournament], error)
+	GetTournament(context.Context, *connect.Request[proto.GetTournamentRequest]) (*connect.Response[proto.Tournament], error)
+	WatchTournament(context.Context, *connect.Request[proto.WatchTournamentRequest], *connect.ServerStream[proto.TournamentEvent]) error
 }
 
 // NewBounceBotHandler builds an HTTP handler from the service implementation. It returns the path
@@ -250,6 +769,192 @@ func NewBounceBotHandler(svc BounceBotHandler, opts ...connect.HandlerOption) (s
 		connect.WithSchema(bounceBotMethods.ByName("MarkReadyForNext")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bounceBotSpectateRoomHandler := connect.NewUnaryHandler(
+		BounceBotSpectateRoomProcedure,
+		svc.SpectateRoom,
+		connect.WithSchema(bounceBotMethods.ByName("SpectateRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetRoomHistoryHandler := connect.NewUnaryHandler(
+		BounceBotGetRoomHistoryProcedure,
+		svc.GetRoomHistory,
+		connect.WithSchema(bounceBotMethods.ByName("GetRoomHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotExportReplayHandler := connect.NewUnaryHandler(
+		BounceBotExportReplayProcedure,
+		svc.ExportReplay,
+		connect.WithSchema(bounceBotMethods.ByName("ExportReplay")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotAddBotHandler := connect.NewUnaryHandler(
+		BounceBotAddBotProcedure,
+		svc.AddBot,
+		connect.WithSchema(bounceBotMethods.ByName("AddBot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotRemoveBotHandler := connect.NewUnaryHandler(
+		BounceBotRemoveBotProcedure,
+		svc.RemoveBot,
+		connect.WithSchema(bounceBotMethods.ByName("RemoveBot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotRequestHintHandler := connect.NewUnaryHandler(
+		BounceBotRequestHintProcedure,
+		svc.RequestHint,
+		connect.WithSchema(bounceBotMethods.ByName("RequestHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotSetTeamHandler := connect.NewUnaryHandler(
+		BounceBotSetTeamProcedure,
+		svc.SetTeam,
+		connect.WithSchema(bounceBotMethods.ByName("SetTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotAssignTeamsHandler := connect.NewUnaryHandler(
+		BounceBotAssignTeamsProcedure,
+		svc.AssignTeams,
+		connect.WithSchema(bounceBotMethods.ByName("AssignTeams")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotSetTeamQuorumHandler := connect.NewUnaryHandler(
+		BounceBotSetTeamQuorumProcedure,
+		svc.SetTeamQuorum,
+		connect.WithSchema(bounceBotMethods.ByName("SetTeamQuorum")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetTeamsHandler := connect.NewUnaryHandler(
+		BounceBotGetTeamsProcedure,
+		svc.GetTeams,
+		connect.WithSchema(bounceBotMethods.ByName("GetTeams")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotStartMatchHandler := connect.NewUnaryHandler(
+		BounceBotStartMatchProcedure,
+		svc.StartMatch,
+		connect.WithSchema(bounceBotMethods.ByName("StartMatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotStopMatchHandler := connect.NewUnaryHandler(
+		BounceBotStopMatchProcedure,
+		svc.StopMatch,
+		connect.WithSchema(bounceBotMethods.ByName("StopMatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotSetHandicapHandler := connect.NewUnaryHandler(
+		BounceBotSetHandicapProcedure,
+		svc.SetHandicap,
+		connect.WithSchema(bounceBotMethods.ByName("SetHandicap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotSuggestHandicapsHandler := connect.NewUnaryHandler(
+		BounceBotSuggestHandicapsProcedure,
+		svc.SuggestHandicaps,
+		connect.WithSchema(bounceBotMethods.ByName("SuggestHandicaps")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotCreateAccountHandler := connect.NewUnaryHandler(
+		BounceBotCreateAccountProcedure,
+		svc.CreateAccount,
+		connect.WithSchema(bounceBotMethods.ByName("CreateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotClaimAccountHandler := connect.NewUnaryHandler(
+		BounceBotClaimAccountProcedure,
+		svc.ClaimAccount,
+		connect.WithSchema(bounceBotMethods.ByName("ClaimAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetRatingsHandler := connect.NewUnaryHandler(
+		BounceBotGetRatingsProcedure,
+		svc.GetRatings,
+		connect.WithSchema(bounceBotMethods.ByName("GetRatings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetLeaderboardHandler := connect.NewUnaryHandler(
+		BounceBotGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(bounceBotMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetPlayerStatsHandler := connect.NewUnaryHandler(
+		BounceBotGetPlayerStatsProcedure,
+		svc.GetPlayerStats,
+		connect.WithSchema(bounceBotMethods.ByName("GetPlayerStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetDailyPuzzleHandler := connect.NewUnaryHandler(
+		BounceBotGetDailyPuzzleProcedure,
+		svc.GetDailyPuzzle,
+		connect.WithSchema(bounceBotMethods.ByName("GetDailyPuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotSubmitDailySolutionHandler := connect.NewUnaryHandler(
+		BounceBotSubmitDailySolutionProcedure,
+		svc.SubmitDailySolution,
+		connect.WithSchema(bounceBotMethods.ByName("SubmitDailySolution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetDailyLeaderboardHandler := connect.NewUnaryHandler(
+		BounceBotGetDailyLeaderboardProcedure,
+		svc.GetDailyLeaderboard,
+		connect.WithSchema(bounceBotMethods.ByName("GetDailyLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotSearchArchiveHandler := connect.NewUnaryHandler(
+		BounceBotSearchArchiveProcedure,
+		svc.SearchArchive,
+		connect.WithSchema(bounceBotMethods.ByName("SearchArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetArchivePuzzleHandler := connect.NewUnaryHandler(
+		BounceBotGetArchivePuzzleProcedure,
+		svc.GetArchivePuzzle,
+		connect.WithSchema(bounceBotMethods.ByName("GetArchivePuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotCheckArchiveSolutionHandler := connect.NewUnaryHandler(
+		BounceBotCheckArchiveSolutionProcedure,
+		svc.CheckArchiveSolution,
+		connect.WithSchema(bounceBotMethods.ByName("CheckArchiveSolution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotWatchRoomHandler := connect.NewServerStreamHandler(
+		BounceBotWatchRoomProcedure,
+		svc.WatchRoom,
+		connect.WithSchema(bounceBotMethods.ByName("WatchRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotCreateTournamentHandler := connect.NewUnaryHandler(
+		BounceBotCreateTournamentProcedure,
+		svc.CreateTournament,
+		connect.WithSchema(bounceBotMethods.ByName("CreateTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotStartTournamentHandler := connect.NewUnaryHandler(
+		BounceBotStartTournamentProcedure,
+		svc.StartTournament,
+		connect.WithSchema(bounceBotMethods.ByName("StartTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotReportTableResultHandler := connect.NewUnaryHandler(
+		BounceBotReportTableResultProcedure,
+		svc.ReportTableResult,
+		connect.WithSchema(bounceBotMethods.ByName("ReportTableResult")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotGetTournamentHandler := connect.NewUnaryHandler(
+		BounceBotGetTournamentProcedure,
+		svc.GetTournament,
+		connect.WithSchema(bounceBotMethods.ByName("GetTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bounceBotWatchTournamentHandler := connect.NewServerStreamHandler(
+		BounceBotWatchTournamentProcedure,
+		svc.WatchTournament,
+		connect.WithSchema(bounceBotMethods.ByName("WatchTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bouncebot.BounceBot/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BounceBotCreateRoomProcedure:
@@ -268,6 +973,68 @@ func NewBounceBotHandler(svc BounceBotHandler, opts ...connect.HandlerOption) (s
 			bounceBotMarkFinishedSolvingHandler.ServeHTTP(w, r)
 		case BounceBotMarkReadyForNextProcedure:
 			bounceBotMarkReadyForNextHandler.ServeHTTP(w, r)
+		case BounceBotSpectateRoomProcedure:
+			bounceBotSpectateRoomHandler.ServeHTTP(w, r)
+		case BounceBotGetRoomHistoryProcedure:
+			bounceBotGetRoomHistoryHandler.ServeHTTP(w, r)
+		case BounceBotExportReplayProcedure:
+			bounceBotExportReplayHandler.ServeHTTP(w, r)
+		case BounceBotAddBotProcedure:
+			bounceBotAddBotHandler.ServeHTTP(w, r)
+		case BounceBotRemoveBotProcedure:
+			bounceBotRemoveBotHandler.ServeHTTP(w, r)
+		case BounceBotRequestHintProcedure:
+			bounceBotRequestHintHandler.ServeHTTP(w, r)
+		case BounceBotSetTeamProcedure:
+			bounceBotSetTeamHandler.ServeHTTP(w, r)
+		case BounceBotAssignTeamsProcedure:
+			bounceBotAssignTeamsHandler.ServeHTTP(w, r)
+		case BounceBotSetTeamQuorumProcedure:
+			bounceBotSetTeamQuorumHandler.ServeHTTP(w, r)
+		case BounceBotGetTeamsProcedure:
+			bounceBotGetTeamsHandler.ServeHTTP(w, r)
+		case BounceBotStartMatchProcedure:
+			bounceBotStartMatchHandler.ServeHTTP(w, r)
+		case BounceBotStopMatchProcedure:
+			bounceBotStopMatchHandler.ServeHTTP(w, r)
+		case BounceBotSetHandicapProcedure:
+			bounceBotSetHandicapHandler.ServeHTTP(w, r)
+		case BounceBotSuggestHandicapsProcedure:
+			bounceBotSuggestHandicapsHandler.ServeHTTP(w, r)
+		case BounceBotCreateAccountProcedure:
+			bounceBotCreateAccountHandler.ServeHTTP(w, r)
+		case BounceBotClaimAccountProcedure:
+			bounceBotClaimAccountHandler.ServeHTTP(w, r)
+		case BounceBotGetRatingsProcedure:
+			bounceBotGetRatingsHandler.ServeHTTP(w, r)
+		case BounceBotGetLeaderboardProcedure:
+			bounceBotGetLeaderboardHandler.ServeHTTP(w, r)
+		case BounceBotGetPlayerStatsProcedure:
+			bounceBotGetPlayerStatsHandler.ServeHTTP(w, r)
+		case BounceBotGetDailyPuzzleProcedure:
+			bounceBotGetDailyPuzzleHandler.ServeHTTP(w, r)
+		case BounceBotSubmitDailySolutionProcedure:
+			bounceBotSubmitDailySolutionHandler.ServeHTTP(w, r)
+		case BounceBotGetDailyLeaderboardProcedure:
+			bounceBotGetDailyLeaderboardHandler.ServeHTTP(w, r)
+		case BounceBotSearchArchiveProcedure:
+			bounceBotSearchArchiveHandler.ServeHTTP(w, r)
+		case BounceBotGetArchivePuzzleProcedure:
+			bounceBotGetArchivePuzzleHandler.ServeHTTP(w, r)
+		case BounceBotCheckArchiveSolutionProcedure:
+			bounceBotCheckArchiveSolutionHandler.ServeHTTP(w, r)
+		case BounceBotWatchRoomProcedure:
+			bounceBotWatchRoomHandler.ServeHTTP(w, r)
+		case BounceBotCreateTournamentProcedure:
+			bounceBotCreateTournamentHandler.ServeHTTP(w, r)
+		case BounceBotStartTournamentProcedure:
+			bounceBotStartTournamentHandler.ServeHTTP(w, r)
+		case BounceBotReportTableResultProcedure:
+			bounceBotReportTableResultHandler.ServeHTTP(w, r)
+		case BounceBotGetTournamentProcedure:
+			bounceBotGetTournamentHandler.ServeHTTP(w, r)
+		case BounceBotWatchTournamentProcedure:
+			bounceBotWatchTournamentHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -308,3 +1075,127 @@ func (UnimplementedBounceBotHandler) MarkFinishedSolving(context.Context, *conne
 func (UnimplementedBounceBotHandler) MarkReadyForNext(context.Context, *connect.Request[proto.MarkReadyForNextRequest]) (*connect.Response[proto.MarkReadyForNextResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.MarkReadyForNext is not implemented"))
 }
+
+func (UnimplementedBounceBotHandler) SpectateRoom(context.Context, *connect.Request[proto.SpectateRoomRequest]) (*connect.Response[proto.SpectateRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SpectateRoom is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetRoomHistory(context.Context, *connect.Request[proto.GetRoomHistoryRequest]) (*connect.Response[proto.GetRoomHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetRoomHistory is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) ExportReplay(context.Context, *connect.Request[proto.ExportReplayRequest]) (*connect.Response[proto.Replay], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.ExportReplay is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) AddBot(context.Context, *connect.Request[proto.AddBotRequest]) (*connect.Response[proto.AddBotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.AddBot is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) RemoveBot(context.Context, *connect.Request[proto.RemoveBotRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.RemoveBot is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) RequestHint(context.Context, *connect.Request[proto.RequestHintRequest]) (*connect.Response[proto.Hint], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.RequestHint is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) SetTeam(context.Context, *connect.Request[proto.SetTeamRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SetTeam is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) AssignTeams(context.Context, *connect.Request[proto.AssignTeamsRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.AssignTeams is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) SetTeamQuorum(context.Context, *connect.Request[proto.SetTeamQuorumRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SetTeamQuorum is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetTeams(context.Context, *connect.Request[proto.GetTeamsRequest]) (*connect.Response[proto.GetTeamsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetTeams is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) StartMatch(context.Context, *connect.Request[proto.StartMatchRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.StartMatch is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) StopMatch(context.Context, *connect.Request[proto.StopMatchRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.StopMatch is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) SetHandicap(context.Context, *connect.Request[proto.SetHandicapRequest]) (*connect.Response[proto.Room], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SetHandicap is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) SuggestHandicaps(context.Context, *connect.Request[proto.SuggestHandicapsRequest]) (*connect.Response[proto.SuggestHandicapsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SuggestHandicaps is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) CreateAccount(context.Context, *connect.Request[proto.CreateAccountRequest]) (*connect.Response[proto.CreateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.CreateAccount is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) ClaimAccount(context.Context, *connect.Request[proto.ClaimAccountRequest]) (*connect.Response[proto.Account], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.ClaimAccount is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetRatings(context.Context, *connect.Request[proto.GetRatingsRequest]) (*connect.Response[proto.GetRatingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetRatings is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetLeaderboard(context.Context, *connect.Request[proto.GetLeaderboardRequest]) (*connect.Response[proto.GetLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetLeaderboard is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetPlayerStats(context.Context, *connect.Request[proto.GetPlayerStatsRequest]) (*connect.Response[proto.PlayerStats], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetPlayerStats is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetDailyPuzzle(context.Context, *connect.Request[proto.GetDailyPuzzleRequest]) (*connect.Response[proto.DailyPuzzle], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetDailyPuzzle is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) SubmitDailySolution(context.Context, *connect.Request[proto.SubmitDailySolutionRequest]) (*connect.Response[proto.DailyEntry], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SubmitDailySolution is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetDailyLeaderboard(context.Context, *connect.Request[proto.GetDailyLeaderboardRequest]) (*connect.Response[proto.GetDailyLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetDailyLeaderboard is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) SearchArchive(context.Context, *connect.Request[proto.SearchArchiveRequest]) (*connect.Response[proto.SearchArchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.SearchArchive is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetArchivePuzzle(context.Context, *connect.Request[proto.GetArchivePuzzleRequest]) (*connect.Response[proto.ArchivePuzzle], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetArchivePuzzle is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) CheckArchiveSolution(context.Context, *connect.Request[proto.CheckArchiveSolutionRequest]) (*connect.Response[proto.CheckArchiveSolutionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.CheckArchiveSolution is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) WatchRoom(context.Context, *connect.Request[proto.WatchRoomRequest], *connect.ServerStream[proto.RoomEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.WatchRoom is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) CreateTournament(context.Context, *connect.Request[proto.CreateTournamentRequest]) (*connect.Response[proto.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.CreateTournament is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) StartTournament(context.Context, *connect.Request[proto.StartTournamentRequest]) (*connect.Response[proto.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.StartTournament is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) ReportTableResult(context.Context, *connect.Request[proto.ReportTableResultRequest]) (*connect.Response[proto.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.ReportTableResult is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) GetTournament(context.Context, *connect.Request[proto.GetTournamentRequest]) (*connect.Response[proto.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.GetTournament is not implemented"))
+}
+
+func (UnimplementedBounceBotHandler) WatchTournament(context.Context, *connect.Request[proto.WatchTournamentRequest], *connect.ServerStream[proto.TournamentEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bouncebot.BounceBot.WatchTournament is not implemented"))
+}
@@ -0,0 +1,235 @@
+// Package account keeps player accounts, so wins and stats follow a person
+// across rooms and server restarts.
+package account
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	mrand "math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// currentVersion is the accounts file format version written by the Store.
+const currentVersion = 1
+
+// Account is a player identity shared across rooms.
+type Account struct {
+	ID          string
+	Name        string
+	TokenHash   string // Hex SHA-256 of the claim token; the token itself is never stored
+	CreatedAt   time.Time
+	Wins        int // Games won in any room
+	GamesPlayed int // Games completed in any room while signed in
+}
+
+// ToProto converts an Account to its protobuf representation.
+func (a *Account) ToProto() *pb.Account {
+	return &pb.Account{
+		Id:          a.ID,
+		Name:        a.Name,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		Wins:        int32(a.Wins),
+		GamesPlayed: int32(a.GamesPlayed),
+	}
+}
+
+// Store holds accounts in memory. After Load, every change is written to the
+// accounts file. Methods return copies, so callers never share an Account with
+// the store.
+type Store struct {
+	mu       sync.Mutex
+	filename string              // Set by Load; empty keeps accounts in memory only
+	accounts map[string]*Account // By ID
+	byToken  map[string]string   // Token hash -> account ID
+	now      func() time.Time
+}
+
+// NewStore creates an empty in-memory Store.
+func NewStore() *Store {
+	return &Store{
+		accounts: make(map[string]*Account),
+		byToken:  make(map[string]string),
+		now:      time.Now,
+	}
+}
+
+// persistedAccounts is the JSON structure of the accounts file.
+type persistedAccounts struct {
+	Accounts []*Account `json:"accounts"`
+	SavedAt  time.Time  `json:"saved_at"`
+	Version  int        `json:"version"`
+}
+
+// Load reads accounts from the file, if it exists, and saves changes there afterwards.
+// Files from a newer format version are refused.
+func (s *Store) Load(filename string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 {
+		var pa persistedAccounts
+		if err := json.Unmarshal(data, &pa); err != nil {
+			return err
+		}
+		if pa.Version > currentVersion {
+			return fmt.Errorf("accounts file version %d is newer than supported version %d", pa.Version, currentVersion)
+		}
+		for _, a := range pa.Accounts {
+			s.accounts[a.ID] = a
+			s.byToken[a.TokenHash] = a.ID
+		}
+		slog.Info("Loaded accounts", "count", len(pa.Accounts), "file", filename)
+	}
+
+	s.filename = filename
+	return nil
+}
+
+// Create creates an account with the given display name. It returns the
+// account and its claim token, which is needed to sign in and is not stored.
+func (s *Store) Create(name string) (*Account, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", fmt.Errorf("account name is required")
+	}
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := generateAccountID()
+	for s.accounts[id] != nil {
+		id = generateAccountID()
+	}
+	a := &Account{ID: id, Name: name, TokenHash: hashToken(token), CreatedAt: s.now()}
+	s.accounts[id] = a
+	s.byToken[a.TokenHash] = id
+	if err := s.save(); err != nil {
+		delete(s.accounts, id)
+		delete(s.byToken, a.TokenHash)
+		return nil, "", err
+	}
+
+	acct := *a
+	return &acct, token, nil
+}
+
+// Claim returns the account a claim token belongs to.
+func (s *Store) Claim(token string) (*Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, ok := s.byToken[hashToken(token)]
+	if !ok || token == "" {
+		return nil, fmt.Errorf("invalid account token")
+	}
+	acct := *s.accounts[id]
+	return &acct, nil
+}
+
+// Get returns the account with the given ID.
+func (s *Store) Get(id string) (*Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.accounts[id]
+	if !ok {
+		return nil, fmt.Errorf("account not found: %s", id)
+	}
+	acct := *a
+	return &acct, nil
+}
+
+// RecordGame credits a completed game to the accounts of the players who were
+// signed in, implementing room.GameRecorder.
+func (s *Store) RecordGame(roomID string, rec room.GameRecord) {
+	if len(rec.AccountIDs) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for playerID, accountID := range rec.AccountIDs {
+		a, ok := s.accounts[accountID]
+		if !ok {
+			continue
+		}
+		a.GamesPlayed++
+		if playerID == rec.WinnerID {
+			a.Wins++
+		}
+	}
+	if err := s.save(); err != nil {
+		slog.Error("Failed to save accounts after game", logging.RoomID(roomID), "error", err)
+	}
+}
+
+// save writes every account to the accounts file. Must be called with mu held.
+func (s *Store) save() error {
+	if s.filename == "" {
+		return nil
+	}
+
+	pa := persistedAccounts{SavedAt: s.now(), Version: currentVersion}
+	for _, a := range s.accounts {
+		pa.Accounts = append(pa.Accounts, a)
+	}
+	slices.SortFunc(pa.Accounts, func(a, b *Account) int { return strings.Compare(a.ID, b.ID) })
+
+	data, err := json.MarshalIndent(pa, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to temp file first, then rename for atomicity
+	tmpFile := s.filename + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, s.filename); err != nil {
+		os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
+
+// generateAccountID creates a random account ID.
+func generateAccountID() string {
+	return fmt.Sprintf("%016x", mrand.Uint64())
+}
+
+// generateToken creates a random claim token.
+func generateToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashToken returns the stored form of a claim token.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
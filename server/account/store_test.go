@@ -0,0 +1,127 @@
+package account
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+func TestStore_CreateAndClaim(t *testing.T) {
+	s := NewStore()
+
+	acct, token, err := s.Create("  Alice ")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if acct.ID == "" || acct.Name != "Alice" || token == "" {
+		t.Errorf("unexpected account %+v with token %q", acct, token)
+	}
+	if acct.TokenHash == token || strings.Contains(acct.TokenHash, token) {
+		t.Error("expected only a hash of the token to be kept")
+	}
+
+	claimed, err := s.Claim(token)
+	if err != nil {
+		t.Fatalf("Claim failed: %v", err)
+	}
+	if claimed.ID != acct.ID {
+		t.Errorf("expected to claim %s, got %s", acct.ID, claimed.ID)
+	}
+
+	if _, err := s.Claim("wrong"); err == nil {
+		t.Error("expected error for unknown token")
+	}
+	if _, err := s.Claim(""); err == nil {
+		t.Error("expected error for empty token")
+	}
+}
+
+func TestStore_Create_RequiresName(t *testing.T) {
+	if _, _, err := NewStore().Create("   "); err == nil {
+		t.Error("expected error for empty name")
+	}
+}
+
+func TestStore_Get(t *testing.T) {
+	s := NewStore()
+	acct, _, _ := s.Create("Alice")
+
+	got, err := s.Get(acct.ID)
+	if err != nil || got.Name != "Alice" {
+		t.Errorf("expected Alice, got %+v (%v)", got, err)
+	}
+
+	// Returned accounts are copies
+	got.Name = "Mallory"
+	if again, _ := s.Get(acct.ID); again.Name != "Alice" {
+		t.Error("expected changes to a returned account not to affect the store")
+	}
+
+	if _, err := s.Get("nope"); err == nil {
+		t.Error("expected error for unknown account")
+	}
+}
+
+func TestStore_RecordGame(t *testing.T) {
+	s := NewStore()
+	alice, _, _ := s.Create("Alice")
+	bob, _, _ := s.Create("Bob")
+
+	s.RecordGame("ROOM1", room.GameRecord{
+		AccountIDs: map[string]string{"p1": alice.ID, "p2": bob.ID, "p3": "deleted"},
+		WinnerID:   "p2",
+	})
+	s.RecordGame("ROOM2", room.GameRecord{
+		AccountIDs: map[string]string{"x": alice.ID},
+		WinnerID:   "x",
+	})
+	// Guests only; nothing to credit
+	s.RecordGame("ROOM3", room.GameRecord{WinnerID: "guest"})
+
+	alice, _ = s.Get(alice.ID)
+	bob, _ = s.Get(bob.ID)
+	if alice.GamesPlayed != 2 || alice.Wins != 1 {
+		t.Errorf("expected Alice to have 1 win in 2 games, got %d in %d", alice.Wins, alice.GamesPlayed)
+	}
+	if bob.GamesPlayed != 1 || bob.Wins != 1 {
+		t.Errorf("expected Bob to have 1 win in 1 game, got %d in %d", bob.Wins, bob.GamesPlayed)
+	}
+}
+
+func TestStore_PersistsAcrossLoads(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "accounts.json")
+
+	s1 := NewStore()
+	if err := s1.Load(filename); err != nil {
+		t.Fatalf("Load of missing file failed: %v", err)
+	}
+	acct, token, _ := s1.Create("Alice")
+	s1.RecordGame("ROOM1", room.GameRecord{AccountIDs: map[string]string{"p1": acct.ID}, WinnerID: "p1"})
+
+	s2 := NewStore()
+	if err := s2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	got, err := s2.Claim(token)
+	if err != nil {
+		t.Fatalf("expected token to work after reload: %v", err)
+	}
+	if got.ID != acct.ID || got.Wins != 1 || got.GamesPlayed != 1 || !got.CreatedAt.Equal(acct.CreatedAt) {
+		t.Errorf("expected %+v with 1 win, got %+v", acct, got)
+	}
+}
+
+func TestStore_Load_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "accounts.json")
+	if err := os.WriteFile(filename, []byte(`{"accounts":[],"version":99}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	err := NewStore().Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package archive
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// currentVersion is the archive file format version written by Save.
+const currentVersion = 1
+
+// HelperFilter restricts a search by whether puzzles need helper bots.
+type HelperFilter int
+
+const (
+	AnyHelpers HelperFilter = iota
+	HelpersRequired
+	HelpersNotRequired
+)
+
+// Query selects archived puzzles. Zero fields don't restrict the search.
+type Query struct {
+	MinMoves int
+	MaxMoves int
+	Bots     []model.BotId // Bots the optimal solution must move, among others
+	Helpers  HelperFilter
+	Tags     []string // Tags the puzzle must all have
+}
+
+// matches reports whether a puzzle satisfies the query.
+func (q *Query) matches(p *Puzzle) bool {
+	n := p.MoveCount()
+	if (q.MinMoves > 0 && n < q.MinMoves) || (q.MaxMoves > 0 && n > q.MaxMoves) {
+		return false
+	}
+	required := p.RequiredBots()
+	for _, id := range q.Bots {
+		if !slices.Contains(required, id) {
+			return false
+		}
+	}
+	switch q.Helpers {
+	case HelpersRequired:
+		if !p.NeedsHelpers {
+			return false
+		}
+	case HelpersNotRequired:
+		if p.NeedsHelpers {
+			return false
+		}
+	}
+	for _, tag := range q.Tags {
+		if !p.HasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// Archive is a read-only catalogue of puzzles, ordered by move count, then seed.
+type Archive struct {
+	puzzles []*Puzzle
+	byID    map[string]*Puzzle
+}
+
+// New creates an archive of the given puzzles.
+func New(puzzles []*Puzzle) *Archive {
+	a := &Archive{puzzles: slices.Clone(puzzles), byID: make(map[string]*Puzzle, len(puzzles))}
+	slices.SortFunc(a.puzzles, func(x, y *Puzzle) int {
+		if c := cmp.Compare(x.MoveCount(), y.MoveCount()); c != 0 {
+			return c
+		}
+		return cmp.Compare(x.Seed, y.Seed)
+	})
+	for _, p := range a.puzzles {
+		a.byID[p.ID] = p
+	}
+	return a
+}
+
+// Len returns the number of puzzles in the archive.
+func (a *Archive) Len() int {
+	return len(a.puzzles)
+}
+
+// Get returns the puzzle with the given ID.
+func (a *Archive) Get(id string) (*Puzzle, error) {
+	p, ok := a.byID[id]
+	if !ok {
+		return nil, fmt.Errorf("puzzle not found: %s", id)
+	}
+	return p, nil
+}
+
+// Search returns up to limit puzzles matching the query, skipping the first
+// offset matches, and the total number of matches.
+func (a *Archive) Search(q Query, offset, limit int) ([]*Puzzle, int) {
+	var page []*Puzzle
+	total := 0
+	for _, p := range a.puzzles {
+		if !q.matches(p) {
+			continue
+		}
+		if total >= offset && len(page) < limit {
+			page = append(page, p)
+		}
+		total++
+	}
+	return page, total
+}
+
+// persistedArchive is the JSON structure of the archive file.
+type persistedArchive struct {
+	Puzzles     []*Puzzle `json:"puzzles"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Version     int       `json:"version"`
+}
+
+// Load reads an archive file written by Save. A missing file is an empty archive.
+// Games are regenerated from their seeds, which restores the board details the
+// file doesn't keep, and a game that doesn't match its seed is refused.
+func Load(filename string) (*Archive, error) {
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return New(nil), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var pa persistedArchive
+	if err := json.Unmarshal(data, &pa); err != nil {
+		return nil, err
+	}
+	if pa.Version > currentVersion {
+		return nil, fmt.Errorf("archive file version %d is newer than supported version %d", pa.Version, currentVersion)
+	}
+	for _, p := range pa.Puzzles {
+		game := model.NewRandomGameFromSeed(p.Seed)
+		if p.Game == nil || !game.Equals(p.Game) {
+			return nil, fmt.Errorf("puzzle %s does not match its seed %d", p.ID, p.Seed)
+		}
+		p.Game = game
+	}
+	return New(pa.Puzzles), nil
+}
+
+// Save writes the archive to a file.
+func (a *Archive) Save(filename string) error {
+	pa := persistedArchive{Puzzles: a.puzzles, GeneratedAt: time.Now(), Version: currentVersion}
+	data, err := json.MarshalIndent(pa, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to temp file first, then rename for atomicity
+	tmpFile := filename + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, filename); err != nil {
+		os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// fakePuzzle returns a puzzle whose solution moves the given bots, one move each,
+// then pads it with target bot moves up to n moves.
+func fakePuzzle(id string, seed int64, n int, helpers bool, bots ...model.BotId) *Puzzle {
+	p := &Puzzle{ID: id, Seed: seed, NeedsHelpers: helpers}
+	for _, b := range bots {
+		p.Solution = append(p.Solution, model.BotPosition{Id: b})
+	}
+	for len(p.Solution) < n {
+		p.Solution = append(p.Solution, model.BotPosition{Id: bots[0]})
+	}
+	p.Tags = tags(p)
+	return p
+}
+
+func testArchive() *Archive {
+	return New([]*Puzzle{
+		fakePuzzle("c", 3, 9, true, 0, 2),
+		fakePuzzle("a", 1, 3, false, 0),
+		fakePuzzle("b", 2, 6, true, 1, 3),
+		fakePuzzle("d", 4, 6, false, 2),
+	})
+}
+
+func ids(puzzles []*Puzzle) string {
+	var out []string
+	for _, p := range puzzles {
+		out = append(out, p.ID)
+	}
+	return strings.Join(out, ",")
+}
+
+func TestArchive_Search(t *testing.T) {
+	a := testArchive()
+	tests := []struct {
+		name string
+		q    Query
+		want string
+	}{
+		{"everything, fewest moves first", Query{}, "a,b,d,c"},
+		{"move range", Query{MinMoves: 4, MaxMoves: 8}, "b,d"},
+		{"required bots", Query{Bots: []model.BotId{2}}, "d,c"},
+		{"helpers required", Query{Helpers: HelpersRequired}, "b,c"},
+		{"no helpers", Query{Helpers: HelpersNotRequired}, "a,d"},
+		{"tags", Query{Tags: []string{TagMedium, TagHelpers}}, "b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, total := a.Search(tt.q, 0, 10)
+			if ids(got) != tt.want || total != len(got) {
+				t.Errorf("expected %s, got %s (total %d)", tt.want, ids(got), total)
+			}
+		})
+	}
+}
+
+func TestArchive_Search_Pages(t *testing.T) {
+	got, total := testArchive().Search(Query{}, 1, 2)
+	if ids(got) != "b,d" || total != 4 {
+		t.Errorf("expected b,d of 4, got %s of %d", ids(got), total)
+	}
+}
+
+func TestArchive_Get(t *testing.T) {
+	a := testArchive()
+	if p, err := a.Get("b"); err != nil || p.Seed != 2 {
+		t.Errorf("expected puzzle b, got %v, %v", p, err)
+	}
+	if _, err := a.Get("missing"); err == nil {
+		t.Error("expected an unknown puzzle to be an error")
+	}
+}
+
+func TestArchive_SaveAndLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "archive.json")
+	a := New(generate(t, 5))
+	if err := a.Save(filename); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Len() != a.Len() {
+		t.Fatalf("expected %d puzzles, got %d", a.Len(), loaded.Len())
+	}
+	for _, want := range a.puzzles {
+		got, err := loaded.Get(want.ID)
+		if err != nil {
+			t.Fatalf("puzzle %s missing after load", want.ID)
+		}
+		if !got.Game.Equals(want.Game) || got.MoveCount() != want.MoveCount() || got.NeedsHelpers != want.NeedsHelpers {
+			t.Errorf("puzzle %s changed after load", want.ID)
+		}
+		// Regenerated games can continue into new games, which needs the board's targets
+		if len(got.Game.Board.PossibleTargets()) == 0 {
+			t.Errorf("puzzle %s lost its board's possible targets", want.ID)
+		}
+	}
+}
+
+func TestLoad_MissingFileIsEmpty(t *testing.T) {
+	a, err := Load(filepath.Join(t.TempDir(), "archive.json"))
+	if err != nil || a.Len() != 0 {
+		t.Errorf("expected an empty archive, got %d puzzles, %v", a.Len(), err)
+	}
+}
+
+func TestLoad_RefusesGameNotMatchingSeed(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "archive.json")
+	p := generate(t, 1)[0]
+	p.Seed++
+	if err := New([]*Puzzle{p}).Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(filename); err == nil || !strings.Contains(err.Error(), "does not match its seed") {
+		t.Errorf("expected a mismatched seed to be refused, got %v", err)
+	}
+}
+
+func TestLoad_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "archive.json")
+	if err := os.WriteFile(filename, []byte(`{"puzzles":[],"version":99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
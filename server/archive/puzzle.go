@@ -0,0 +1,165 @@
+// Package archive is a catalogue of pre-generated puzzles, each with an optimal
+// solution found by the solver, that can be browsed, played solo or started in
+// a room.
+package archive
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
+// Difficulty and solution tags.
+const (
+	TagEasy       = "easy"        // 1-4 moves
+	TagMedium     = "medium"      // 5-7 moves
+	TagHard       = "hard"        // 8-10 moves
+	TagExpert     = "expert"      // 11 or more moves
+	TagHelpers    = "helpers"     // Other bots must move for an optimal solution
+	TagTargetOnly = "target-only" // The target bot alone solves it optimally
+)
+
+// Puzzle is an archived game with an optimal solution.
+type Puzzle struct {
+	ID           string
+	Seed         int64 // model.NewRandomGameFromSeed(Seed) generates Game
+	Game         *model.Game
+	Solution     []model.BotPosition // An optimal solution, moving only the target bot if that's optimal
+	NeedsHelpers bool                // No optimal solution moves only the target bot
+	Tags         []string
+}
+
+// NewPuzzle generates the game for a seed and solves it. It returns false if
+// the solver finds no solution within maxMoves and maxStates, or if the game
+// starts solved.
+func NewPuzzle(seed int64, maxMoves, maxStates int) (*Puzzle, bool) {
+	game := model.NewRandomGameFromSeed(seed)
+	solution, ok := game.Solve(maxMoves, maxStates)
+	if !ok || len(solution) == 0 {
+		return nil, false
+	}
+
+	p := &Puzzle{ID: strconv.FormatInt(seed, 10), Seed: seed, Game: game, Solution: solution}
+	if direct, ok := targetOnlySolution(game, len(solution)); ok {
+		p.Solution = direct
+	} else {
+		p.NeedsHelpers = true
+	}
+	p.Tags = tags(p)
+	return p, true
+}
+
+// MoveCount returns the number of moves in the optimal solution.
+func (p *Puzzle) MoveCount() int {
+	return len(p.Solution)
+}
+
+// RequiredBots returns the bots the optimal solution moves, in ID order.
+func (p *Puzzle) RequiredBots() []model.BotId {
+	var bots []model.BotId
+	for _, m := range p.Solution {
+		if !slices.Contains(bots, m.Id) {
+			bots = append(bots, m.Id)
+		}
+	}
+	slices.Sort(bots)
+	return bots
+}
+
+// HasTag reports whether the puzzle has a tag.
+func (p *Puzzle) HasTag(tag string) bool {
+	return slices.Contains(p.Tags, tag)
+}
+
+// ToProto converts a Puzzle to its protobuf representation. The solution is
+// only included if withSolution is set, so browsing doesn't give it away.
+func (p *Puzzle) ToProto(withSolution bool) *pb.ArchivePuzzle {
+	out := &pb.ArchivePuzzle{
+		Id:           p.ID,
+		Seed:         p.Seed,
+		Game:         p.Game.ToProto(),
+		OptimalMoves: int32(p.MoveCount()),
+		NeedsHelpers: p.NeedsHelpers,
+		Tags:         p.Tags,
+	}
+	for _, id := range p.RequiredBots() {
+		out.RequiredBots = append(out.RequiredBots, int32(id))
+	}
+	if withSolution {
+		for _, m := range p.Solution {
+			out.Solution = append(out.Solution, m.ToProto())
+		}
+	}
+	return out
+}
+
+// tags returns the difficulty and solution tags of a puzzle.
+func tags(p *Puzzle) []string {
+	var out []string
+	switch n := p.MoveCount(); {
+	case n <= 4:
+		out = append(out, TagEasy)
+	case n <= 7:
+		out = append(out, TagMedium)
+	case n <= 10:
+		out = append(out, TagHard)
+	default:
+		out = append(out, TagExpert)
+	}
+	if p.NeedsHelpers {
+		out = append(out, TagHelpers)
+	} else {
+		out = append(out, TagTargetOnly)
+	}
+	return out
+}
+
+// targetOnlySolution finds a shortest solution of at most maxMoves moves that
+// moves only the target bot, with a breadth-first search over its positions.
+func targetOnlySolution(g *model.Game, maxMoves int) ([]model.BotPosition, bool) {
+	id := g.Target.Id
+	start := g.Bots[id]
+	type visit struct {
+		prev  model.Position
+		depth int
+	}
+	visited := map[model.Position]visit{start: {}}
+	queue := []model.Position{start}
+
+	bots := make(map[model.BotId]model.Position, len(g.Bots))
+	for bid, pos := range g.Bots {
+		bots[bid] = pos
+	}
+	moved := &model.Game{Board: g.Board, Bots: bots, Target: g.Target}
+
+	for len(queue) > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+		if pos == g.Target.Pos {
+			var moves []model.BotPosition
+			for p := pos; p != start; p = visited[p].prev {
+				moves = append(moves, model.BotPosition{Id: id, Pos: p})
+			}
+			slices.Reverse(moves)
+			return moves, true
+		}
+		if visited[pos].depth == maxMoves {
+			continue
+		}
+		bots[id] = pos
+		for _, dir := range []model.Direction{model.Up, model.Down, model.Left, model.Right} {
+			next, err := moved.ComputeDestination(id, dir)
+			if err != nil {
+				continue
+			}
+			if _, ok := visited[next]; ok {
+				continue
+			}
+			visited[next] = visit{prev: pos, depth: visited[pos].depth + 1}
+			queue = append(queue, next)
+		}
+	}
+	return nil, false
+}
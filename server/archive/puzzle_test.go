@@ -0,0 +1,97 @@
+package archive
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// generate returns the first n puzzles from seed 1, failing the test if the
+// solver can't keep up.
+func generate(t *testing.T, n int) []*Puzzle {
+	t.Helper()
+	var puzzles []*Puzzle
+	for seed := int64(1); len(puzzles) < n; seed++ {
+		if seed > int64(10*n) {
+			t.Fatalf("only %d of %d puzzles generated", len(puzzles), n)
+		}
+		if p, ok := NewPuzzle(seed, 10, 200_000); ok {
+			puzzles = append(puzzles, p)
+		}
+	}
+	return puzzles
+}
+
+func TestNewPuzzle_SolutionIsOptimal(t *testing.T) {
+	for _, p := range generate(t, 10) {
+		if ok, _ := p.Game.CheckSolution(p.Solution); !ok {
+			t.Errorf("puzzle %s: solution doesn't solve the game", p.ID)
+		}
+		if shorter, ok := p.Game.Solve(p.MoveCount()-1, 200_000); ok {
+			t.Errorf("puzzle %s: found a %d move solution, shorter than %d", p.ID, len(shorter), p.MoveCount())
+		}
+		if !p.Game.Equals(model.NewRandomGameFromSeed(p.Seed)) {
+			t.Errorf("puzzle %s: game doesn't match seed %d", p.ID, p.Seed)
+		}
+	}
+}
+
+func TestNewPuzzle_Helpers(t *testing.T) {
+	for _, p := range generate(t, 20) {
+		bots := p.RequiredBots()
+		onlyTarget := len(bots) == 1 && bots[0] == p.Game.Target.Id
+		if p.NeedsHelpers == onlyTarget {
+			t.Errorf("puzzle %s: NeedsHelpers is %v but the solution moves bots %v", p.ID, p.NeedsHelpers, bots)
+		}
+		want := TagTargetOnly
+		if p.NeedsHelpers {
+			want = TagHelpers
+		}
+		if !p.HasTag(want) {
+			t.Errorf("puzzle %s: expected tag %q, got %v", p.ID, want, p.Tags)
+		}
+	}
+}
+
+func TestTags_Difficulty(t *testing.T) {
+	tests := []struct {
+		moves int
+		want  string
+	}{
+		{1, TagEasy},
+		{4, TagEasy},
+		{5, TagMedium},
+		{8, TagHard},
+		{11, TagExpert},
+	}
+	for _, tt := range tests {
+		p := &Puzzle{Solution: make([]model.BotPosition, tt.moves)}
+		if got := tags(p); !slices.Contains(got, tt.want) {
+			t.Errorf("%d moves: expected tag %q, got %v", tt.moves, tt.want, got)
+		}
+	}
+}
+
+func TestTargetOnlySolution(t *testing.T) {
+	// Bot 0 reaches the target by moving right then down
+	board := model.NewBoard(16, nil, nil)
+	game, err := model.NewGame(board, map[model.BotId]model.Position{
+		0: {X: 0, Y: 0},
+		1: {X: 15, Y: 15},
+	}, model.NewBotPosition(0, 15, 14))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moves, ok := targetOnlySolution(game, 5)
+	if !ok || len(moves) != 2 {
+		t.Fatalf("expected a 2 move solution, got %v", moves)
+	}
+	if ok, _ := game.CheckSolution(moves); !ok {
+		t.Errorf("expected %v to solve the game", moves)
+	}
+	if _, ok := targetOnlySolution(game, 1); ok {
+		t.Error("expected no solution within 1 move")
+	}
+}
@@ -2,28 +2,81 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/srsalisbury/bouncebot/model"
 	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/account"
+	"github.com/srsalisbury/bouncebot/server/archive"
+	"github.com/srsalisbury/bouncebot/server/config"
+	"github.com/srsalisbury/bouncebot/server/daily"
+	"github.com/srsalisbury/bouncebot/server/rating"
 	"github.com/srsalisbury/bouncebot/server/room"
+	"github.com/srsalisbury/bouncebot/server/stats"
+	"github.com/srsalisbury/bouncebot/server/tournament"
+	"github.com/srsalisbury/bouncebot/server/watch"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type bounceBotServer struct {
-	rooms *room.RoomService
+	cfg         *config.Config
+	rooms       *room.RoomService
+	watcher     *watch.Broadcaster
+	accounts    *account.Store
+	ratings     *rating.Ladder
+	stats       *stats.Store
+	daily       *daily.Store
+	archive     *archive.Archive
+	tournaments *tournament.Manager
 }
 
-func NewBounceBotServer(rooms *room.RoomService) *bounceBotServer {
-	return &bounceBotServer{rooms: rooms}
+func NewBounceBotServer(cfg *config.Config, rooms *room.RoomService, watcher *watch.Broadcaster, accounts *account.Store, ratings *rating.Ladder, stats *stats.Store, daily *daily.Store, archive *archive.Archive, tournaments *tournament.Manager) *bounceBotServer {
+	return &bounceBotServer{cfg: cfg, rooms: rooms, watcher: watcher, accounts: accounts, ratings: ratings, stats: stats, daily: daily, archive: archive, tournaments: tournaments}
+}
+
+// requireAdmin refuses admin RPCs without the configured admin token.
+func (s *bounceBotServer) requireAdmin(token string) error {
+	if !s.cfg.IsAdmin(token) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("admin token required"))
+	}
+	return nil
+}
+
+// signIn resolves an optional account token to the account's ID and the player's
+// name, which defaults to the account name. Guests have no token and no account ID.
+func (s *bounceBotServer) signIn(playerName, token string) (string, string, error) {
+	if token == "" {
+		return playerName, "", nil
+	}
+	acct, err := s.accounts.Claim(token)
+	if err != nil {
+		return "", "", connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if playerName == "" {
+		playerName = acct.Name
+	}
+	return playerName, acct.ID, nil
 }
 
 func (s *bounceBotServer) CreateRoom(_ context.Context, req *connect.Request[pb.CreateRoomRequest]) (*connect.Response[pb.Room], error) {
-	r := s.rooms.Create(req.Msg.PlayerName)
+	name, accountID, err := s.signIn(req.Msg.PlayerName, req.Msg.AccountToken)
+	if err != nil {
+		return nil, err
+	}
+	r := s.rooms.CreateWithAccount(name, accountID)
 	return connect.NewResponse(r.ToProto()), nil
 }
 
 func (s *bounceBotServer) JoinRoom(_ context.Context, req *connect.Request[pb.JoinRoomRequest]) (*connect.Response[pb.Room], error) {
-	r, err := s.rooms.Join(req.Msg.RoomId, req.Msg.PlayerName)
+	name, accountID, err := s.signIn(req.Msg.PlayerName, req.Msg.AccountToken)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.rooms.JoinWithAccount(req.Msg.RoomId, name, accountID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
@@ -39,7 +92,16 @@ func (s *bounceBotServer) GetRoom(_ context.Context, req *connect.Request[pb.Get
 }
 
 func (s *bounceBotServer) StartGame(_ context.Context, req *connect.Request[pb.StartGameRequest]) (*connect.Response[pb.Room], error) {
-	r, err := s.rooms.StartGame(req.Msg.RoomId)
+	var game *model.Game
+	var seed int64
+	if req.Msg.ArchivePuzzleId != "" {
+		p, err := s.archive.Get(req.Msg.ArchivePuzzleId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		game, seed = p.Game, p.Seed
+	}
+	r, err := s.rooms.StartGameWith(req.Msg.RoomId, game, seed)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
@@ -96,3 +158,545 @@ func (s *bounceBotServer) MarkReadyForNext(_ context.Context, req *connect.Reque
 		Success: true,
 	}), nil
 }
+
+func (s *bounceBotServer) SpectateRoom(_ context.Context, req *connect.Request[pb.SpectateRoomRequest]) (*connect.Response[pb.SpectateRoomResponse], error) {
+	r, spectator, err := s.rooms.Spectate(req.Msg.RoomId, req.Msg.SpectatorName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&pb.SpectateRoomResponse{
+		Room:        r.ToProto(),
+		SpectatorId: spectator.ID,
+	}), nil
+}
+
+func (s *bounceBotServer) GetRoomHistory(_ context.Context, req *connect.Request[pb.GetRoomHistoryRequest]) (*connect.Response[pb.GetRoomHistoryResponse], error) {
+	games, err := s.rooms.History(req.Msg.RoomId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&pb.GetRoomHistoryResponse{Games: games}), nil
+}
+
+func (s *bounceBotServer) ExportReplay(_ context.Context, req *connect.Request[pb.ExportReplayRequest]) (*connect.Response[pb.Replay], error) {
+	replay, err := s.rooms.ExportReplay(req.Msg.RoomId, int(req.Msg.GameIndex))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(replay), nil
+}
+
+func (s *bounceBotServer) AddBot(_ context.Context, req *connect.Request[pb.AddBotRequest]) (*connect.Response[pb.AddBotResponse], error) {
+	level := room.BotLevel(req.Msg.Level)
+	if level == "" {
+		level = room.BotMedium
+	}
+	if !room.ValidBotLevel(level) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown bot level: %s", level))
+	}
+	r, bot, err := s.rooms.AddBot(req.Msg.RoomId, level)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&pb.AddBotResponse{
+		Room:     r.ToProto(),
+		PlayerId: bot.ID,
+	}), nil
+}
+
+func (s *bounceBotServer) RemoveBot(_ context.Context, req *connect.Request[pb.RemoveBotRequest]) (*connect.Response[pb.Room], error) {
+	r, err := s.rooms.RemoveBot(req.Msg.RoomId, req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+func (s *bounceBotServer) RequestHint(_ context.Context, req *connect.Request[pb.RequestHintRequest]) (*connect.Response[pb.Hint], error) {
+	hint, err := s.rooms.RequestHint(req.Msg.RoomId, req.Msg.PlayerId)
+	if errors.Is(err, room.ErrNoHint) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if errors.Is(err, room.ErrHintGameChanged) {
+		return nil, connect.NewError(connect.CodeAborted, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(hint.ToProto()), nil
+}
+
+func (s *bounceBotServer) SetTeam(_ context.Context, req *connect.Request[pb.SetTeamRequest]) (*connect.Response[pb.Room], error) {
+	r, err := s.rooms.SetTeam(req.Msg.RoomId, req.Msg.PlayerId, req.Msg.Team)
+	if err != nil {
+		return nil, teamError(err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+func (s *bounceBotServer) AssignTeams(_ context.Context, req *connect.Request[pb.AssignTeamsRequest]) (*connect.Response[pb.Room], error) {
+	teams := req.Msg.Teams
+	if len(teams) == 0 {
+		teams = room.DefaultTeams
+	}
+	if slices.Contains(teams, "") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("team name must not be empty"))
+	}
+	r, err := s.rooms.AssignTeams(req.Msg.RoomId, teams)
+	if err != nil {
+		return nil, teamError(err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+func (s *bounceBotServer) SetTeamQuorum(_ context.Context, req *connect.Request[pb.SetTeamQuorumRequest]) (*connect.Response[pb.Room], error) {
+	r, err := s.rooms.SetTeamQuorum(req.Msg.RoomId, req.Msg.Enabled)
+	if err != nil {
+		return nil, teamError(err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+func (s *bounceBotServer) GetTeams(_ context.Context, req *connect.Request[pb.GetTeamsRequest]) (*connect.Response[pb.GetTeamsResponse], error) {
+	teams, err := s.rooms.Teams(req.Msg.RoomId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&pb.GetTeamsResponse{Teams: teams}), nil
+}
+
+// teamError maps a team change error to its Connect code.
+func teamError(err error) error {
+	if errors.Is(err, room.ErrTeamsLocked) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeNotFound, err)
+}
+
+func (s *bounceBotServer) StartMatch(_ context.Context, req *connect.Request[pb.StartMatchRequest]) (*connect.Response[pb.Room], error) {
+	rounds, firstTo := int(req.Msg.Rounds), int(req.Msg.FirstTo)
+	if rounds < 0 || firstTo < 0 || rounds == 0 && firstTo == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a match needs a positive number of rounds or wins"))
+	}
+	r, err := s.rooms.StartMatch(req.Msg.RoomId, rounds, firstTo, req.Msg.Seed)
+	if err != nil {
+		return nil, matchError(err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+func (s *bounceBotServer) StopMatch(_ context.Context, req *connect.Request[pb.StopMatchRequest]) (*connect.Response[pb.Room], error) {
+	r, err := s.rooms.StopMatch(req.Msg.RoomId)
+	if err != nil {
+		return nil, matchError(err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+func (s *bounceBotServer) SetHandicap(_ context.Context, req *connect.Request[pb.SetHandicapRequest]) (*connect.Response[pb.Room], error) {
+	handicap := room.HandicapFromProto(req.Msg.Handicap)
+	if handicap.ExtraMoves < 0 || handicap.Delay < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("handicap must not be negative"))
+	}
+	r, err := s.rooms.SetHandicap(req.Msg.RoomId, req.Msg.PlayerId, handicap)
+	if err != nil {
+		if errors.Is(err, room.ErrHandicapsLocked) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(r.ToProto()), nil
+}
+
+// SuggestHandicaps suggests handicaps for a room's people from their ratings.
+// Guests are rated as new accounts.
+func (s *bounceBotServer) SuggestHandicaps(_ context.Context, req *connect.Request[pb.SuggestHandicapsRequest]) (*connect.Response[pb.SuggestHandicapsResponse], error) {
+	snapshot, err := s.rooms.Snapshot(req.Msg.RoomId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	ratings := make(map[string]float64)
+	for _, p := range snapshot.Players {
+		if p.Bot != "" {
+			continue
+		}
+		ratings[p.Id] = rating.InitialRating
+		if p.AccountId != "" {
+			ratings[p.Id] = s.ratings.Get(p.AccountId).Value
+		}
+	}
+	suggestions := rating.SuggestHandicaps(ratings)
+
+	out := &pb.SuggestHandicapsResponse{}
+	for _, p := range snapshot.Players {
+		if h, ok := suggestions[p.Id]; ok {
+			out.Handicaps = append(out.Handicaps, &pb.SuggestedHandicap{PlayerId: p.Id, Rating: ratings[p.Id], Handicap: h.ToProto()})
+		}
+	}
+	return connect.NewResponse(out), nil
+}
+
+// matchError maps a match error to its Connect code.
+func matchError(err error) error {
+	if errors.Is(err, room.ErrMatchLocked) || errors.Is(err, room.ErrMatchInProgress) || errors.Is(err, room.ErrNoMatch) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeNotFound, err)
+}
+
+func (s *bounceBotServer) CreateAccount(_ context.Context, req *connect.Request[pb.CreateAccountRequest]) (*connect.Response[pb.CreateAccountResponse], error) {
+	acct, token, err := s.accounts.Create(req.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(&pb.CreateAccountResponse{Account: acct.ToProto(), Token: token}), nil
+}
+
+func (s *bounceBotServer) ClaimAccount(_ context.Context, req *connect.Request[pb.ClaimAccountRequest]) (*connect.Response[pb.Account], error) {
+	acct, err := s.accounts.Claim(req.Msg.Token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return connect.NewResponse(acct.ToProto()), nil
+}
+
+// defaultLadderLength is how many entries GetRatings, GetLeaderboard,
+// GetDailyLeaderboard and SearchArchive return when no limit is given.
+const defaultLadderLength = 50
+
+func (s *bounceBotServer) GetRatings(_ context.Context, req *connect.Request[pb.GetRatingsRequest]) (*connect.Response[pb.GetRatingsResponse], error) {
+	var ratings []rating.Rating
+	if len(req.Msg.AccountIds) > 0 {
+		for _, id := range req.Msg.AccountIds {
+			if _, err := s.accounts.Get(id); err != nil {
+				return nil, connect.NewError(connect.CodeNotFound, err)
+			}
+			ratings = append(ratings, s.ratings.Get(id))
+		}
+	} else {
+		limit := int(req.Msg.Limit)
+		if limit <= 0 {
+			limit = defaultLadderLength
+		}
+		ratings = s.ratings.Top(limit)
+	}
+
+	out := make([]*pb.Rating, len(ratings))
+	for i, r := range ratings {
+		out[i] = r.ToProto()
+		if acct, err := s.accounts.Get(r.AccountID); err == nil {
+			out[i].Name = acct.Name
+		}
+	}
+	return connect.NewResponse(&pb.GetRatingsResponse{Ratings: out}), nil
+}
+
+// statsWindows maps StatsWindow values to the stats package's windows.
+var statsWindows = map[pb.StatsWindow]time.Duration{
+	pb.StatsWindow_STATS_WINDOW_ALL_TIME: stats.AllTime,
+	pb.StatsWindow_STATS_WINDOW_DAILY:    stats.Day,
+	pb.StatsWindow_STATS_WINDOW_WEEKLY:   stats.Week,
+}
+
+// leaderboardOrders maps LeaderboardOrder values to the stats package's orders.
+var leaderboardOrders = map[pb.LeaderboardOrder]stats.Order{
+	pb.LeaderboardOrder_LEADERBOARD_ORDER_WINS:            stats.ByWins,
+	pb.LeaderboardOrder_LEADERBOARD_ORDER_SOLVE_RATE:      stats.BySolveRate,
+	pb.LeaderboardOrder_LEADERBOARD_ORDER_FASTEST_SOLVE:   stats.ByFastestSolve,
+	pb.LeaderboardOrder_LEADERBOARD_ORDER_BEST_WIN_STREAK: stats.ByBestWinStreak,
+}
+
+// statsWindow returns the window for a StatsWindow, or an InvalidArgument error.
+func statsWindow(w pb.StatsWindow) (time.Duration, error) {
+	window, ok := statsWindows[w]
+	if !ok {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown stats window: %d", w))
+	}
+	return window, nil
+}
+
+// playerStatsProto converts stats to their protobuf representation with the account name.
+func (s *bounceBotServer) playerStatsProto(st stats.Stats) *pb.PlayerStats {
+	out := st.ToProto()
+	if acct, err := s.accounts.Get(st.AccountID); err == nil {
+		out.Name = acct.Name
+	}
+	return out
+}
+
+func (s *bounceBotServer) GetLeaderboard(_ context.Context, req *connect.Request[pb.GetLeaderboardRequest]) (*connect.Response[pb.GetLeaderboardResponse], error) {
+	window, err := statsWindow(req.Msg.Window)
+	if err != nil {
+		return nil, err
+	}
+	order, ok := leaderboardOrders[req.Msg.Order]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown leaderboard order: %d", req.Msg.Order))
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultLadderLength
+	}
+
+	board := s.stats.Leaderboard(window, order, limit)
+	out := make([]*pb.PlayerStats, len(board))
+	for i, st := range board {
+		out[i] = s.playerStatsProto(st)
+	}
+	return connect.NewResponse(&pb.GetLeaderboardResponse{Players: out}), nil
+}
+
+func (s *bounceBotServer) GetPlayerStats(_ context.Context, req *connect.Request[pb.GetPlayerStatsRequest]) (*connect.Response[pb.PlayerStats], error) {
+	window, err := statsWindow(req.Msg.Window)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.accounts.Get(req.Msg.AccountId); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(s.playerStatsProto(s.stats.Player(req.Msg.AccountId, window))), nil
+}
+
+func (s *bounceBotServer) GetDailyPuzzle(_ context.Context, req *connect.Request[pb.GetDailyPuzzleRequest]) (*connect.Response[pb.DailyPuzzle], error) {
+	_, accountID, err := s.signIn("", req.Msg.AccountToken)
+	if err != nil {
+		return nil, err
+	}
+	if accountID == "" {
+		p, err := s.daily.Today()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(p.ToProto()), nil
+	}
+
+	p, started, err := s.daily.Start(accountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	out := p.ToProto()
+	out.StartedAt = timestamppb.New(started)
+	return connect.NewResponse(out), nil
+}
+
+func (s *bounceBotServer) SubmitDailySolution(_ context.Context, req *connect.Request[pb.SubmitDailySolutionRequest]) (*connect.Response[pb.DailyEntry], error) {
+	if req.Msg.AccountToken == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("daily puzzle solutions need an account"))
+	}
+	name, accountID, err := s.signIn("", req.Msg.AccountToken)
+	if err != nil {
+		return nil, err
+	}
+	entry, rank, err := s.daily.Submit(accountID, req.Msg.Date, model.NewBotPositionsFromProto(req.Msg.Moves))
+	if errors.Is(err, daily.ErrNotStarted) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	out := entry.ToProto(rank)
+	out.Name = name
+	return connect.NewResponse(out), nil
+}
+
+func (s *bounceBotServer) GetDailyLeaderboard(_ context.Context, req *connect.Request[pb.GetDailyLeaderboardRequest]) (*connect.Response[pb.GetDailyLeaderboardResponse], error) {
+	date := req.Msg.Date
+	if date == "" {
+		date = daily.Date(time.Now())
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultLadderLength
+	}
+
+	entries := s.daily.Leaderboard(date, limit)
+	out := make([]*pb.DailyEntry, len(entries))
+	for i, e := range entries {
+		out[i] = e.ToProto(i + 1)
+		if acct, err := s.accounts.Get(e.AccountID); err == nil {
+			out[i].Name = acct.Name
+		}
+	}
+	return connect.NewResponse(&pb.GetDailyLeaderboardResponse{Date: date, Entries: out}), nil
+}
+
+// helperFilters maps HelperFilter values to the archive package's filters.
+var helperFilters = map[pb.HelperFilter]archive.HelperFilter{
+	pb.HelperFilter_HELPER_FILTER_ANY:          archive.AnyHelpers,
+	pb.HelperFilter_HELPER_FILTER_REQUIRED:     archive.HelpersRequired,
+	pb.HelperFilter_HELPER_FILTER_NOT_REQUIRED: archive.HelpersNotRequired,
+}
+
+func (s *bounceBotServer) SearchArchive(_ context.Context, req *connect.Request[pb.SearchArchiveRequest]) (*connect.Response[pb.SearchArchiveResponse], error) {
+	helpers, ok := helperFilters[req.Msg.Helpers]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown helper filter: %d", req.Msg.Helpers))
+	}
+	q := archive.Query{
+		MinMoves: int(req.Msg.MinMoves),
+		MaxMoves: int(req.Msg.MaxMoves),
+		Helpers:  helpers,
+		Tags:     req.Msg.Tags,
+	}
+	for _, id := range req.Msg.Bots {
+		q.Bots = append(q.Bots, model.BotId(id))
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultLadderLength
+	}
+
+	puzzles, total := s.archive.Search(q, max(int(req.Msg.Offset), 0), limit)
+	out := make([]*pb.ArchivePuzzle, len(puzzles))
+	for i, p := range puzzles {
+		out[i] = p.ToProto(false)
+	}
+	return connect.NewResponse(&pb.SearchArchiveResponse{Puzzles: out, Total: int32(total)}), nil
+}
+
+func (s *bounceBotServer) GetArchivePuzzle(_ context.Context, req *connect.Request[pb.GetArchivePuzzleRequest]) (*connect.Response[pb.ArchivePuzzle], error) {
+	p, err := s.archive.Get(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(p.ToProto(req.Msg.IncludeSolution)), nil
+}
+
+func (s *bounceBotServer) CheckArchiveSolution(_ context.Context, req *connect.Request[pb.CheckArchiveSolutionRequest]) (*connect.Response[pb.CheckArchiveSolutionResponse], error) {
+	p, err := s.archive.Get(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	solved, _ := p.Game.CheckSolution(model.NewBotPositionsFromProto(req.Msg.Moves))
+	return connect.NewResponse(&pb.CheckArchiveSolutionResponse{
+		Solved:       solved,
+		MoveCount:    int32(len(req.Msg.Moves)),
+		OptimalMoves: int32(p.MoveCount()),
+	}), nil
+}
+
+func (s *bounceBotServer) WatchRoom(ctx context.Context, req *connect.Request[pb.WatchRoomRequest], stream *connect.ServerStream[pb.RoomEvent]) error {
+	r, err := s.rooms.Get(req.Msg.RoomId)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	sub := s.watcher.Subscribe(r.ID)
+	defer s.watcher.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return connect.NewError(connect.CodeResourceExhausted, errors.New("stream fell behind room events"))
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			if event.GetRoomClosed() != nil {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *bounceBotServer) CreateTournament(_ context.Context, req *connect.Request[pb.CreateTournamentRequest]) (*connect.Response[pb.Tournament], error) {
+	if err := s.requireAdmin(req.Msg.AdminToken); err != nil {
+		return nil, err
+	}
+	entrants := make([]tournament.Entrant, len(req.Msg.AccountIds))
+	for i, id := range req.Msg.AccountIds {
+		acct, err := s.accounts.Get(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		entrants[i] = tournament.Entrant{AccountID: acct.ID, Name: acct.Name}
+	}
+	opts := tournament.Options{
+		Name:    req.Msg.Name,
+		Format:  tournament.Format(req.Msg.Format),
+		FirstTo: int(req.Msg.FirstTo),
+		Rounds:  int(req.Msg.Rounds),
+	}
+	if opts.Format == "" {
+		opts.Format = tournament.FormatSingleElimination
+	}
+	if opts.FirstTo == 0 {
+		opts.FirstTo = 1
+	}
+	t, err := s.tournaments.Create(opts, entrants)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(t), nil
+}
+
+func (s *bounceBotServer) StartTournament(_ context.Context, req *connect.Request[pb.StartTournamentRequest]) (*connect.Response[pb.Tournament], error) {
+	if err := s.requireAdmin(req.Msg.AdminToken); err != nil {
+		return nil, err
+	}
+	t, err := s.tournaments.Start(req.Msg.TournamentId)
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+	return connect.NewResponse(t), nil
+}
+
+func (s *bounceBotServer) ReportTableResult(_ context.Context, req *connect.Request[pb.ReportTableResultRequest]) (*connect.Response[pb.Tournament], error) {
+	if err := s.requireAdmin(req.Msg.AdminToken); err != nil {
+		return nil, err
+	}
+	t, err := s.tournaments.ReportResult(req.Msg.TournamentId, req.Msg.RoomId, req.Msg.WinnerId)
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+	return connect.NewResponse(t), nil
+}
+
+func (s *bounceBotServer) GetTournament(_ context.Context, req *connect.Request[pb.GetTournamentRequest]) (*connect.Response[pb.Tournament], error) {
+	t, err := s.tournaments.Get(req.Msg.TournamentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(t), nil
+}
+
+// tournamentError maps tournament errors to Connect codes: unknown tournaments
+// are NotFound, starting twice is FailedPrecondition, anything else is a bad argument.
+func tournamentError(err error) error {
+	switch {
+	case errors.Is(err, tournament.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, tournament.ErrStarted):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInvalidArgument, err)
+}
+
+func (s *bounceBotServer) WatchTournament(ctx context.Context, req *connect.Request[pb.WatchTournamentRequest], stream *connect.ServerStream[pb.TournamentEvent]) error {
+	sub, err := s.tournaments.Subscribe(req.Msg.TournamentId)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	defer s.tournaments.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return connect.NewError(connect.CodeResourceExhausted, errors.New("stream fell behind tournament events"))
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			if event.GetFinished() != nil {
+				return nil
+			}
+		}
+	}
+}
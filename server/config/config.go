@@ -2,8 +2,11 @@
 package config
 
 import (
+	"crypto/subtle"
+	"log/slog"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +18,32 @@ type Config struct {
 	Port     int
 	DataFile string
 
+	// AccountsFile is where player accounts are stored, shared by every room.
+	AccountsFile string
+
+	// RatingsFile is where account ratings are stored.
+	RatingsFile string
+
+	// StatsFile is where player statistics for leaderboards are stored.
+	StatsFile string
+
+	// DailyFile is where daily puzzle results are stored.
+	DailyFile string
+
+	// ArchiveFile is the puzzle archive written by cmd/genarchive. It is only read.
+	ArchiveFile string
+
+	// TournamentsFile is where tournaments and their brackets are stored.
+	TournamentsFile string
+
+	// AdminToken authorizes admin RPCs such as creating tournaments.
+	// If empty, admin RPCs are disabled.
+	AdminToken string
+
+	// Storage is the persistence backend, StorageJSON or StorageSQLite.
+	// If empty, it is chosen from the DataFile extension; see StorageBackend.
+	Storage string
+
 	// CORS/WebSocket allowed origins (comma-separated hostnames)
 	// e.g., "localhost,myserver.com"
 	// Each hostname allows both http://hostname and http://hostname:port
@@ -30,19 +59,62 @@ type Config struct {
 	CleanupInterval       time.Duration
 	RoomMaxAge            time.Duration
 	DisconnectGracePeriod time.Duration
+
+	// HintPenalty is how many moves are added to a solution for each hint tier
+	// its player took when choosing a game's winner. Zero ignores hints.
+	HintPenalty int
+
+	// WebSocket keepalive: the server pings every WebSocketPingInterval and closes
+	// connections that haven't answered within two intervals. Writes that take
+	// longer than WebSocketWriteTimeout close the connection.
+	WebSocketPingInterval time.Duration
+	WebSocketWriteTimeout time.Duration
+
+	// JournalSyncInterval is how often the JSON backend's operation journal
+	// is fsynced. Appends within one interval share a single fsync.
+	JournalSyncInterval time.Duration
+
+	// LogLevel is the lowest level of log entries written.
+	LogLevel slog.Level
+
+	// LogFormat is how log entries are written, LogFormatText or LogFormatJSON.
+	LogFormat string
 }
 
+// Persistence backends.
+const (
+	StorageJSON   = "json"
+	StorageSQLite = "sqlite"
+)
+
+// Log formats.
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
 // DefaultConfig returns configuration with sensible defaults.
 func DefaultConfig() *Config {
 	return &Config{
 		Port:                  8080,
 		DataFile:              "rooms.json",
+		AccountsFile:          "accounts.json",
+		RatingsFile:           "ratings.json",
+		StatsFile:             "stats.json",
+		DailyFile:             "daily.json",
+		ArchiveFile:           "archive.json",
+		TournamentsFile:       "tournaments.json",
 		AllowedOrigins:        []string{"localhost"},
 		AllowSameHost:         true,
 		AutoSaveInterval:      30 * time.Second,
 		CleanupInterval:       1 * time.Hour,
 		RoomMaxAge:            24 * time.Hour,
 		DisconnectGracePeriod: 30 * time.Second,
+		WebSocketPingInterval: 30 * time.Second,
+		WebSocketWriteTimeout: 10 * time.Second,
+		JournalSyncInterval:   50 * time.Millisecond,
+		LogLevel:              slog.LevelInfo,
+		LogFormat:             LogFormatText,
 	}
 }
 
@@ -50,12 +122,26 @@ func DefaultConfig() *Config {
 // Environment variables override defaults. Supported variables:
 //   - PORT: Server port (default: 8080)
 //   - DATA_FILE: Path to room data file (default: rooms.json)
+//   - ACCOUNTS_FILE: Path to player accounts file (default: accounts.json)
+//   - RATINGS_FILE: Path to account ratings file (default: ratings.json)
+//   - STATS_FILE: Path to player statistics file (default: stats.json)
+//   - DAILY_FILE: Path to daily puzzle results file (default: daily.json)
+//   - ARCHIVE_FILE: Path to puzzle archive file (default: archive.json)
+//   - TOURNAMENTS_FILE: Path to tournaments file (default: tournaments.json)
+//   - ADMIN_TOKEN: Token authorizing admin RPCs (default: none, admin RPCs disabled)
+//   - STORAGE: Persistence backend, json or sqlite (default: from DATA_FILE extension)
 //   - ALLOWED_ORIGINS: Comma-separated allowed origins (default: localhost)
 //   - ALLOW_SAME_HOST: Allow same-host requests (default: true)
 //   - AUTO_SAVE_INTERVAL: Auto-save interval in seconds (default: 30)
 //   - CLEANUP_INTERVAL: Cleanup interval in seconds (default: 3600)
 //   - ROOM_MAX_AGE: Room max age in seconds (default: 86400)
 //   - DISCONNECT_GRACE_PERIOD: Player disconnect grace period in seconds (default: 30)
+//   - HINT_PENALTY: Moves added to a solution per hint tier taken when choosing the winner (default: 0)
+//   - WS_PING_INTERVAL: WebSocket ping interval in seconds (default: 30)
+//   - WS_WRITE_TIMEOUT: WebSocket write timeout in seconds (default: 10)
+//   - JOURNAL_SYNC_MS: Journal fsync interval in milliseconds (default: 50)
+//   - LOG_LEVEL: Lowest log level written, debug, info, warn or error (default: info)
+//   - LOG_FORMAT: Log format, text or json (default: text)
 func LoadFromEnv() *Config {
 	cfg := DefaultConfig()
 
@@ -69,6 +155,38 @@ func LoadFromEnv() *Config {
 		cfg.DataFile = v
 	}
 
+	if v := os.Getenv("ACCOUNTS_FILE"); v != "" {
+		cfg.AccountsFile = v
+	}
+
+	if v := os.Getenv("RATINGS_FILE"); v != "" {
+		cfg.RatingsFile = v
+	}
+
+	if v := os.Getenv("STATS_FILE"); v != "" {
+		cfg.StatsFile = v
+	}
+
+	if v := os.Getenv("DAILY_FILE"); v != "" {
+		cfg.DailyFile = v
+	}
+
+	if v := os.Getenv("ARCHIVE_FILE"); v != "" {
+		cfg.ArchiveFile = v
+	}
+
+	if v := os.Getenv("TOURNAMENTS_FILE"); v != "" {
+		cfg.TournamentsFile = v
+	}
+
+	if v := os.Getenv("ADMIN_TOKEN"); v != "" {
+		cfg.AdminToken = v
+	}
+
+	if v := os.Getenv("STORAGE"); v != "" {
+		cfg.Storage = strings.ToLower(v)
+	}
+
 	if v := os.Getenv("ALLOWED_ORIGINS"); v != "" {
 		origins := strings.Split(v, ",")
 		cfg.AllowedOrigins = make([]string, 0, len(origins))
@@ -108,9 +226,73 @@ func LoadFromEnv() *Config {
 		}
 	}
 
+	if v := os.Getenv("HINT_PENALTY"); v != "" {
+		if moves, err := strconv.Atoi(v); err == nil && moves >= 0 {
+			cfg.HintPenalty = moves
+		}
+	}
+
+	if v := os.Getenv("WS_PING_INTERVAL"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			cfg.WebSocketPingInterval = time.Duration(secs) * time.Second
+		}
+	}
+
+	if v := os.Getenv("WS_WRITE_TIMEOUT"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			cfg.WebSocketWriteTimeout = time.Duration(secs) * time.Second
+		}
+	}
+
+	if v := os.Getenv("JOURNAL_SYNC_MS"); v != "" {
+		if ms, err := strconv.Atoi(v); err == nil && ms > 0 {
+			cfg.JournalSyncInterval = time.Duration(ms) * time.Millisecond
+		}
+	}
+
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(v)); err == nil {
+			cfg.LogLevel = level
+		}
+	}
+
+	if v := os.Getenv("LOG_FORMAT"); v != "" {
+		cfg.LogFormat = strings.ToLower(v)
+	}
+
 	return cfg
 }
 
+// JournalFile returns the path of the operation journal kept next to the data file.
+func (c *Config) JournalFile() string {
+	return c.DataFile + ".journal"
+}
+
+// StorageBackend returns the persistence backend to use.
+// An explicit Storage setting wins; otherwise data files ending in .db, .sqlite
+// or .sqlite3 use SQLite and everything else uses JSON.
+func (c *Config) StorageBackend() string {
+	if c.Storage != "" {
+		return c.Storage
+	}
+	switch strings.ToLower(filepath.Ext(c.DataFile)) {
+	case ".db", ".sqlite", ".sqlite3":
+		return StorageSQLite
+	default:
+		return StorageJSON
+	}
+}
+
+// IsAdmin reports whether token authorizes admin RPCs. Always false if no
+// admin token is configured.
+func (c *Config) IsAdmin(token string) bool {
+	if c.AdminToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(c.AdminToken)) == 1
+}
+
 // IsOriginAllowed checks if the given origin is allowed based on configured origins only.
 func (c *Config) IsOriginAllowed(origin string) bool {
 	for _, allowed := range c.AllowedOrigins {
@@ -1,6 +1,9 @@
 package config
 
-import "testing"
+import (
+	"log/slog"
+	"testing"
+)
 
 func TestIsOriginAllowed(t *testing.T) {
 	cfg := &Config{
@@ -155,3 +158,62 @@ func TestIsOriginAllowedForRequest_InvalidOrigin(t *testing.T) {
 		t.Errorf("expected invalid origin to be rejected")
 	}
 }
+
+func TestStorageBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		storage  string
+		dataFile string
+		want     string
+	}{
+		{"default json file", "", "rooms.json", StorageJSON},
+		{"db extension", "", "/data/rooms.db", StorageSQLite},
+		{"sqlite extension", "", "rooms.SQLITE", StorageSQLite},
+		{"sqlite3 extension", "", "rooms.sqlite3", StorageSQLite},
+		{"no extension", "", "rooms", StorageJSON},
+		{"explicit overrides extension", StorageJSON, "rooms.db", StorageJSON},
+		{"explicit sqlite", StorageSQLite, "rooms.dat", StorageSQLite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Storage: tt.storage, DataFile: tt.dataFile}
+			if got := cfg.StorageBackend(); got != tt.want {
+				t.Errorf("StorageBackend() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAdmin(t *testing.T) {
+	cfg := &Config{AdminToken: "s3cret"}
+	if !cfg.IsAdmin("s3cret") {
+		t.Error("expected the admin token to be accepted")
+	}
+	if cfg.IsAdmin("s3cre") || cfg.IsAdmin("") {
+		t.Error("expected other tokens to be refused")
+	}
+
+	if (&Config{}).IsAdmin("") {
+		t.Error("expected admin disabled without a token")
+	}
+}
+
+func TestLoadFromEnv_Logging(t *testing.T) {
+	cfg := LoadFromEnv()
+	if cfg.LogLevel != slog.LevelInfo || cfg.LogFormat != LogFormatText {
+		t.Errorf("expected info level text logs by default, got %v %q", cfg.LogLevel, cfg.LogFormat)
+	}
+
+	t.Setenv("LOG_LEVEL", "debug")
+	t.Setenv("LOG_FORMAT", "JSON")
+	cfg = LoadFromEnv()
+	if cfg.LogLevel != slog.LevelDebug || cfg.LogFormat != LogFormatJSON {
+		t.Errorf("expected debug level JSON logs, got %v %q", cfg.LogLevel, cfg.LogFormat)
+	}
+
+	t.Setenv("LOG_LEVEL", "loud")
+	if cfg = LoadFromEnv(); cfg.LogLevel != slog.LevelInfo {
+		t.Errorf("expected an unknown level ignored, got %v", cfg.LogLevel)
+	}
+}
@@ -0,0 +1,83 @@
+// Package daily provides the daily puzzle: one game a day, the same for every
+// player, with a leaderboard of each day's best solutions.
+package daily
+
+import (
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
+const (
+	// dateLayout formats the UTC day a puzzle belongs to.
+	dateLayout = time.DateOnly
+
+	// A daily puzzle's optimal solution takes between minMoves and maxMoves moves,
+	// hard enough to be worth a day but within reach of the solver.
+	minMoves = 6
+	maxMoves = 9
+
+	// maxCandidates is how many seeds are tried for a day before settling for the
+	// hardest one that the solver could solve.
+	maxCandidates = 100
+
+	// solverStateLimit bounds the search for each candidate's optimal move count.
+	solverStateLimit = 200_000
+)
+
+// Puzzle is the game for one day.
+type Puzzle struct {
+	Date         string // UTC day, as YYYY-MM-DD
+	Seed         int64  // Seed the game was generated from
+	Game         *model.Game
+	OptimalMoves int // Fewest moves that solve the game, 0 if unknown
+}
+
+// ToProto converts a Puzzle to its protobuf representation.
+func (p *Puzzle) ToProto() *pb.DailyPuzzle {
+	return &pb.DailyPuzzle{
+		Date:         p.Date,
+		Game:         p.Game.ToProto(),
+		OptimalMoves: int32(p.OptimalMoves),
+	}
+}
+
+// Date returns the UTC day that t falls on, as a puzzle date.
+func Date(t time.Time) string {
+	return t.UTC().Format(dateLayout)
+}
+
+// NewPuzzle generates the puzzle for a date. The same date always gives the same
+// puzzle: candidate seeds are derived from the date and the first game whose
+// optimal solution is between minMoves and maxMoves is chosen.
+func NewPuzzle(date string) (*Puzzle, error) {
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return nil, err
+	}
+	base := int64(day.Year()*10000+int(day.Month())*100+day.Day()) * maxCandidates
+
+	var fallback *Puzzle
+	for i := range int64(maxCandidates) {
+		seed := base + i
+		game := model.NewRandomGameFromSeed(seed)
+		moves, ok := game.Solve(maxMoves, solverStateLimit)
+		if !ok {
+			continue
+		}
+		p := &Puzzle{Date: date, Seed: seed, Game: game, OptimalMoves: len(moves)}
+		if len(moves) >= minMoves {
+			return p, nil
+		}
+		if fallback == nil || p.OptimalMoves > fallback.OptimalMoves {
+			fallback = p
+		}
+	}
+	if fallback == nil {
+		// Unreachable in practice: most random games solve within maxMoves
+		game := model.NewRandomGameFromSeed(base)
+		fallback = &Puzzle{Date: date, Seed: base, Game: game}
+	}
+	return fallback, nil
+}
@@ -0,0 +1,57 @@
+package daily
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewPuzzle_SameForTheSameDate(t *testing.T) {
+	p1, err := NewPuzzle("2025-03-01")
+	if err != nil {
+		t.Fatalf("NewPuzzle failed: %v", err)
+	}
+	p2, err := NewPuzzle("2025-03-01")
+	if err != nil {
+		t.Fatalf("NewPuzzle failed: %v", err)
+	}
+	if p1.Seed != p2.Seed || !p1.Game.Equals(p2.Game) {
+		t.Errorf("expected the same puzzle for the same date, got seeds %d and %d", p1.Seed, p2.Seed)
+	}
+
+	other, err := NewPuzzle("2025-03-02")
+	if err != nil {
+		t.Fatalf("NewPuzzle failed: %v", err)
+	}
+	if other.Game.Equals(p1.Game) {
+		t.Error("expected a different puzzle on a different date")
+	}
+}
+
+func TestNewPuzzle_VerifiedDifficulty(t *testing.T) {
+	for _, date := range []string{"2025-03-01", "2025-12-31", "2026-10-18"} {
+		p, err := NewPuzzle(date)
+		if err != nil {
+			t.Fatalf("NewPuzzle(%s) failed: %v", date, err)
+		}
+		if p.OptimalMoves < minMoves || p.OptimalMoves > maxMoves {
+			t.Errorf("%s: expected an optimal solution of %d to %d moves, got %d", date, minMoves, maxMoves, p.OptimalMoves)
+		}
+		moves, ok := p.Game.Solve(p.OptimalMoves, solverStateLimit)
+		if !ok || len(moves) != p.OptimalMoves {
+			t.Errorf("%s: expected the solver to confirm %d moves", date, p.OptimalMoves)
+		}
+	}
+}
+
+func TestNewPuzzle_InvalidDate(t *testing.T) {
+	if _, err := NewPuzzle("yesterday"); err == nil {
+		t.Error("expected an invalid date to be rejected")
+	}
+}
+
+func TestDate_UsesUTC(t *testing.T) {
+	late := time.Date(2025, 3, 1, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+	if got := Date(late); got != "2025-03-02" {
+		t.Errorf("expected the UTC date 2025-03-02, got %s", got)
+	}
+}
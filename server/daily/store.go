@@ -0,0 +1,298 @@
+package daily
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// keepDays is how many days of results are kept, including today.
+	keepDays = 30
+
+	// currentVersion is the daily file format version written by the Store.
+	currentVersion = 1
+)
+
+// ErrNotStarted is returned when an account submits a solution to a puzzle it
+// never fetched, so its time can't be measured.
+var ErrNotStarted = errors.New("daily puzzle not started: fetch it while signed in first")
+
+// Entry is an account's best solution to a day's puzzle.
+type Entry struct {
+	AccountID   string
+	Moves       []model.BotPosition
+	Time        time.Duration // From first fetching the puzzle to submitting this solution
+	SubmittedAt time.Time
+}
+
+// MoveCount returns the number of moves in the solution.
+func (e *Entry) MoveCount() int {
+	return len(e.Moves)
+}
+
+// better reports whether e ranks above o: fewer moves, then a shorter time.
+func (e *Entry) better(o *Entry) bool {
+	return compareEntries(e, o) < 0
+}
+
+// compareEntries orders entries for the leaderboard.
+func compareEntries(a, b *Entry) int {
+	if c := cmp.Compare(a.MoveCount(), b.MoveCount()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Time, b.Time); c != 0 {
+		return c
+	}
+	if c := a.SubmittedAt.Compare(b.SubmittedAt); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.AccountID, b.AccountID)
+}
+
+// ToProto converts an Entry to its protobuf representation, without the account name.
+func (e *Entry) ToProto(rank int) *pb.DailyEntry {
+	return &pb.DailyEntry{
+		AccountId:   e.AccountID,
+		MoveCount:   int32(e.MoveCount()),
+		Time:        durationpb.New(e.Time),
+		SubmittedAt: timestamppb.New(e.SubmittedAt),
+		Rank:        int32(rank),
+	}
+}
+
+// dayResults holds one day's progress.
+type dayResults struct {
+	Started map[string]time.Time // When each account first fetched the puzzle, by account ID
+	Entries map[string]*Entry    // Best entry by account ID
+}
+
+// Store hands out each day's puzzle and keeps its results. After Load, every
+// change is written to the daily file.
+type Store struct {
+	mu       sync.Mutex
+	filename string                 // Set by Load; empty keeps results in memory only
+	days     map[string]*dayResults // By date
+	now      func() time.Time
+
+	puzzleMu sync.Mutex         // Held while generating, which can take a moment
+	puzzles  map[string]*Puzzle // Generated puzzles by date
+}
+
+// NewStore creates an empty in-memory Store.
+func NewStore() *Store {
+	return &Store{
+		days:    make(map[string]*dayResults),
+		puzzles: make(map[string]*Puzzle),
+		now:     time.Now,
+	}
+}
+
+// persistedDays is the JSON structure of the daily file.
+type persistedDays struct {
+	Days    map[string]*dayResults `json:"days"`
+	SavedAt time.Time              `json:"saved_at"`
+	Version int                    `json:"version"`
+}
+
+// Load reads results from the file, if it exists, and saves changes there afterwards.
+// Files from a newer format version are refused.
+func (s *Store) Load(filename string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 {
+		var pd persistedDays
+		if err := json.Unmarshal(data, &pd); err != nil {
+			return err
+		}
+		if pd.Version > currentVersion {
+			return fmt.Errorf("daily file version %d is newer than supported version %d", pd.Version, currentVersion)
+		}
+		for date, d := range pd.Days {
+			s.days[date] = d
+		}
+		slog.Info("Loaded daily puzzle results", "days", len(pd.Days), "file", filename)
+	}
+
+	s.filename = filename
+	return nil
+}
+
+// Today returns the current date's puzzle.
+func (s *Store) Today() (*Puzzle, error) {
+	return s.Puzzle(Date(s.now()))
+}
+
+// Puzzle returns the puzzle for a date, generating it the first time it's asked for.
+func (s *Store) Puzzle(date string) (*Puzzle, error) {
+	s.puzzleMu.Lock()
+	defer s.puzzleMu.Unlock()
+
+	if p, ok := s.puzzles[date]; ok {
+		return p, nil
+	}
+	p, err := NewPuzzle(date)
+	if err != nil {
+		return nil, err
+	}
+	// Only the current puzzles are worth keeping
+	for d := range s.puzzles {
+		if d < date {
+			delete(s.puzzles, d)
+		}
+	}
+	s.puzzles[date] = p
+	return p, nil
+}
+
+// Start returns today's puzzle for an account, and when the account first
+// fetched it, which starts its clock.
+func (s *Store) Start(accountID string) (*Puzzle, time.Time, error) {
+	p, err := s.Today()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.day(p.Date)
+	started, ok := d.Started[accountID]
+	if !ok {
+		started = s.now()
+		d.Started[accountID] = started
+		if err := s.save(); err != nil {
+			slog.Error("Failed to save daily puzzle results", "date", p.Date, "error", err)
+		}
+	}
+	return p, started, nil
+}
+
+// Submit checks an account's solution to the puzzle for date, which must be
+// today's, and keeps it if it's the account's best. It returns the account's
+// best entry and its rank on the day's leaderboard, from 1.
+func (s *Store) Submit(accountID, date string, moves []model.BotPosition) (*Entry, int, error) {
+	today := Date(s.now())
+	if date == "" {
+		date = today
+	}
+	if date != today {
+		return nil, 0, fmt.Errorf("daily puzzle for %s is closed", date)
+	}
+	p, err := s.Puzzle(date)
+	if err != nil {
+		return nil, 0, err
+	}
+	if ok, _ := p.Game.CheckSolution(moves); !ok {
+		return nil, 0, errors.New("solution does not solve the daily puzzle")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.day(date)
+	started, ok := d.Started[accountID]
+	if !ok {
+		return nil, 0, ErrNotStarted
+	}
+	now := s.now()
+	entry := &Entry{
+		AccountID:   accountID,
+		Moves:       slices.Clone(moves),
+		Time:        now.Sub(started),
+		SubmittedAt: now,
+	}
+	if best, ok := d.Entries[accountID]; !ok || entry.better(best) {
+		d.Entries[accountID] = entry
+		if err := s.save(); err != nil {
+			slog.Error("Failed to save daily puzzle results", "date", date, "error", err)
+		}
+	}
+
+	best := *d.Entries[accountID]
+	rank := 1
+	for _, e := range d.Entries {
+		if e.better(&best) {
+			rank++
+		}
+	}
+	return &best, rank, nil
+}
+
+// Leaderboard returns up to limit of a day's best entries, best first. Days
+// older than keepDays have no entries.
+func (s *Store) Leaderboard(date string, limit int) []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.days[date]
+	if !ok {
+		return nil
+	}
+	entries := make([]Entry, 0, len(d.Entries))
+	for _, e := range d.Entries {
+		entries = append(entries, *e)
+	}
+	slices.SortFunc(entries, func(a, b Entry) int { return compareEntries(&a, &b) })
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// day returns the results for a date, creating them if needed. Must be called
+// with mu held.
+func (s *Store) day(date string) *dayResults {
+	d, ok := s.days[date]
+	if !ok {
+		d = &dayResults{Started: make(map[string]time.Time), Entries: make(map[string]*Entry)}
+		s.days[date] = d
+	}
+	return d
+}
+
+// save drops days older than keepDays and writes the rest to the daily file.
+// Must be called with mu held.
+func (s *Store) save() error {
+	cutoff := Date(s.now().AddDate(0, 0, -(keepDays - 1)))
+	for date := range s.days {
+		if date < cutoff {
+			delete(s.days, date)
+		}
+	}
+	if s.filename == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(persistedDays{Days: s.days, SavedAt: s.now(), Version: currentVersion}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to temp file first, then rename for atomicity
+	tmpFile := s.filename + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, s.filename); err != nil {
+		os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package daily
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// testClock is a settable clock for a Store.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func newTestStore() (*Store, *testClock) {
+	clock := &testClock{now: time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)}
+	s := NewStore()
+	s.now = clock.Now
+	return s, clock
+}
+
+// solve returns an optimal solution to today's puzzle.
+func solve(t *testing.T, s *Store) []model.BotPosition {
+	t.Helper()
+	p, err := s.Today()
+	if err != nil {
+		t.Fatalf("Today failed: %v", err)
+	}
+	moves, ok := p.Game.Solve(p.OptimalMoves, solverStateLimit)
+	if !ok {
+		t.Fatal("expected the puzzle to be solvable")
+	}
+	return moves
+}
+
+func TestStore_Submit(t *testing.T) {
+	s, clock := newTestStore()
+	moves := solve(t, s)
+
+	if _, _, err := s.Start("a1"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	clock.now = clock.now.Add(time.Minute)
+	if _, _, err := s.Start("a2"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	clock.now = clock.now.Add(time.Minute)
+
+	entry, rank, err := s.Submit("a2", "", moves)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if entry.Time != time.Minute || rank != 1 {
+		t.Errorf("expected a2 to take 1m and rank 1, got %v and rank %d", entry.Time, rank)
+	}
+
+	entry, rank, err = s.Submit("a1", Date(clock.now), moves)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if entry.Time != 2*time.Minute || rank != 2 {
+		t.Errorf("expected a1 to take 2m and rank 2, got %v and rank %d", entry.Time, rank)
+	}
+
+	// A slower resubmission doesn't replace the best entry
+	clock.now = clock.now.Add(time.Minute)
+	entry, _, err = s.Submit("a2", "", moves)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if entry.Time != time.Minute {
+		t.Errorf("expected a2 to keep its 1m entry, got %v", entry.Time)
+	}
+
+	board := s.Leaderboard(Date(clock.now), 10)
+	if len(board) != 2 || board[0].AccountID != "a2" || board[1].AccountID != "a1" {
+		t.Errorf("expected a2 then a1, got %+v", board)
+	}
+	if board := s.Leaderboard(Date(clock.now), 1); len(board) != 1 {
+		t.Errorf("expected the limit to keep 1 entry, got %d", len(board))
+	}
+}
+
+func TestStore_Submit_Rejected(t *testing.T) {
+	s, clock := newTestStore()
+	moves := solve(t, s)
+
+	if _, _, err := s.Submit("a1", "", moves); !errors.Is(err, ErrNotStarted) {
+		t.Errorf("expected ErrNotStarted before fetching the puzzle, got %v", err)
+	}
+
+	s.Start("a1")
+	if _, _, err := s.Submit("a1", "", moves[:len(moves)-1]); err == nil {
+		t.Error("expected an incomplete solution to be rejected")
+	}
+	yesterday := Date(clock.now.AddDate(0, 0, -1))
+	if _, _, err := s.Submit("a1", yesterday, moves); err == nil || !strings.Contains(err.Error(), "closed") {
+		t.Errorf("expected yesterday's puzzle to be closed, got %v", err)
+	}
+}
+
+func TestStore_Start_KeepsFirstFetch(t *testing.T) {
+	s, clock := newTestStore()
+	_, first, _ := s.Start("a1")
+	clock.now = clock.now.Add(time.Hour)
+	_, again, _ := s.Start("a1")
+	if !again.Equal(first) {
+		t.Errorf("expected the clock to start at the first fetch %v, got %v", first, again)
+	}
+}
+
+func TestStore_PersistsAcrossLoads(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "daily.json")
+
+	s1, clock := newTestStore()
+	if err := s1.Load(filename); err != nil {
+		t.Fatalf("Load of missing file failed: %v", err)
+	}
+	s1.Start("a1")
+	clock.now = clock.now.Add(time.Minute)
+	if _, _, err := s1.Submit("a1", "", solve(t, s1)); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	s2, _ := newTestStore()
+	s2.now = clock.Now
+	if err := s2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	board := s2.Leaderboard(Date(clock.now), 10)
+	if len(board) != 1 || board[0].AccountID != "a1" || board[0].Time != time.Minute {
+		t.Errorf("expected a1's 1m entry after reload, got %+v", board)
+	}
+}
+
+func TestStore_DropsOldDays(t *testing.T) {
+	s, clock := newTestStore()
+	s.Start("a1")
+	first := Date(clock.now)
+
+	clock.now = clock.now.AddDate(0, 0, keepDays)
+	s.Start("a1")
+	if _, ok := s.days[first]; ok {
+		t.Errorf("expected results from %d days ago to be dropped", keepDays)
+	}
+}
+
+func TestStore_Load_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "daily.json")
+	if err := os.WriteFile(filename, []byte(`{"days":{},"version":99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := NewStore().Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// RequestIDHeader carries a call's request ID. Clients may set it to tie the
+// server's log entries to their own; otherwise the server generates one. Either
+// way it is returned in the response headers.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength is the longest request ID accepted from a client.
+const maxRequestIDLength = 64
+
+// Interceptor logs every RPC when it finishes, with its method, request ID,
+// result code and duration, and the room and player IDs it was about.
+type Interceptor struct {
+	logger *slog.Logger
+}
+
+// NewInterceptor creates an Interceptor logging to logger.
+func NewInterceptor(logger *slog.Logger) *Interceptor {
+	return &Interceptor{logger: logger}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		id := requestID(req.Header())
+
+		resp, err := next(ctx, req)
+
+		var ids callIDs
+		ids.from(req.Any())
+		if err == nil {
+			resp.Header().Set(RequestIDHeader, id)
+			ids.from(resp.Any())
+		}
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			connectErr.Meta().Set(RequestIDHeader, id)
+		}
+		i.log(ctx, req.Spec().Procedure, id, ids, start, err)
+		return resp, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		id := requestID(conn.RequestHeader())
+		conn.ResponseHeader().Set(RequestIDHeader, id)
+
+		logged := &loggedConn{StreamingHandlerConn: conn}
+		err := next(ctx, logged)
+		i.log(ctx, conn.Spec().Procedure, id, logged.ids, start, err)
+		return err
+	}
+}
+
+// log writes the entry for a finished call: at info level if it succeeded,
+// error level if the server failed, and warn level for any other error.
+func (i *Interceptor) log(ctx context.Context, method, id string, ids callIDs, start time.Time, err error) {
+	attrs := []slog.Attr{
+		slog.String(KeyMethod, method),
+		slog.String(KeyRequestID, id),
+	}
+	if ids.roomID != "" {
+		attrs = append(attrs, RoomID(ids.roomID))
+	}
+	if ids.playerID != "" {
+		attrs = append(attrs, PlayerID(ids.playerID))
+	}
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	level := slog.LevelInfo
+	if err == nil {
+		attrs = append(attrs, slog.String("code", "ok"))
+	} else {
+		code := connect.CodeOf(err)
+		attrs = append(attrs, slog.String("code", code.String()), slog.String("error", err.Error()))
+		switch code {
+		case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss:
+			level = slog.LevelError
+		default:
+			level = slog.LevelWarn
+		}
+	}
+	i.logger.LogAttrs(ctx, level, "RPC", attrs...)
+}
+
+// requestID returns the request ID a client sent, or a new one if it sent none
+// or one that is too long.
+func requestID(header http.Header) string {
+	if id := header.Get(RequestIDHeader); id != "" && len(id) <= maxRequestIDLength {
+		return id
+	}
+	var b [8]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// callIDs are the room and player IDs a call was about, taken from the first
+// of its messages that have them.
+type callIDs struct {
+	roomID   string
+	playerID string
+}
+
+// from fills in any IDs still missing from msg's room_id and player_id fields.
+func (c *callIDs) from(msg any) {
+	if m, ok := msg.(interface{ GetRoomId() string }); ok && c.roomID == "" {
+		c.roomID = m.GetRoomId()
+	}
+	if m, ok := msg.(interface{ GetPlayerId() string }); ok && c.playerID == "" {
+		c.playerID = m.GetPlayerId()
+	}
+}
+
+// loggedConn records the IDs of the messages a streaming call receives.
+type loggedConn struct {
+	connect.StreamingHandlerConn
+	ids callIDs
+}
+
+func (c *loggedConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil {
+		c.ids.from(msg)
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
+const procedure = "/test.Service/MarkFinished"
+
+// newTestClient serves procedure behind an Interceptor logging JSON to the
+// returned buffer. The handler fails with NotFound for room "GONE".
+func newTestClient(t *testing.T) (*connect.Client[pb.MarkFinishedSolvingRequest, pb.MarkFinishedSolvingResponse], *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+		func(_ context.Context, req *connect.Request[pb.MarkFinishedSolvingRequest]) (*connect.Response[pb.MarkFinishedSolvingResponse], error) {
+			if req.Msg.RoomId == "GONE" {
+				return nil, connect.NewError(connect.CodeNotFound, errors.New("room not found"))
+			}
+			return connect.NewResponse(&pb.MarkFinishedSolvingResponse{}), nil
+		}, connect.WithInterceptors(NewInterceptor(logger))))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return connect.NewClient[pb.MarkFinishedSolvingRequest, pb.MarkFinishedSolvingResponse](server.Client(), server.URL+procedure), &buf
+}
+
+// lastEntry decodes the last JSON log entry in buf.
+func lastEntry(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	var entry map[string]any
+	if err := json.Unmarshal(lines[len(lines)-1], &entry); err != nil {
+		t.Fatalf("failed to decode log entry %q: %v", lines[len(lines)-1], err)
+	}
+	return entry
+}
+
+func TestInterceptor_LogsCall(t *testing.T) {
+	client, buf := newTestClient(t)
+
+	req := connect.NewRequest(&pb.MarkFinishedSolvingRequest{RoomId: "ABC123", PlayerId: "p1"})
+	req.Header().Set(RequestIDHeader, "req-42")
+	resp, err := client.CallUnary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if got := resp.Header().Get(RequestIDHeader); got != "req-42" {
+		t.Errorf("expected the client's request ID echoed, got %q", got)
+	}
+
+	entry := lastEntry(t, buf)
+	want := map[string]any{
+		"level":      "INFO",
+		KeyMethod:    procedure,
+		KeyRequestID: "req-42",
+		KeyRoomID:    "ABC123",
+		KeyPlayerID:  "p1",
+		"code":       "ok",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("expected %s=%v, got %v", k, v, entry[k])
+		}
+	}
+}
+
+func TestInterceptor_LogsError(t *testing.T) {
+	client, buf := newTestClient(t)
+
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(&pb.MarkFinishedSolvingRequest{RoomId: "GONE"}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	// Without a request ID from the client the server makes one up and returns it
+	entry := lastEntry(t, buf)
+	id, _ := entry[KeyRequestID].(string)
+	if id == "" || connectErr.Meta().Get(RequestIDHeader) != id {
+		t.Errorf("expected a generated request ID in the log and the error, got %q and %q", id, connectErr.Meta().Get(RequestIDHeader))
+	}
+	if entry["level"] != "WARN" || entry["code"] != "not_found" || entry[KeyRoomID] != "GONE" {
+		t.Errorf("expected a not_found warning for room GONE, got %v", entry)
+	}
+	if _, ok := entry[KeyPlayerID]; ok {
+		t.Errorf("expected no player ID for a call without one, got %v", entry[KeyPlayerID])
+	}
+}
@@ -0,0 +1,44 @@
+// Package logging sets up the server's structured logging and the attributes
+// its log entries share.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/srsalisbury/bouncebot/server/config"
+)
+
+// Attribute keys shared by log entries, so that every entry about one room,
+// player or request can be found with a single query.
+const (
+	KeyRoomID    = "room_id"
+	KeyPlayerID  = "player_id"
+	KeyMethod    = "method"
+	KeyRequestID = "request_id"
+)
+
+// RoomID returns the attribute for a room's ID.
+func RoomID(id string) slog.Attr {
+	return slog.String(KeyRoomID, id)
+}
+
+// PlayerID returns the attribute for a player's ID.
+func PlayerID(id string) slog.Attr {
+	return slog.String(KeyPlayerID, id)
+}
+
+// New creates a logger writing entries at level or above to w, as text
+// (config.LogFormatText) or one JSON object per line (config.LogFormatJSON).
+func New(w io.Writer, level slog.Level, format string) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	switch format {
+	case config.LogFormatText:
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case config.LogFormatJSON:
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q (want %s or %s)", format, config.LogFormatText, config.LogFormatJSON)
+	}
+}
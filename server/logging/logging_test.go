@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/srsalisbury/bouncebot/server/config"
+)
+
+func TestNew_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, slog.LevelInfo, config.LogFormatJSON)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	logger.Debug("hidden")
+	logger.Info("Loaded rooms", RoomID("ABC123"), PlayerID("p1"))
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected a single JSON entry, got %q: %v", buf.String(), err)
+	}
+	if entry["msg"] != "Loaded rooms" || entry[KeyRoomID] != "ABC123" || entry[KeyPlayerID] != "p1" {
+		t.Errorf("expected the message with room and player IDs, got %v", entry)
+	}
+}
+
+func TestNew_Text(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, slog.LevelWarn, config.LogFormatText)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	logger.Info("hidden")
+	logger.Warn("Slow client", RoomID("ABC123"))
+
+	if got := buf.String(); strings.Contains(got, "hidden") || !strings.Contains(got, "room_id=ABC123") {
+		t.Errorf("expected only the warning, with its room ID, got %q", got)
+	}
+}
+
+func TestNew_UnknownFormat(t *testing.T) {
+	if _, err := New(&bytes.Buffer{}, slog.LevelInfo, "xml"); err == nil {
+		t.Error("expected error for an unknown format")
+	}
+}
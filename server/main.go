@@ -4,15 +4,27 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/srsalisbury/bouncebot/proto/protoconnect"
+	"github.com/srsalisbury/bouncebot/server/account"
+	"github.com/srsalisbury/bouncebot/server/archive"
 	"github.com/srsalisbury/bouncebot/server/config"
+	"github.com/srsalisbury/bouncebot/server/daily"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/metrics"
+	"github.com/srsalisbury/bouncebot/server/rating"
 	"github.com/srsalisbury/bouncebot/server/room"
+	"github.com/srsalisbury/bouncebot/server/stats"
+	"github.com/srsalisbury/bouncebot/server/tournament"
+	"github.com/srsalisbury/bouncebot/server/watch"
 	"github.com/srsalisbury/bouncebot/server/ws"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -37,18 +49,82 @@ func main() {
 		cfg.DataFile = *dataFile
 	}
 
-	log.Printf("Configuration: port=%d, data=%s, origins=%v", cfg.Port, cfg.DataFile, cfg.AllowedOrigins)
+	// Structured logging; the standard log package writes through it too
+	logger, err := logging.New(os.Stderr, cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		log.Fatal(err)
+	}
+	slog.SetDefault(logger)
+
+	storage := cfg.StorageBackend()
+	slog.Info("Configuration", "port", cfg.Port, "data", cfg.DataFile, "storage", storage, "origins", cfg.AllowedOrigins)
 
 	rooms := room.NewRoomService()
 	rooms.SetDisconnectGracePeriod(cfg.DisconnectGracePeriod)
+	rooms.SetHintPenalty(cfg.HintPenalty)
+
+	switch storage {
+	case config.StorageJSON:
+		// The default persistence manager writes JSON
+	case config.StorageSQLite:
+		if err := room.SQLiteAvailable(); err != nil {
+			fatal("SQLite storage is not available in this build", "error", err)
+		}
+		rooms.SetPersistenceManager(room.NewSQLitePersistenceManager())
+	default:
+		fatal("Unknown storage backend", "storage", storage, "want", []string{config.StorageJSON, config.StorageSQLite})
+	}
 
 	// Load existing rooms from disk (continue with empty list on failure)
 	if err := rooms.Load(cfg.DataFile); err != nil {
-		log.Printf("Warning: Failed to load rooms from %s: %v (starting with empty room list)", cfg.DataFile, err)
+		slog.Warn("Failed to load rooms, starting with empty room list", "file", cfg.DataFile, "error", err)
+	}
+
+	// Accounts outlive rooms, so refuse to start rather than overwrite an unreadable file
+	accounts := account.NewStore()
+	if err := accounts.Load(cfg.AccountsFile); err != nil {
+		fatal("Failed to load accounts", "file", cfg.AccountsFile, "error", err)
 	}
+	rooms.AddGameRecorder(accounts)
 
-	// Start auto-save goroutine
-	stopAutoSave := rooms.StartAutoSave(cfg.DataFile, cfg.AutoSaveInterval)
+	ratings := rating.NewLadder()
+	if err := ratings.Load(cfg.RatingsFile); err != nil {
+		fatal("Failed to load ratings", "file", cfg.RatingsFile, "error", err)
+	}
+	rooms.AddGameRecorder(ratings)
+
+	playerStats := stats.NewStore()
+	if err := playerStats.Load(cfg.StatsFile); err != nil {
+		fatal("Failed to load stats", "file", cfg.StatsFile, "error", err)
+	}
+	rooms.AddGameRecorder(playerStats)
+
+	dailyPuzzles := daily.NewStore()
+	if err := dailyPuzzles.Load(cfg.DailyFile); err != nil {
+		fatal("Failed to load daily puzzle results", "file", cfg.DailyFile, "error", err)
+	}
+
+	puzzles, err := archive.Load(cfg.ArchiveFile)
+	if err != nil {
+		fatal("Failed to load puzzle archive", "file", cfg.ArchiveFile, "error", err)
+	}
+	slog.Info("Loaded archive puzzles", "count", puzzles.Len(), "file", cfg.ArchiveFile)
+
+	tournaments := tournament.NewManager(rooms)
+	if err := tournaments.Load(cfg.TournamentsFile); err != nil {
+		fatal("Failed to load tournaments", "file", cfg.TournamentsFile, "error", err)
+	}
+	rooms.AddGameRecorder(tournaments)
+
+	// Start auto-save goroutine. SQLite saves each room as it changes, so only
+	// the JSON file needs periodic saves, with a journal of changes in between.
+	var stopAutoSave func()
+	if storage == config.StorageJSON {
+		if err := rooms.EnableJournal(cfg.JournalFile(), cfg.JournalSyncInterval); err != nil {
+			fatal("Failed to open journal", "file", cfg.JournalFile(), "error", err)
+		}
+		stopAutoSave = rooms.StartAutoSave(cfg.DataFile, cfg.AutoSaveInterval)
+	}
 
 	// Clean up stale rooms immediately, then start periodic cleanup
 	rooms.CleanupStaleRooms(cfg.RoomMaxAge)
@@ -59,19 +135,38 @@ func main() {
 	signal.Notify(shutdownChan, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
 		<-shutdownChan
-		log.Println("Shutting down, saving rooms...")
+		slog.Info("Shutting down, saving rooms")
 		close(stopCleanup)
-		close(stopAutoSave) // This triggers final save
+		// Solve and record games that just ended, so the final save includes them
+		rooms.FlushRecords()
+		if stopAutoSave != nil {
+			stopAutoSave() // Returns once the final save is written
+		}
+		// Sync the journal's last batch of entries before exiting
+		if err := rooms.CloseJournal(); err != nil {
+			slog.Error("Failed to close journal", "error", err)
+		}
 		os.Exit(0)
 	}()
 
 	wsHub := ws.NewHub(rooms, cfg)
-	rooms.SetBroadcaster(wsHub)
+	rooms.AddBroadcaster(wsHub)
+
+	// WatchRoom streams receive the same events as WebSocket clients
+	watcher := watch.NewBroadcaster()
+	rooms.AddBroadcaster(watcher)
 
 	mux := http.NewServeMux()
-	path, handler := protoconnect.NewBounceBotHandler(NewBounceBotServer(rooms))
+	path, handler := protoconnect.NewBounceBotHandler(
+		NewBounceBotServer(cfg, rooms, watcher, accounts, ratings, playerStats, dailyPuzzles, puzzles, tournaments),
+		connect.WithInterceptors(metrics.NewInterceptor(), logging.NewInterceptor(logger)),
+	)
 	mux.Handle(path, handler)
 
+	// Prometheus metrics endpoint
+	metrics.RegisterActivity(rooms.Activity)
+	mux.Handle("/metrics", promhttp.Handler())
+
 	// WebSocket endpoint
 	mux.HandleFunc("/ws", wsHub.HandleWebSocket)
 
@@ -91,20 +186,28 @@ func main() {
 			"Grpc-Timeout",
 			"X-Grpc-Web",
 			"X-User-Agent",
+			logging.RequestIDHeader,
 		},
 		ExposedHeaders: []string{
 			"Grpc-Status",
 			"Grpc-Message",
 			"Grpc-Status-Details-Bin",
+			logging.RequestIDHeader,
 		},
 	})
 
 	addr := fmt.Sprintf(":%d", cfg.Port)
-	log.Printf("BounceBot Connect server listening at %s", addr)
+	slog.Info("BounceBot Connect server listening", "addr", addr)
 
 	// Use h2c to support HTTP/2 without TLS (needed for gRPC clients)
 	h2cHandler := h2c.NewHandler(corsHandler.Handler(mux), &http2.Server{})
 	if err := http.ListenAndServe(addr, h2cHandler); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+		fatal("Failed to serve", "error", err)
 	}
 }
+
+// fatal logs an error and exits.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
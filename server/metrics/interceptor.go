@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Interceptor records the latency and result code of every RPC in RPCDuration.
+type Interceptor struct{}
+
+// NewInterceptor creates an Interceptor.
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+		observeRPC(req.Spec().Procedure, start, err)
+		return resp, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		observeRPC(conn.Spec().Procedure, start, err)
+		return err
+	}
+}
+
+// observeRPC records an RPC that started at start and finished with err.
+func observeRPC(procedure string, start time.Time, err error) {
+	RPCDuration.WithLabelValues(procedure, codeOf(err)).Observe(time.Since(start).Seconds())
+}
+
+// codeOf returns the Connect code of an RPC's error, or "ok" if it succeeded.
+func codeOf(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	okProcedure     = "/test.Service/Ok"
+	failedProcedure = "/test.Service/Fail"
+)
+
+// newTestClients serves a procedure that succeeds and one that fails with
+// NotFound behind an Interceptor, and returns clients for both.
+func newTestClients(t *testing.T) (ok, failed *connect.Client[emptypb.Empty, emptypb.Empty]) {
+	t.Helper()
+	interceptors := connect.WithInterceptors(NewInterceptor())
+	mux := http.NewServeMux()
+	mux.Handle(okProcedure, connect.NewUnaryHandler(okProcedure,
+		func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		}, interceptors))
+	mux.Handle(failedProcedure, connect.NewUnaryHandler(failedProcedure,
+		func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("room not found"))
+		}, interceptors))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ok = connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+okProcedure)
+	failed = connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+failedProcedure)
+	return ok, failed
+}
+
+// rpcCount returns the number of RPCs RPCDuration has observed for the
+// procedure and code.
+func rpcCount(t *testing.T, procedure, code string) uint64 {
+	t.Helper()
+	var m dto.Metric
+	if err := RPCDuration.WithLabelValues(procedure, code).(prometheus.Histogram).Write(&m); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestInterceptor_RecordsProcedureAndCode(t *testing.T) {
+	ok, failed := newTestClients(t)
+	ctx := context.Background()
+
+	ok.CallUnary(ctx, connect.NewRequest(&emptypb.Empty{}))
+	ok.CallUnary(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if _, err := failed.CallUnary(ctx, connect.NewRequest(&emptypb.Empty{})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	if got := rpcCount(t, okProcedure, "ok"); got != 2 {
+		t.Errorf("expected 2 successful RPCs, got %d", got)
+	}
+	if got := rpcCount(t, failedProcedure, "not_found"); got != 1 {
+		t.Errorf("expected 1 not_found RPC, got %d", got)
+	}
+	if got := rpcCount(t, failedProcedure, "ok"); got != 0 {
+		t.Errorf("expected no successful RPCs of %s, got %d", failedProcedure, got)
+	}
+}
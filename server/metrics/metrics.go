@@ -0,0 +1,107 @@
+// Package metrics defines the Prometheus metrics the server exports on /metrics.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "bouncebot"
+
+var (
+	// WebSocketConnections is the number of open WebSocket connections.
+	WebSocketConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "websocket_connections",
+		Help:      "Open WebSocket connections.",
+	})
+
+	// WebSocketSlowClientsDropped counts WebSocket clients evicted for not
+	// keeping up with their room's events.
+	WebSocketSlowClientsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "websocket_slow_clients_dropped_total",
+		Help:      "WebSocket clients dropped because their send buffer was full.",
+	})
+
+	// RPCDuration is the latency of RPCs by procedure and Connect code. For
+	// streams it is the lifetime of the stream.
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "RPC latency by procedure and result code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure", "code"})
+
+	// GamesStarted counts games started in any room.
+	GamesStarted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "games_started_total",
+		Help:      "Games started.",
+	})
+
+	// GamesEnded counts games ended in any room.
+	GamesEnded = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "games_ended_total",
+		Help:      "Games ended.",
+	})
+
+	// SolutionsSubmitted counts solution submissions by result: valid, or invalid
+	// when the moves don't solve the game. Other failures only show in RPC codes.
+	SolutionsSubmitted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "solutions_submitted_total",
+		Help:      "Solution submissions by result.",
+	}, []string{"result"})
+
+	// AutoSaveDuration is how long each periodic save of the rooms file takes.
+	AutoSaveDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "autosave_duration_seconds",
+		Help:      "Duration of periodic room saves.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	// AutoSaveFailures counts periodic room saves that failed.
+	AutoSaveFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "autosave_failures_total",
+		Help:      "Periodic room saves that failed.",
+	})
+
+	// StaleRoomsCleaned counts rooms removed for inactivity.
+	StaleRoomsCleaned = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "stale_rooms_cleaned_total",
+		Help:      "Rooms removed after being inactive too long.",
+	})
+)
+
+// Results of a solution submission, the values of SolutionsSubmitted's result label.
+const (
+	SolutionValid   = "valid"
+	SolutionInvalid = "invalid"
+)
+
+// RegisterActivity exports the number of active rooms and connected players,
+// read from activity on every scrape. Call it once, with the room service's
+// counts.
+func RegisterActivity(activity func() (rooms, players int)) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "rooms_active",
+		Help:      "Rooms currently held by the server.",
+	}, func() float64 {
+		rooms, _ := activity()
+		return float64(rooms)
+	})
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "players_active",
+		Help:      "Players currently connected to a room, not counting bots.",
+	}, func() float64 {
+		_, players := activity()
+		return float64(players)
+	})
+}
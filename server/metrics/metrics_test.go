@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRegisterActivity(t *testing.T) {
+	RegisterActivity(func() (int, int) { return 3, 5 })
+
+	want := `
+# HELP bouncebot_players_active Players currently connected to a room, not counting bots.
+# TYPE bouncebot_players_active gauge
+bouncebot_players_active 5
+# HELP bouncebot_rooms_active Rooms currently held by the server.
+# TYPE bouncebot_rooms_active gauge
+bouncebot_rooms_active 3
+`
+	err := testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(want),
+		"bouncebot_rooms_active", "bouncebot_players_active")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,34 @@
+package rating
+
+import (
+	"math"
+
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+const (
+	// HandicapStep is the rating gap worth one extra move. At 200 points the
+	// stronger player is expected to win about three games in four.
+	HandicapStep = 200.0
+
+	// MaxSuggestedExtraMoves caps a suggested handicap, so a newcomer against an
+	// expert isn't handed every game.
+	MaxSuggestedExtraMoves = 3
+)
+
+// SuggestHandicaps suggests a handicap for each player from their rating, by
+// player ID: one extra move for every HandicapStep a player is rated below the
+// strongest player, up to MaxSuggestedExtraMoves. The strongest get none.
+func SuggestHandicaps(ratings map[string]float64) map[string]room.Handicap {
+	best := math.Inf(-1)
+	for _, r := range ratings {
+		best = math.Max(best, r)
+	}
+
+	suggestions := make(map[string]room.Handicap, len(ratings))
+	for id, r := range ratings {
+		extra := min(int((best-r)/HandicapStep), MaxSuggestedExtraMoves)
+		suggestions[id] = room.Handicap{ExtraMoves: extra}
+	}
+	return suggestions
+}
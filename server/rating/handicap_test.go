@@ -0,0 +1,31 @@
+package rating
+
+import "testing"
+
+func TestSuggestHandicaps(t *testing.T) {
+	suggestions := SuggestHandicaps(map[string]float64{
+		"expert":   1900,
+		"strong":   1850,
+		"average":  1500,
+		"newcomer": 1000,
+	})
+
+	want := map[string]int{"expert": 0, "strong": 0, "average": 2, "newcomer": MaxSuggestedExtraMoves}
+	for id, extra := range want {
+		if got := suggestions[id]; got.ExtraMoves != extra || got.Delay != 0 {
+			t.Errorf("%s: expected %d extra moves, got %+v", id, extra, got)
+		}
+	}
+}
+
+func TestSuggestHandicaps_Even(t *testing.T) {
+	suggestions := SuggestHandicaps(map[string]float64{"a": InitialRating, "b": InitialRating})
+	for id, h := range suggestions {
+		if !h.IsZero() {
+			t.Errorf("%s: expected no handicap between equal ratings, got %+v", id, h)
+		}
+	}
+	if len(SuggestHandicaps(nil)) != 0 {
+		t.Error("expected no suggestions without players")
+	}
+}
@@ -0,0 +1,228 @@
+// Package rating keeps an Elo rating for each player account, updated from the
+// result of every completed game.
+package rating
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// InitialRating is the rating of an account that hasn't played a rated game.
+	InitialRating = 1500.0
+
+	// kFactor is the most a rating can move in one game.
+	kFactor = 32.0
+
+	// currentVersion is the ratings file format version written by the Ladder.
+	currentVersion = 1
+)
+
+// Rating is an account's position on the ladder.
+type Rating struct {
+	AccountID string
+	Value     float64
+	Games     int       // Rated games played
+	UpdatedAt time.Time // Zero if the account hasn't played a rated game
+}
+
+// ToProto converts a Rating to its protobuf representation, without the account name.
+func (r *Rating) ToProto() *pb.Rating {
+	out := &pb.Rating{
+		AccountId:   r.AccountID,
+		Rating:      r.Value,
+		GamesPlayed: int32(r.Games),
+	}
+	if !r.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(r.UpdatedAt)
+	}
+	return out
+}
+
+// Ladder holds the ratings of every account that has played a rated game. After
+// Load, every change is written to the ratings file.
+type Ladder struct {
+	mu       sync.Mutex
+	filename string             // Set by Load; empty keeps ratings in memory only
+	ratings  map[string]*Rating // By account ID
+	now      func() time.Time
+}
+
+// NewLadder creates an empty in-memory Ladder.
+func NewLadder() *Ladder {
+	return &Ladder{ratings: make(map[string]*Rating), now: time.Now}
+}
+
+// persistedRatings is the JSON structure of the ratings file.
+type persistedRatings struct {
+	Ratings []*Rating `json:"ratings"`
+	SavedAt time.Time `json:"saved_at"`
+	Version int       `json:"version"`
+}
+
+// Load reads ratings from the file, if it exists, and saves changes there afterwards.
+// Files from a newer format version are refused.
+func (l *Ladder) Load(filename string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	data, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 {
+		var pr persistedRatings
+		if err := json.Unmarshal(data, &pr); err != nil {
+			return err
+		}
+		if pr.Version > currentVersion {
+			return fmt.Errorf("ratings file version %d is newer than supported version %d", pr.Version, currentVersion)
+		}
+		for _, r := range pr.Ratings {
+			l.ratings[r.AccountID] = r
+		}
+		slog.Info("Loaded ratings", "count", len(pr.Ratings), "file", filename)
+	}
+
+	l.filename = filename
+	return nil
+}
+
+// Get returns an account's rating, or InitialRating if it hasn't played a rated game.
+func (l *Ladder) Get(accountID string) Rating {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.get(accountID)
+}
+
+// get returns an account's rating. Must be called with mu held.
+func (l *Ladder) get(accountID string) Rating {
+	if r, ok := l.ratings[accountID]; ok {
+		return *r
+	}
+	return Rating{AccountID: accountID, Value: InitialRating}
+}
+
+// Top returns up to limit ratings, highest first.
+func (l *Ladder) Top(limit int) []Rating {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	top := make([]Rating, 0, len(l.ratings))
+	for _, r := range l.ratings {
+		top = append(top, *r)
+	}
+	slices.SortFunc(top, func(a, b Rating) int {
+		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.AccountID, b.AccountID)
+	})
+	if len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
+
+// RecordGame updates the ratings of the signed-in players of a completed game,
+// implementing room.GameRecorder. Guests are not rated, and games nobody rated
+// solved, or with fewer than two rated players, leave ratings unchanged.
+func (l *Ladder) RecordGame(roomID string, rec room.GameRecord) {
+	placings := Placings(rec)
+	if len(placings) < 2 || !placings[0].Solved {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	before := make([]float64, len(placings))
+	for i, p := range placings {
+		before[i] = l.get(p.AccountID).Value
+	}
+	now := l.now()
+	for i, delta := range eloDeltas(placings, before) {
+		r := l.get(placings[i].AccountID)
+		r.Value += delta
+		r.Games++
+		r.UpdatedAt = now
+		l.ratings[r.AccountID] = &r
+	}
+
+	if err := l.save(); err != nil {
+		slog.Error("Failed to save ratings after game", logging.RoomID(roomID), "error", err)
+	}
+}
+
+// eloDeltas returns each player's rating change. Every pair of players is scored
+// as a win, loss or draw by placing, and each player's change is the average of
+// their pairwise Elo updates, so a game moves a rating by at most kFactor.
+func eloDeltas(placings []Placing, ratings []float64) []float64 {
+	n := len(placings)
+	deltas := make([]float64, n)
+	for i := range n {
+		var sum float64
+		for j := range n {
+			if i == j {
+				continue
+			}
+			expected := 1 / (1 + math.Pow(10, (ratings[j]-ratings[i])/400))
+			sum += score(placings[i].Rank, placings[j].Rank) - expected
+		}
+		deltas[i] = kFactor * sum / float64(n-1)
+	}
+	return deltas
+}
+
+// score is the result of a pairwise comparison: 1 for the better rank, 0.5 for a draw.
+func score(rank, other int) float64 {
+	switch {
+	case rank < other:
+		return 1
+	case rank == other:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// save writes every rating to the ratings file. Must be called with mu held.
+func (l *Ladder) save() error {
+	if l.filename == "" {
+		return nil
+	}
+
+	pr := persistedRatings{SavedAt: l.now(), Version: currentVersion}
+	for _, r := range l.ratings {
+		pr.Ratings = append(pr.Ratings, r)
+	}
+	slices.SortFunc(pr.Ratings, func(a, b *Rating) int { return cmp.Compare(a.AccountID, b.AccountID) })
+
+	data, err := json.MarshalIndent(pr, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to temp file first, then rename for atomicity
+	tmpFile := l.filename + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, l.filename); err != nil {
+		os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package rating
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+func TestLadder_RecordGame_WinnerGainsLoserLoses(t *testing.T) {
+	l := NewLadder()
+
+	l.RecordGame("ROOM1", room.GameRecord{
+		Solutions:  []room.PlayerSolution{solution("p1", 5, time.Second), solution("p2", 7, time.Second)},
+		AccountIDs: map[string]string{"p1": "a1", "p2": "a2", "p3": "a3"},
+	})
+
+	a1, a2, a3 := l.Get("a1"), l.Get("a2"), l.Get("a3")
+	if a1.Value <= InitialRating || a3.Value >= InitialRating {
+		t.Errorf("expected a1 to gain and a3 to lose, got %.1f and %.1f", a1.Value, a3.Value)
+	}
+	if a2.Value != InitialRating {
+		t.Errorf("expected the middle of three equal players to stay at %.0f, got %.1f", InitialRating, a2.Value)
+	}
+	if total := a1.Value + a2.Value + a3.Value; math.Abs(total-3*InitialRating) > 1e-9 {
+		t.Errorf("expected rating points to be conserved, got total %.3f", total)
+	}
+	if a1.Games != 1 || a1.UpdatedAt.IsZero() {
+		t.Errorf("expected a1 to have 1 rated game, got %+v", a1)
+	}
+}
+
+func TestLadder_RecordGame_UpsetMovesMore(t *testing.T) {
+	l := NewLadder()
+	l.ratings["strong"] = &Rating{AccountID: "strong", Value: 1800}
+	l.ratings["weak"] = &Rating{AccountID: "weak", Value: 1400}
+
+	l.RecordGame("ROOM1", room.GameRecord{
+		Solutions:  []room.PlayerSolution{solution("p2", 5, time.Second)},
+		AccountIDs: map[string]string{"p1": "strong", "p2": "weak"},
+	})
+
+	gain := l.Get("weak").Value - 1400
+	if gain <= kFactor/2 || gain > kFactor {
+		t.Errorf("expected an upset to gain between %.0f and %.0f, got %.1f", kFactor/2, kFactor, gain)
+	}
+}
+
+func TestLadder_RecordGame_Unrated(t *testing.T) {
+	tests := []struct {
+		name string
+		rec  room.GameRecord
+	}{
+		{"one account", room.GameRecord{
+			Solutions:  []room.PlayerSolution{solution("p1", 5, 0)},
+			AccountIDs: map[string]string{"p1": "a1"},
+		}},
+		{"nobody solved", room.GameRecord{
+			AccountIDs: map[string]string{"p1": "a1", "p2": "a2"},
+		}},
+		{"only a guest solved", room.GameRecord{
+			Solutions:  []room.PlayerSolution{solution("guest", 5, 0)},
+			AccountIDs: map[string]string{"p1": "a1", "p2": "a2"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLadder()
+			l.RecordGame("ROOM1", tt.rec)
+			if top := l.Top(10); len(top) != 0 {
+				t.Errorf("expected no ratings, got %+v", top)
+			}
+		})
+	}
+}
+
+func TestLadder_Top(t *testing.T) {
+	l := NewLadder()
+	l.ratings["a"] = &Rating{AccountID: "a", Value: 1500}
+	l.ratings["b"] = &Rating{AccountID: "b", Value: 1600}
+	l.ratings["c"] = &Rating{AccountID: "c", Value: 1400}
+
+	top := l.Top(2)
+	if len(top) != 2 || top[0].AccountID != "b" || top[1].AccountID != "a" {
+		t.Errorf("expected b then a, got %+v", top)
+	}
+}
+
+func TestLadder_PersistsAcrossLoads(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "ratings.json")
+
+	l1 := NewLadder()
+	if err := l1.Load(filename); err != nil {
+		t.Fatalf("Load of missing file failed: %v", err)
+	}
+	l1.RecordGame("ROOM1", room.GameRecord{
+		Solutions:  []room.PlayerSolution{solution("p1", 5, 0)},
+		AccountIDs: map[string]string{"p1": "a1", "p2": "a2"},
+	})
+
+	l2 := NewLadder()
+	if err := l2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got, want := l2.Get("a1"), l1.Get("a1"); got.Value != want.Value || got.Games != 1 {
+		t.Errorf("expected %+v after reload, got %+v", want, got)
+	}
+}
+
+func TestLadder_Load_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "ratings.json")
+	if err := os.WriteFile(filename, []byte(`{"ratings":[],"version":99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := NewLadder().Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package rating
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+// Placing is a signed-in player's result in a completed game.
+type Placing struct {
+	PlayerID  string
+	AccountID string
+	Rank      int  // 1 for the best result; equal results share a rank
+	Solved    bool // Whether the player had a standing solution when the game ended
+	Best      *room.PlayerSolution
+}
+
+// Placings ranks the signed-in players of a completed game by their best
+// solution, as the game's winner was chosen: fewer moves (counting hint
+// penalties and handicaps) first, then the earlier solve. Players without a
+// solution share the last rank. Guests are left out.
+func Placings(rec room.GameRecord) []Placing {
+	best := rec.BestSolutions()
+
+	var placings []Placing
+	for playerID, accountID := range rec.AccountIDs {
+		p := Placing{PlayerID: playerID, AccountID: accountID, Best: best[playerID]}
+		p.Solved = p.Best != nil
+		placings = append(placings, p)
+	}
+	slices.SortFunc(placings, func(a, b Placing) int {
+		if c := compareResults(rec, a.Best, b.Best); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.AccountID, b.AccountID)
+	})
+
+	for i := range placings {
+		placings[i].Rank = i + 1
+		if i > 0 && compareResults(rec, placings[i-1].Best, placings[i].Best) == 0 {
+			placings[i].Rank = placings[i-1].Rank
+		}
+	}
+	return placings
+}
+
+// compareResults orders solutions as the game ranked them. Nil, for no
+// solution, sorts after every solution.
+func compareResults(rec room.GameRecord, a, b *room.PlayerSolution) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return rec.CompareSolutions(a, b)
+}
@@ -0,0 +1,75 @@
+package rating
+
+import (
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+// solution returns a solution of the given length solved at the given offset.
+func solution(playerID string, moves int, at time.Duration) room.PlayerSolution {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	return room.PlayerSolution{PlayerID: playerID, SolvedAt: start.Add(at), Moves: make([]model.BotPosition, moves)}
+}
+
+func TestPlacings(t *testing.T) {
+	rec := room.GameRecord{
+		Solutions: []room.PlayerSolution{
+			solution("p1", 7, 10*time.Second),
+			solution("p2", 5, 30*time.Second),
+			solution("p1", 5, 20*time.Second), // p1's best: beats p2 on time
+			solution("guest", 3, time.Second),
+		},
+		AccountIDs: map[string]string{"p1": "a1", "p2": "a2", "p3": "a3", "p4": "a4"},
+	}
+
+	placings := Placings(rec)
+
+	want := []struct {
+		accountID string
+		rank      int
+		solved    bool
+	}{
+		{"a1", 1, true},
+		{"a2", 2, true},
+		{"a3", 3, false},
+		{"a4", 3, false},
+	}
+	if len(placings) != len(want) {
+		t.Fatalf("expected %d placings, got %d", len(want), len(placings))
+	}
+	for i, w := range want {
+		p := placings[i]
+		if p.AccountID != w.accountID || p.Rank != w.rank || p.Solved != w.solved {
+			t.Errorf("placing %d: expected %+v, got %+v", i, w, p)
+		}
+	}
+	if placings[0].Best.MoveCount() != 5 {
+		t.Errorf("expected a1's best solution of 5 moves, got %d", placings[0].Best.MoveCount())
+	}
+}
+
+func TestPlacings_CountsHandicaps(t *testing.T) {
+	fast := solution("p1", 5, 10*time.Second)
+	slow := solution("p2", 6, 20*time.Second)
+	slow.Handicap = room.Handicap{ExtraMoves: 2}
+	rec := room.GameRecord{
+		Solutions:  []room.PlayerSolution{fast, slow},
+		AccountIDs: map[string]string{"p1": "a1", "p2": "a2"},
+		WinnerID:   "p2",
+	}
+
+	placings := Placings(rec)
+	if placings[0].PlayerID != rec.WinnerID || placings[1].Rank != 2 {
+		t.Errorf("expected the handicapped winner placed first, got %+v", placings)
+	}
+}
+
+func TestPlacings_NoAccounts(t *testing.T) {
+	rec := room.GameRecord{Solutions: []room.PlayerSolution{solution("p1", 5, 0)}}
+	if placings := Placings(rec); len(placings) != 0 {
+		t.Errorf("expected guests to be left out, got %+v", placings)
+	}
+}
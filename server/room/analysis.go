@@ -0,0 +1,137 @@
+package room
+
+import (
+	"slices"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
+// maxOptimalPaths is how many optimal solutions a game's analysis shows.
+const maxOptimalPaths = 3
+
+// GameAnalysis compares each player's best solution to the game's optimal ones,
+// so everyone can learn from the round once it ends.
+type GameAnalysis struct {
+	OptimalMoves int                `json:"optimalMoves"` // 0 if the solver couldn't find it
+	Optimal      []SolutionPath     `json:"optimal"`      // Different optimal solutions
+	Players      []SolutionAnalysis `json:"players"`      // Best solutions, best first
+}
+
+// SolutionPath is a solution with the board rendered to show where each move stops.
+type SolutionPath struct {
+	Moves []MovePayload `json:"moves"`
+	Path  string        `json:"path"`
+}
+
+// SolutionAnalysis compares a player's best solution to the optimal ones.
+// MovesOverOptimal and Divergence are only meaningful if the optimum is known.
+type SolutionAnalysis struct {
+	PlayerID         string       `json:"playerId"`
+	PlayerName       string       `json:"playerName"`
+	Solution         SolutionPath `json:"solution"`
+	MovesOverOptimal int          `json:"movesOverOptimal"`
+	Divergence       int          `json:"divergence"` // Index of the first move that leaves every optimal line, -1 if none does
+}
+
+// ToProto converts a GameAnalysis to its protobuf representation.
+func (a *GameAnalysis) ToProto() *pb.GameAnalysis {
+	out := &pb.GameAnalysis{OptimalMoves: int32(a.OptimalMoves)}
+	for _, p := range a.Optimal {
+		out.Optimal = append(out.Optimal, p.ToProto())
+	}
+	for _, p := range a.Players {
+		out.Players = append(out.Players, &pb.SolutionAnalysis{
+			PlayerId:         p.PlayerID,
+			PlayerName:       p.PlayerName,
+			Solution:         p.Solution.ToProto(),
+			MovesOverOptimal: int32(p.MovesOverOptimal),
+			Divergence:       int32(p.Divergence),
+		})
+	}
+	return out
+}
+
+// ToProto converts a SolutionPath to its protobuf representation.
+func (p SolutionPath) ToProto() *pb.SolutionPath {
+	moves := make([]*pb.BotPos, len(p.Moves))
+	for i, m := range p.Moves {
+		moves[i] = m.ToProto()
+	}
+	return &pb.SolutionPath{Moves: moves, Path: p.Path}
+}
+
+// analyseGame analyses a finished game from its record, or returns nil if the
+// record has no game. It searches within the same limits as the record's
+// optimal move count.
+func analyseGame(rec GameRecord) *GameAnalysis {
+	game := rec.Game
+	if game == nil {
+		return nil
+	}
+
+	a := &GameAnalysis{OptimalMoves: rec.OptimalMoves}
+	if rec.OptimalMoves > 0 {
+		optimal, _ := game.SolveAll(rec.OptimalMoves, solverStateLimit, maxOptimalPaths)
+		for _, moves := range optimal {
+			a.Optimal = append(a.Optimal, newSolutionPath(game, moves))
+		}
+	}
+
+	best := rec.BestSolutions()
+	solutions := make([]*PlayerSolution, 0, len(best))
+	for _, sol := range best {
+		solutions = append(solutions, sol)
+	}
+	slices.SortFunc(solutions, rec.CompareSolutions)
+
+	for _, sol := range solutions {
+		pa := SolutionAnalysis{
+			PlayerID:   sol.PlayerID,
+			PlayerName: rec.PlayerNames[sol.PlayerID],
+			Solution:   newSolutionPath(game, sol.Moves),
+			Divergence: -1,
+		}
+		if rec.OptimalMoves > 0 {
+			pa.MovesOverOptimal = sol.MoveCount() - rec.OptimalMoves
+			pa.Divergence = divergence(game, sol.Moves, rec.OptimalMoves)
+		}
+		a.Players = append(a.Players, pa)
+	}
+	return a
+}
+
+// newSolutionPath renders a solution's path on the game's board.
+func newSolutionPath(game *model.Game, moves []model.BotPosition) SolutionPath {
+	return SolutionPath{Moves: movePayloads(moves), Path: model.RenderPath(game, moves)}
+}
+
+// divergence returns the index of the first move after which the game can no
+// longer be solved in optimal moves in all, or -1 if the moves are optimal.
+func divergence(game *model.Game, moves []model.BotPosition, optimal int) int {
+	state := game
+	for i, m := range moves {
+		next, err := state.MoveBot(m.Id, m.Pos)
+		if err != nil {
+			return i
+		}
+		remaining := optimal - i - 1
+		if remaining < 0 {
+			return i
+		}
+		if _, ok := next.Solve(remaining, solverStateLimit); !ok {
+			return i
+		}
+		state = next
+	}
+	return -1
+}
+
+// movePayloads converts moves to their event payload form.
+func movePayloads(moves []model.BotPosition) []MovePayload {
+	out := make([]MovePayload, len(moves))
+	for i, m := range moves {
+		out[i] = MovePayload{RobotId: int(m.Id), X: int(m.Pos.X), Y: int(m.Pos.Y)}
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+package room
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// cornerGame is a 4x4 empty board where bot 0 must reach the far corner: right
+// then down, or down then right.
+func cornerGame(t *testing.T) *model.Game {
+	t.Helper()
+	game, err := model.NewGame(model.NewBoard(4, nil, nil), map[model.BotId]model.Position{0: {X: 0, Y: 0}}, model.BotPosition{Id: 0, Pos: model.Position{X: 3, Y: 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return game
+}
+
+func TestAnalyseGame(t *testing.T) {
+	game := cornerGame(t)
+	now := time.Now()
+	optimal := []model.BotPosition{model.NewBotPosition(0, 3, 0), model.NewBotPosition(0, 3, 3)}
+	// Right, back left (off every optimal line), then down and right
+	detour := []model.BotPosition{model.NewBotPosition(0, 3, 0), model.NewBotPosition(0, 0, 0), model.NewBotPosition(0, 0, 3), model.NewBotPosition(0, 3, 3)}
+
+	rec := GameRecord{
+		Game: game,
+		Solutions: []PlayerSolution{
+			{PlayerID: "bob", SolvedAt: now, Moves: detour},
+			{PlayerID: "alice", SolvedAt: now.Add(time.Second), Moves: optimal},
+		},
+		PlayerNames:  map[string]string{"alice": "Alice", "bob": "Bob"},
+		OptimalMoves: 2,
+	}
+
+	a := analyseGame(rec)
+	if a.OptimalMoves != 2 || len(a.Optimal) != 2 {
+		t.Fatalf("expected both 2-move solutions, got %+v", a.Optimal)
+	}
+	for _, p := range a.Optimal {
+		if len(p.Moves) != 2 || !strings.Contains(p.Path, " 2:0") {
+			t.Errorf("expected a rendered 2-move path, got %+v", p)
+		}
+	}
+
+	if len(a.Players) != 2 {
+		t.Fatalf("expected 2 player analyses, got %d", len(a.Players))
+	}
+	alice, bob := a.Players[0], a.Players[1]
+	if alice.PlayerID != "alice" || alice.PlayerName != "Alice" {
+		t.Errorf("expected best solution first, got %s", alice.PlayerID)
+	}
+	if alice.MovesOverOptimal != 0 || alice.Divergence != -1 {
+		t.Errorf("expected alice to be optimal, got %+v", alice)
+	}
+	if bob.MovesOverOptimal != 2 || bob.Divergence != 1 {
+		t.Errorf("expected bob 2 over, diverging at move 1, got over %d at %d", bob.MovesOverOptimal, bob.Divergence)
+	}
+	if len(bob.Solution.Moves) != 4 || !strings.Contains(bob.Solution.Path, " 4:0") {
+		t.Errorf("expected bob's rendered path, got %+v", bob.Solution)
+	}
+}
+
+func TestAnalyseGame_BestSolutionPerPlayer(t *testing.T) {
+	game := cornerGame(t)
+	now := time.Now()
+	detour := []model.BotPosition{model.NewBotPosition(0, 0, 3), model.NewBotPosition(0, 0, 0), model.NewBotPosition(0, 3, 0), model.NewBotPosition(0, 3, 3)}
+	optimal := []model.BotPosition{model.NewBotPosition(0, 0, 3), model.NewBotPosition(0, 3, 3)}
+
+	rec := GameRecord{
+		Game: game,
+		Solutions: []PlayerSolution{
+			{PlayerID: "alice", SolvedAt: now, Moves: detour},
+			{PlayerID: "alice", SolvedAt: now.Add(time.Second), Moves: optimal},
+		},
+		OptimalMoves: 2,
+	}
+
+	a := analyseGame(rec)
+	if len(a.Players) != 1 || len(a.Players[0].Solution.Moves) != 2 {
+		t.Errorf("expected alice's 2-move solution only, got %+v", a.Players)
+	}
+}
+
+func TestAnalyseGame_UnknownOptimum(t *testing.T) {
+	game := cornerGame(t)
+	moves := []model.BotPosition{model.NewBotPosition(0, 3, 0), model.NewBotPosition(0, 3, 3)}
+	rec := GameRecord{
+		Game:      game,
+		Solutions: []PlayerSolution{{PlayerID: "alice", SolvedAt: time.Now(), Moves: moves}},
+	}
+
+	a := analyseGame(rec)
+	if len(a.Optimal) != 0 {
+		t.Errorf("expected no optimal solutions, got %+v", a.Optimal)
+	}
+	if len(a.Players) != 1 || a.Players[0].Divergence != -1 || a.Players[0].MovesOverOptimal != 0 {
+		t.Errorf("expected only alice's path, got %+v", a.Players)
+	}
+}
+
+func TestAnalyseGame_NoGame(t *testing.T) {
+	if a := analyseGame(GameRecord{}); a != nil {
+		t.Errorf("expected no analysis, got %+v", a)
+	}
+}
+
+func TestDivergence_InvalidMove(t *testing.T) {
+	game := cornerGame(t)
+	moves := []model.BotPosition{model.NewBotPosition(0, 3, 0), model.NewBotPosition(0, 1, 1)}
+	if d := divergence(game, moves, 2); d != 1 {
+		t.Errorf("expected divergence at the invalid move 1, got %d", d)
+	}
+}
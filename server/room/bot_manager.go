@@ -0,0 +1,156 @@
+package room
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// BotCallback is called when a computer opponent has finished thinking about a
+// game, with its solution, or nil moves if it found none.
+type BotCallback func(roomID, playerID string, game *model.Game, moves []model.BotPosition)
+
+// BotManager plays computer opponents' turns in the background.
+type BotManager interface {
+	// Play solves the game within the level's skill and calls callback once the
+	// bot's think time has passed. Cancels any turn the bot is still playing.
+	Play(roomID, playerID string, level BotLevel, game *model.Game, callback BotCallback)
+
+	// Cancel stops the bot's turn, if any. Its callback won't be called.
+	Cancel(playerID string)
+
+	// StopAll cancels all turns.
+	StopAll()
+
+	// IsPlaying returns true if the bot has a turn in progress (for testing).
+	IsPlaying(playerID string) bool
+}
+
+// botSkill limits how well and how fast a computer opponent plays.
+type botSkill struct {
+	maxMoves  int           // Longest solution the bot looks for
+	maxStates int           // Positions the bot searches before giving up
+	thinkTime time.Duration // Least time before the bot submits
+	perMove   time.Duration // Extra think time for each move of its solution
+}
+
+// botSkills are the skills of each bot level.
+var botSkills = map[BotLevel]botSkill{
+	BotEasy:   {maxMoves: 5, maxStates: 20_000, thinkTime: 20 * time.Second, perMove: 3 * time.Second},
+	BotMedium: {maxMoves: 8, maxStates: 100_000, thinkTime: 10 * time.Second, perMove: 2 * time.Second},
+	BotHard:   {maxMoves: 12, maxStates: 500_000, thinkTime: 5 * time.Second, perMove: time.Second},
+}
+
+// ValidBotLevel reports whether level is a known bot level.
+func ValidBotLevel(level BotLevel) bool {
+	_, ok := botSkills[level]
+	return ok
+}
+
+// thinkDelay returns how long a bot takes to submit a solution of the given
+// number of moves, within a quarter either way so bots don't all finish at once.
+// A bot that found no solution searches as long as its longest solution takes.
+func thinkDelay(skill botSkill, moves int) time.Duration {
+	if moves == 0 {
+		moves = skill.maxMoves
+	}
+	d := skill.thinkTime + time.Duration(moves)*skill.perMove
+	return d*3/4 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// botTurn is a turn in progress. Its timer is set once solving finishes.
+type botTurn struct {
+	timer *time.Timer
+}
+
+// botManager is the concrete implementation of BotManager.
+type botManager struct {
+	mu    sync.Mutex
+	turns map[string]*botTurn // By player ID
+	delay func(skill botSkill, moves int) time.Duration
+}
+
+// NewBotManager creates a new BotManager.
+func NewBotManager() BotManager {
+	return &botManager{
+		turns: make(map[string]*botTurn),
+		delay: thinkDelay,
+	}
+}
+
+func (bm *botManager) Play(roomID, playerID string, level BotLevel, game *model.Game, callback BotCallback) {
+	skill, ok := botSkills[level]
+	if !ok {
+		skill = botSkills[BotMedium]
+	}
+
+	bm.mu.Lock()
+	bm.cancelLocked(playerID)
+	turn := &botTurn{}
+	bm.turns[playerID] = turn
+	bm.mu.Unlock()
+
+	go func() {
+		// Solving takes a while on hard games, so it counts toward the think time
+		started := time.Now()
+		moves, ok := game.Solve(skill.maxMoves, skill.maxStates)
+		if !ok || len(moves) == 0 {
+			moves = nil
+		}
+		delay := bm.delay(skill, len(moves)) - time.Since(started)
+
+		bm.mu.Lock()
+		defer bm.mu.Unlock()
+		if bm.turns[playerID] != turn {
+			return // Cancelled while solving
+		}
+		turn.timer = time.AfterFunc(max(delay, 0), func() {
+			bm.mu.Lock()
+			current := bm.turns[playerID] == turn
+			if current {
+				delete(bm.turns, playerID)
+			}
+			bm.mu.Unlock()
+
+			if current {
+				callback(roomID, playerID, game, moves)
+			}
+		})
+	}()
+}
+
+func (bm *botManager) Cancel(playerID string) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.cancelLocked(playerID)
+}
+
+// cancelLocked stops a bot's turn. Must be called with mu held.
+func (bm *botManager) cancelLocked(playerID string) {
+	if turn, ok := bm.turns[playerID]; ok {
+		if turn.timer != nil {
+			turn.timer.Stop()
+		}
+		delete(bm.turns, playerID)
+	}
+}
+
+func (bm *botManager) StopAll() {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	for id := range bm.turns {
+		bm.cancelLocked(id)
+	}
+}
+
+func (bm *botManager) IsPlaying(playerID string) bool {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	_, ok := bm.turns[playerID]
+	return ok
+}
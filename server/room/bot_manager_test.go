@@ -0,0 +1,139 @@
+package room
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// newInstantBotManager returns a BotManager whose bots don't wait before submitting.
+func newInstantBotManager() *botManager {
+	bm := NewBotManager().(*botManager)
+	bm.delay = func(botSkill, int) time.Duration { return 0 }
+	return bm
+}
+
+func TestBotManager_Play_SubmitsSolution(t *testing.T) {
+	bm := newInstantBotManager()
+	game := model.Game1()
+
+	done := make(chan []model.BotPosition, 1)
+	bm.Play("room1", "bot1", BotHard, game, func(roomID, playerID string, g *model.Game, moves []model.BotPosition) {
+		if roomID != "room1" || playerID != "bot1" || g != game {
+			t.Errorf("callback got wrong args: %s, %s", roomID, playerID)
+		}
+		done <- moves
+	})
+
+	select {
+	case moves := <-done:
+		if valid, _ := game.CheckSolution(moves); !valid {
+			t.Errorf("expected a valid solution, got %v", moves)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected bot to submit")
+	}
+	if bm.IsPlaying("bot1") {
+		t.Error("expected turn to be over after callback")
+	}
+}
+
+func TestBotManager_Play_GivesUpBeyondSkill(t *testing.T) {
+	bm := newInstantBotManager()
+
+	// Game1 takes 7 moves, more than an easy bot looks for
+	done := make(chan []model.BotPosition, 1)
+	bm.Play("room1", "bot1", BotEasy, model.Game1(), func(_, _ string, _ *model.Game, moves []model.BotPosition) {
+		done <- moves
+	})
+
+	select {
+	case moves := <-done:
+		if moves != nil {
+			t.Errorf("expected easy bot to give up, got %v", moves)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected bot to finish")
+	}
+}
+
+func TestBotManager_Cancel(t *testing.T) {
+	bm := newInstantBotManager()
+	bm.delay = func(botSkill, int) time.Duration { return 50 * time.Millisecond }
+
+	var called atomic.Bool
+	bm.Play("room1", "bot1", BotHard, model.Game1(), func(_, _ string, _ *model.Game, _ []model.BotPosition) {
+		called.Store(true)
+	})
+	if !bm.IsPlaying("bot1") {
+		t.Error("expected turn to exist after Play")
+	}
+
+	bm.Cancel("bot1")
+	if bm.IsPlaying("bot1") {
+		t.Error("expected turn to be cancelled")
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	if called.Load() {
+		t.Error("expected cancelled turn not to call back")
+	}
+}
+
+func TestBotManager_Play_ReplacesTurn(t *testing.T) {
+	bm := newInstantBotManager()
+	first, second := model.Game1(), model.Game1()
+
+	done := make(chan *model.Game, 2)
+	callback := func(_, _ string, g *model.Game, _ []model.BotPosition) { done <- g }
+	bm.delay = func(botSkill, int) time.Duration { return 50 * time.Millisecond }
+	bm.Play("room1", "bot1", BotHard, first, callback)
+	bm.Play("room1", "bot1", BotHard, second, callback)
+
+	select {
+	case g := <-done:
+		if g != second {
+			t.Error("expected callback for the latest game only")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected bot to submit")
+	}
+	time.Sleep(200 * time.Millisecond)
+	if len(done) != 0 {
+		t.Error("expected replaced turn not to call back")
+	}
+}
+
+func TestBotManager_StopAll(t *testing.T) {
+	bm := newInstantBotManager()
+	bm.delay = func(botSkill, int) time.Duration { return time.Hour }
+
+	callback := func(_, _ string, _ *model.Game, _ []model.BotPosition) {}
+	bm.Play("room1", "bot1", BotEasy, model.Game1(), callback)
+	bm.Play("room1", "bot2", BotHard, model.Game1(), callback)
+
+	bm.StopAll()
+
+	if bm.IsPlaying("bot1") || bm.IsPlaying("bot2") {
+		t.Error("expected all turns to be stopped")
+	}
+}
+
+func TestThinkDelay(t *testing.T) {
+	skill := botSkills[BotMedium]
+	base := skill.thinkTime + 4*skill.perMove
+	for range 100 {
+		d := thinkDelay(skill, 4)
+		if d < base*3/4 || d > base*5/4 {
+			t.Fatalf("expected delay within a quarter of %v, got %v", base, d)
+		}
+	}
+
+	// Giving up takes as long as the longest solution
+	longest := skill.thinkTime + time.Duration(skill.maxMoves)*skill.perMove
+	if d := thinkDelay(skill, 0); d < longest*3/4 {
+		t.Errorf("expected giving up to take about %v, got %v", longest, d)
+	}
+}
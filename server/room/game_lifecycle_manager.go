@@ -2,6 +2,7 @@ package room
 
 import (
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/srsalisbury/bouncebot/model"
@@ -13,6 +14,10 @@ type GameLifecycle interface {
 	// Returns signals or error.
 	StartGame(room *Room) ([]Signal, error)
 
+	// StartGameWith starts the given game, generated from seed, instead of a
+	// generated one. Returns signals or error.
+	StartGameWith(room *Room, game *model.Game, seed int64) ([]Signal, error)
+
 	// MarkFinishedSolving marks a player as finished solving.
 	// Returns signals or error.
 	MarkFinishedSolving(room *Room, playerID string) ([]Signal, error)
@@ -21,7 +26,7 @@ type GameLifecycle interface {
 	// Returns signals or error.
 	MarkReadyForNext(room *Room, playerID string) ([]Signal, error)
 
-	// EndGame ends the current game and determines the winner.
+	// EndGame ends the current game, determines the winner and records the game.
 	// Returns signals.
 	EndGame(room *Room) []Signal
 
@@ -33,52 +38,84 @@ type GameLifecycle interface {
 // gameLifecycle is the concrete implementation of GameLifecycle.
 type gameLifecycle struct {
 	solutionMgr SolutionManager
+	now         func() time.Time                                        // Clock, replaced when replaying the journal
+	nextGame    func(prev *model.Game, seed int64) (*model.Game, int64) // Game generator, replaced when replaying the journal
 }
 
 // NewGameLifecycle creates a new GameLifecycle.
 // Requires SolutionManager for determining winners.
 func NewGameLifecycle(solutionMgr SolutionManager) GameLifecycle {
-	return &gameLifecycle{solutionMgr: solutionMgr}
+	return &gameLifecycle{solutionMgr: solutionMgr, now: time.Now, nextGame: generateGame}
+}
+
+// generateGame continues from prev, or starts a fully random game if there is none.
+// A zero seed picks a random one. It returns the game with the seed it was
+// generated from.
+func generateGame(prev *model.Game, seed int64) (*model.Game, int64) {
+	if seed == 0 {
+		seed = rand.Int63()
+	}
+	return model.NewContinuationGameFromSeed(prev, seed), seed
+}
+
+// newGame generates the room's next game, continuing from prev. Games in a
+// seeded match are generated from the match seed alone instead, so every room
+// playing it gets the same puzzles whoever wins each game.
+func (gl *gameLifecycle) newGame(room *Room, prev *model.Game) (*model.Game, int64) {
+	if m := room.Match; m.InProgress() && m.Seed != 0 {
+		return gl.nextGame(nil, m.roundSeed())
+	}
+	return gl.nextGame(prev, 0)
 }
 
 func (gl *gameLifecycle) StartGame(room *Room) ([]Signal, error) {
+	return gl.StartGameWith(room, nil, 0)
+}
+
+func (gl *gameLifecycle) StartGameWith(room *Room, game *model.Game, seed int64) ([]Signal, error) {
 	// If there was a previous game with solutions, determine and record the winner
-	// and get the final game state from the winning solution
+	// (unless EndGame already did) and get the final game state from the winning solution
+	now := gl.now()
 	var winningGameState *model.Game
+	var recorded []Signal
 	if room.CurrentGame != nil && len(room.Solutions) > 0 {
 		winningSolution := gl.solutionMgr.GetWinningSolution(room.Solutions)
-		if winningSolution != nil {
-			room.Wins[winningSolution.PlayerID]++
-			// Apply winning moves to get final robot positions
-			if len(winningSolution.Moves) > 0 {
-				_, winningGameState = room.CurrentGame.CheckSolution(winningSolution.Moves)
+		// Apply winning moves to get final robot positions
+		if winningSolution != nil && len(winningSolution.Moves) > 0 {
+			_, winningGameState = room.CurrentGame.CheckSolution(winningSolution.Moves)
+		}
+		if !room.gameRecorded() {
+			if winningSolution != nil {
+				room.creditWin(winningSolution.PlayerID)
 			}
+			room.GamesPlayed++
+			rec := newGameRecord(room, winningSolution, now, gl.solutionMgr.HintPenalty())
+			room.recordGame(rec)
+			recorded = append(recorded, GameRecordedSignal{RoomID: room.ID, Record: rec})
+			recorded = append(recorded, creditMatchGame(room, rec.WinnerID, now)...)
 		}
-		room.GamesPlayed++
 	}
 
-	// Generate game
-	var game *model.Game
-	if winningGameState != nil {
-		// Continue from winning game state: same board, robots at final positions
-		game = model.NewContinuationGame(winningGameState)
-	} else if room.CurrentGame != nil {
-		// No winning solution with moves, continue from existing game
-		game = model.NewContinuationGame(room.CurrentGame)
-	} else {
-		// First game: fully random
-		game = model.NewRandomGame()
+	// Unless a game was given, generate one: continue from the winning game state
+	// (same board, robots at final positions), else from the existing game, else
+	// fully random
+	if game == nil {
+		prev := room.CurrentGame
+		if winningGameState != nil {
+			prev = winningGameState
+		}
+		game, seed = gl.newGame(room, prev)
 	}
-	now := time.Now()
 
 	room.CurrentGame = game
+	room.GameSeed = seed
 	room.GameStartedAt = &now
 	room.LastActivityAt = now
 	room.ClearGameState()
+	startMatchGame(room)
 
-	signals := []Signal{
-		BroadcastSignal{Event: GameStartedEvent{RoomID: room.ID}},
-	}
+	signals := append(recorded, BroadcastSignal{Event: GameStartedEvent{RoomID: room.ID, Game: game}})
+	signals = append(signals, botTurns(room)...)
 
 	return signals, nil
 }
@@ -93,13 +130,14 @@ func (gl *gameLifecycle) MarkFinishedSolving(room *Room, playerID string) ([]Sig
 		return nil, fmt.Errorf("player not found: %s", playerID)
 	}
 
-	room.LastActivityAt = time.Now()
+	room.LastActivityAt = gl.now()
 
 	// Check if already finished
 	if containsString(room.FinishedSolving, playerID) {
 		return nil, nil
 	}
 
+	ended := room.quorum(room.FinishedSolving)
 	room.FinishedSolving = append(room.FinishedSolving, playerID)
 
 	signals := []Signal{
@@ -109,8 +147,9 @@ func (gl *gameLifecycle) MarkFinishedSolving(room *Room, playerID string) ([]Sig
 		}},
 	}
 
-	// Check if all players are finished -> signal end game
-	if len(room.FinishedSolving) == len(room.Players) {
+	// Check if all human players are now finished -> signal end game. A bot
+	// finishing after the game ended must not end it again.
+	if !ended && room.quorum(room.FinishedSolving) {
 		signals = append(signals, EndGameSignal{RoomID: room.ID})
 	}
 
@@ -123,13 +162,14 @@ func (gl *gameLifecycle) MarkReadyForNext(room *Room, playerID string) ([]Signal
 		return nil, fmt.Errorf("player not found: %s", playerID)
 	}
 
-	room.LastActivityAt = time.Now()
+	room.LastActivityAt = gl.now()
 
 	// Check if already ready
 	if containsString(room.ReadyForNext, playerID) {
 		return nil, nil
 	}
 
+	started := room.quorum(room.ReadyForNext)
 	room.ReadyForNext = append(room.ReadyForNext, playerID)
 
 	signals := []Signal{
@@ -139,8 +179,8 @@ func (gl *gameLifecycle) MarkReadyForNext(room *Room, playerID string) ([]Signal
 		}},
 	}
 
-	// Check if all players are ready -> signal start next game
-	if len(room.ReadyForNext) == len(room.Players) {
+	// Check if all human players are now ready -> signal start next game
+	if !started && room.quorum(room.ReadyForNext) {
 		signals = append(signals, StartNextGameSignal{RoomID: room.ID})
 	}
 
@@ -148,28 +188,25 @@ func (gl *gameLifecycle) MarkReadyForNext(room *Room, playerID string) ([]Signal
 }
 
 func (gl *gameLifecycle) EndGame(room *Room) []Signal {
-	// Credit the win and increment games played
+	// Credit the win, to the winner's team too, and increment games played
 	winner := gl.solutionMgr.GetWinningSolution(room.Solutions)
 	if winner != nil {
-		room.Wins[winner.PlayerID]++
+		room.creditWin(winner.PlayerID)
 	}
 	room.GamesPlayed++
+	now := gl.now()
+	rec := newGameRecord(room, winner, now, gl.solutionMgr.HintPenalty())
+	room.recordGame(rec)
 
-	// Build game ended event
+	// Build game ended event. The analysis of everyone's best solution follows
+	// it, once the game is analysed outside the room lock.
 	var winnerID, winnerName string
 	var moves []MovePayload
 
 	if winner != nil {
 		winnerID = winner.PlayerID
 		winnerName = room.GetPlayerName(winner.PlayerID)
-		moves = make([]MovePayload, len(winner.Moves))
-		for i, move := range winner.Moves {
-			moves[i] = MovePayload{
-				RobotId: int(move.Id),
-				X:       int(move.Pos.X),
-				Y:       int(move.Pos.Y),
-			}
-		}
+		moves = movePayloads(winner.Moves)
 	}
 
 	signals := []Signal{
@@ -179,6 +216,13 @@ func (gl *gameLifecycle) EndGame(room *Room) []Signal {
 			WinnerName: winnerName,
 			Moves:      moves,
 		}},
+		GameRecordedSignal{RoomID: room.ID, Record: rec, Analyse: true},
+	}
+	signals = append(signals, creditMatchGame(room, rec.WinnerID, now)...)
+	for _, p := range room.Players {
+		if p.IsBot() {
+			signals = append(signals, BotReadySignal{RoomID: room.ID, PlayerID: p.ID})
+		}
 	}
 
 	return signals
@@ -198,23 +242,34 @@ func (gl *gameLifecycle) StartNextGame(room *Room) []Signal {
 	}
 
 	// Generate next game
-	var game *model.Game
+	prev := room.CurrentGame
 	if winningGameState != nil {
-		game = model.NewContinuationGame(winningGameState)
-	} else if room.CurrentGame != nil {
-		game = model.NewContinuationGame(room.CurrentGame)
-	} else {
-		game = model.NewRandomGame()
+		prev = winningGameState
 	}
-	now := time.Now()
+	game, seed := gl.newGame(room, prev)
+	now := gl.now()
 
 	room.CurrentGame = game
+	room.GameSeed = seed
 	room.GameStartedAt = &now
 	room.ClearGameState()
+	startMatchGame(room)
 
 	signals := []Signal{
-		BroadcastSignal{Event: GameStartedEvent{RoomID: room.ID}},
+		BroadcastSignal{Event: GameStartedEvent{RoomID: room.ID, Game: game}},
 	}
+	signals = append(signals, botTurns(room)...)
+
+	return signals
+}
 
+// botTurns returns a signal for each computer opponent to play the current game.
+func botTurns(room *Room) []Signal {
+	var signals []Signal
+	for _, p := range room.Players {
+		if p.IsBot() {
+			signals = append(signals, BotTurnSignal{RoomID: room.ID, PlayerID: p.ID, Level: p.Bot, Game: room.CurrentGame})
+		}
+	}
 	return signals
 }
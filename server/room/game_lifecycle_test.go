@@ -45,6 +45,52 @@ func TestGameLifecycle_StartGame(t *testing.T) {
 	}
 }
 
+func TestGameLifecycle_StartGame_RecordsSeed(t *testing.T) {
+	gl := NewGameLifecycle(NewSolutionManager())
+	room := &Room{
+		ID:      "TEST",
+		Players: []Player{{ID: "alice", Name: "Alice", Status: PlayerStatusConnected}},
+		Wins:    map[string]int{},
+	}
+
+	gl.StartGame(room)
+
+	// The first game is fully random, so its seed regenerates it
+	if !room.CurrentGame.Equals(model.NewRandomGameFromSeed(room.GameSeed)) {
+		t.Errorf("expected game to be generated from seed %d", room.GameSeed)
+	}
+}
+
+func TestGameLifecycle_StartGameWith(t *testing.T) {
+	gl := NewGameLifecycle(NewSolutionManager())
+	room := &Room{
+		ID:      "TEST",
+		Players: []Player{{ID: "alice", Name: "Alice", Status: PlayerStatusConnected}},
+		Wins:    map[string]int{},
+	}
+	gl.StartGame(room)
+
+	game := model.NewRandomGameFromSeed(42)
+	signals, err := gl.StartGameWith(room, game, 42)
+	if err != nil {
+		t.Fatalf("StartGameWith failed: %v", err)
+	}
+
+	if room.CurrentGame != game || room.GameSeed != 42 {
+		t.Errorf("expected the given game and seed, got seed %d", room.GameSeed)
+	}
+	if len(signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(signals))
+	}
+	broadcast, ok := signals[0].(BroadcastSignal)
+	if !ok {
+		t.Fatalf("expected BroadcastSignal, got %T", signals[0])
+	}
+	if started, ok := broadcast.Event.(GameStartedEvent); !ok || started.Game != game {
+		t.Errorf("expected GameStartedEvent with the given game, got %+v", broadcast.Event)
+	}
+}
+
 func TestGameLifecycle_StartGame_ClearsGameState(t *testing.T) {
 	sm := NewSolutionManager()
 	gl := NewGameLifecycle(sm)
@@ -325,6 +371,37 @@ func TestGameLifecycle_MarkReadyForNext_TriggersNextGame(t *testing.T) {
 	}
 }
 
+func TestGameLifecycle_StartGame_AfterEndGame(t *testing.T) {
+	sm := NewSolutionManager()
+	gl := NewGameLifecycle(sm)
+
+	startedAt := time.Now()
+	room := &Room{
+		ID:            "TEST",
+		Players:       []Player{{ID: "alice", Name: "Alice"}},
+		CurrentGame:   model.Game1(),
+		GameStartedAt: &startedAt,
+		Wins:          map[string]int{},
+		Solutions:     []PlayerSolution{{PlayerID: "alice", SolvedAt: time.Now(), Moves: model.Game1Solution()}},
+	}
+	gl.EndGame(room)
+
+	// After a restart the recorded game is a copy of the current one
+	room.History[0].Game = model.Game1()
+	startedAt = startedAt.Round(0)
+	room.GameStartedAt = &startedAt
+
+	signals, _ := gl.StartGame(room)
+	for _, s := range signals {
+		if _, ok := s.(GameRecordedSignal); ok {
+			t.Error("expected no GameRecordedSignal for a game EndGame already recorded")
+		}
+	}
+	if len(room.History) != 1 || room.GamesPlayed != 1 || room.Wins["alice"] != 1 {
+		t.Errorf("expected the game counted once, got %d records, %d played, %d wins", len(room.History), room.GamesPlayed, room.Wins["alice"])
+	}
+}
+
 func TestGameLifecycle_EndGame(t *testing.T) {
 	sm := NewSolutionManager()
 	gl := NewGameLifecycle(sm)
@@ -353,9 +430,13 @@ func TestGameLifecycle_EndGame(t *testing.T) {
 		t.Errorf("expected 1 game played, got %d", room.GamesPlayed)
 	}
 
-	// Check GameEndedEvent
-	if len(signals) != 1 {
-		t.Errorf("expected 1 signal, got %d", len(signals))
+	// Check GameEndedEvent, then the recorded game
+	if len(signals) != 2 {
+		t.Fatalf("expected 2 signals, got %d", len(signals))
+	}
+	recorded, ok := signals[1].(GameRecordedSignal)
+	if !ok || recorded.RoomID != "TEST" || recorded.Record.WinnerID != "bob" || !recorded.Analyse {
+		t.Errorf("expected GameRecordedSignal with winner bob, to be analysed, got %+v", signals[1])
 	}
 	broadcast, ok := signals[0].(BroadcastSignal)
 	if !ok {
@@ -373,6 +454,106 @@ func TestGameLifecycle_EndGame(t *testing.T) {
 	}
 }
 
+// A team win counts for the team, but only the player whose solution won is
+// credited with it: teammates get no win in the room, the match or the record.
+func TestGameLifecycle_EndGame_CreditsTeam(t *testing.T) {
+	gl := NewGameLifecycle(NewSolutionManager())
+	room := teamRoom()
+	room.Players[1].Team = "Blue"
+	room.Players[3].Team = "Blue"
+	room.Players[3].AccountID = "a-dave"
+	room.Match = &Match{Rounds: 3}
+	room.CurrentGame = model.Game1()
+	room.Solutions = []PlayerSolution{{PlayerID: "bob", SolvedAt: time.Now(), Moves: make([]model.BotPosition, 5)}}
+
+	signals := gl.EndGame(room)
+
+	if room.Wins["bob"] != 1 || room.TeamWins["Blue"] != 1 {
+		t.Errorf("expected bob and Blue credited, got wins %v and team wins %v", room.Wins, room.TeamWins)
+	}
+	if room.Wins["dave"] != 0 || room.Match.Wins["dave"] != 0 {
+		t.Errorf("expected no win for teammate dave, got wins %v and match wins %v", room.Wins, room.Match.Wins)
+	}
+	recorded := signals[1].(GameRecordedSignal).Record
+	if recorded.WinnerID != "bob" || recorded.AccountIDs["dave"] != "a-dave" {
+		t.Errorf("expected a record won by bob that dave played, got winner %s and accounts %v", recorded.WinnerID, recorded.AccountIDs)
+	}
+}
+
+func TestGameLifecycle_EndGame_EndsMatch(t *testing.T) {
+	gl := NewGameLifecycle(NewSolutionManager())
+	room := teamRoom()
+	room.Match = &Match{FirstTo: 1}
+	room.CurrentGame = model.Game1()
+	room.Solutions = []PlayerSolution{{PlayerID: "bob", SolvedAt: time.Now(), Moves: make([]model.BotPosition, 5)}}
+
+	signals := gl.EndGame(room)
+
+	if room.Match.InProgress() || room.Match.ChampionID != "bob" {
+		t.Errorf("expected bob to win the match, got %+v", room.Match)
+	}
+	var over *MatchOverEvent
+	for _, sig := range signals {
+		if b, ok := sig.(BroadcastSignal); ok {
+			if e, ok := b.Event.(MatchOverEvent); ok {
+				over = &e
+			}
+		}
+	}
+	if over == nil || over.ChampionName != "Bob" || over.GamesPlayed != 1 {
+		t.Errorf("expected match over with Bob champion after 1 game, got %+v", over)
+	}
+}
+
+// A seeded match generates each game from the match seed alone, so rooms
+// playing it get the same games whatever their winners did.
+func TestGameLifecycle_SeededMatch(t *testing.T) {
+	gl := NewGameLifecycle(NewSolutionManager())
+	a, b := teamRoom(), teamRoom()
+	for _, room := range []*Room{a, b} {
+		room.Match = &Match{Rounds: 2, Seed: 500}
+		gl.StartGame(room)
+	}
+	if a.GameSeed != 500 || !a.CurrentGame.Equals(b.CurrentGame) {
+		t.Fatalf("expected both rooms to start the game seeded 500, got seeds %d and %d", a.GameSeed, b.GameSeed)
+	}
+
+	// Different winners, same next game
+	a.Solutions = []PlayerSolution{{PlayerID: "alice", SolvedAt: time.Now(), Moves: validSolution()}}
+	gl.EndGame(a)
+	gl.EndGame(b)
+	gl.StartNextGame(a)
+	gl.StartNextGame(b)
+	if a.GameSeed != 501 || !a.CurrentGame.Equals(b.CurrentGame) {
+		t.Errorf("expected both rooms to play the game seeded 501, got seeds %d and %d", a.GameSeed, b.GameSeed)
+	}
+
+	// Once the match is over, games continue as usual
+	gl.EndGame(a)
+	gl.StartNextGame(a)
+	if a.Match.InProgress() || a.GameSeed == 502 {
+		t.Errorf("expected an unseeded game after the match, got seed %d", a.GameSeed)
+	}
+}
+
+// Skipping a seeded match game nobody solved moves on to the next puzzle
+// rather than replaying the same one.
+func TestGameLifecycle_SeededMatch_SkipGame(t *testing.T) {
+	gl := NewGameLifecycle(NewSolutionManager())
+	room := teamRoom()
+	room.Match = &Match{Rounds: 2, Seed: 500}
+	gl.StartGame(room)
+	skipped := room.CurrentGame
+
+	gl.StartGame(room)
+	if room.GameSeed != 501 || room.CurrentGame.Equals(skipped) {
+		t.Errorf("expected the game seeded 501 after skipping, got seed %d", room.GameSeed)
+	}
+	if room.Match.GamesPlayed != 0 || room.Match.GamesStarted != 2 {
+		t.Errorf("expected 2 games started and none played, got %+v", room.Match)
+	}
+}
+
 func TestGameLifecycle_EndGame_NoSolutions(t *testing.T) {
 	sm := NewSolutionManager()
 	gl := NewGameLifecycle(sm)
@@ -393,9 +574,12 @@ func TestGameLifecycle_EndGame_NoSolutions(t *testing.T) {
 		t.Errorf("expected 1 game played, got %d", room.GamesPlayed)
 	}
 
-	// Check GameEndedEvent with no winner
-	if len(signals) != 1 {
-		t.Fatalf("expected 1 signal, got %d", len(signals))
+	// Check GameEndedEvent with no winner, then the recorded game
+	if len(signals) != 2 {
+		t.Fatalf("expected 2 signals, got %d", len(signals))
+	}
+	if _, ok := signals[1].(GameRecordedSignal); !ok {
+		t.Errorf("expected GameRecordedSignal, got %T", signals[1])
 	}
 	broadcast := signals[0].(BroadcastSignal)
 	event := broadcast.Event.(GameEndedEvent)
@@ -451,3 +635,117 @@ func TestGameLifecycle_StartNextGame(t *testing.T) {
 		t.Error("expected GameStartedEvent")
 	}
 }
+
+func TestGameLifecycle_MarkFinishedSolving_IgnoresBots(t *testing.T) {
+	sm := NewSolutionManager()
+	gl := NewGameLifecycle(sm)
+
+	room := &Room{
+		ID:          "TEST",
+		Players:     []Player{{ID: "alice", Name: "Alice"}, {ID: "bot", Name: "Easy Bot", Bot: BotEasy}},
+		CurrentGame: model.Game1(),
+	}
+
+	// Alice is the only person, so her finishing ends the game
+	signals, err := gl.MarkFinishedSolving(room, "alice")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !hasSignal[EndGameSignal](signals) {
+		t.Error("expected EndGameSignal without waiting for the bot")
+	}
+
+	// The bot finishing late must not end the game again
+	signals, err = gl.MarkFinishedSolving(room, "bot")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hasSignal[EndGameSignal](signals) {
+		t.Error("expected no second EndGameSignal when a bot finishes after the game ended")
+	}
+}
+
+func TestGameLifecycle_MarkReadyForNext_IgnoresBots(t *testing.T) {
+	sm := NewSolutionManager()
+	gl := NewGameLifecycle(sm)
+
+	room := &Room{
+		ID:      "TEST",
+		Players: []Player{{ID: "bot", Name: "Hard Bot", Bot: BotHard}, {ID: "alice", Name: "Alice"}},
+	}
+
+	// A bot alone never reaches a quorum
+	signals, _ := gl.MarkReadyForNext(room, "bot")
+	if hasSignal[StartNextGameSignal](signals) {
+		t.Error("expected no StartNextGameSignal before any person is ready")
+	}
+
+	signals, _ = gl.MarkReadyForNext(room, "alice")
+	if !hasSignal[StartNextGameSignal](signals) {
+		t.Error("expected StartNextGameSignal once every person is ready")
+	}
+}
+
+func TestGameLifecycle_StartGame_SignalsBotTurns(t *testing.T) {
+	sm := NewSolutionManager()
+	gl := NewGameLifecycle(sm)
+
+	room := &Room{
+		ID:      "TEST",
+		Players: []Player{{ID: "alice", Name: "Alice"}, {ID: "bot", Name: "Hard Bot", Bot: BotHard}},
+		Wins:    make(map[string]int),
+	}
+
+	signals, err := gl.StartGame(room)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var turns []BotTurnSignal
+	for _, sig := range signals {
+		if turn, ok := sig.(BotTurnSignal); ok {
+			turns = append(turns, turn)
+		}
+	}
+	if len(turns) != 1 {
+		t.Fatalf("expected 1 BotTurnSignal, got %d", len(turns))
+	}
+	if turns[0].PlayerID != "bot" || turns[0].Level != BotHard || turns[0].Game != room.CurrentGame {
+		t.Errorf("unexpected BotTurnSignal: %+v", turns[0])
+	}
+
+	if !hasSignal[BotTurnSignal](gl.StartNextGame(room)) {
+		t.Error("expected StartNextGame to signal the bot's turn")
+	}
+}
+
+func TestGameLifecycle_EndGame_SignalsBotsReady(t *testing.T) {
+	sm := NewSolutionManager()
+	gl := NewGameLifecycle(sm)
+
+	room := &Room{
+		ID:          "TEST",
+		Players:     []Player{{ID: "alice", Name: "Alice"}, {ID: "bot", Name: "Easy Bot", Bot: BotEasy}},
+		CurrentGame: model.Game1(),
+		Wins:        make(map[string]int),
+	}
+
+	var ready []string
+	for _, sig := range gl.EndGame(room) {
+		if r, ok := sig.(BotReadySignal); ok {
+			ready = append(ready, r.PlayerID)
+		}
+	}
+	if len(ready) != 1 || ready[0] != "bot" {
+		t.Errorf("expected only the bot to be signalled ready, got %v", ready)
+	}
+}
+
+// hasSignal reports whether signals include one of type S.
+func hasSignal[S Signal](signals []Signal) bool {
+	for _, sig := range signals {
+		if _, ok := sig.(S); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrHandicapsLocked is returned when a handicap is changed while a game is in
+// progress, which would score players' solutions in the same game differently.
+var ErrHandicapsLocked = errors.New("handicaps can only change between games")
+
+// Handicap evens out games between players of different skill. It only changes
+// how GetWinningSolution ranks solutions; move counts and times are reported as played.
+type Handicap struct {
+	ExtraMoves int           // Moves taken off the player's solutions
+	Delay      time.Duration // Added to the player's solve times
+}
+
+// IsZero reports whether the handicap changes nothing.
+func (h Handicap) IsZero() bool {
+	return h == Handicap{}
+}
+
+// ToProto converts a Handicap to its protobuf representation, or returns nil
+// for no handicap.
+func (h Handicap) ToProto() *pb.Handicap {
+	if h.IsZero() {
+		return nil
+	}
+	return &pb.Handicap{ExtraMoves: int32(h.ExtraMoves), Delay: durationpb.New(h.Delay)}
+}
+
+// HandicapFromProto converts a protobuf Handicap, which may be nil for none.
+func HandicapFromProto(h *pb.Handicap) Handicap {
+	return Handicap{ExtraMoves: int(h.GetExtraMoves()), Delay: h.GetDelay().AsDuration()}
+}
+
+// playerHandicap returns the handicap of the player with the given ID, or none
+// if not found.
+func (r *Room) playerHandicap(playerID string) Handicap {
+	if i := r.FindPlayerIndex(playerID); i != -1 {
+		return r.Players[i].Handicap
+	}
+	return Handicap{}
+}
+
+// HandicapManager sets players' handicaps. Each solution records its player's
+// handicap when submitted, and GetWinningSolution scores it from there.
+type HandicapManager interface {
+	// SetHandicap sets a player's handicap; a zero Handicap removes it.
+	// Returns signals or error.
+	SetHandicap(room *Room, playerID string, handicap Handicap) ([]Signal, error)
+}
+
+// handicapManager is the concrete implementation of HandicapManager.
+type handicapManager struct {
+	now func() time.Time // Clock, replaced when replaying the journal
+}
+
+// NewHandicapManager creates a new HandicapManager.
+func NewHandicapManager() HandicapManager {
+	return &handicapManager{now: time.Now}
+}
+
+func (hm *handicapManager) SetHandicap(room *Room, playerID string, handicap Handicap) ([]Signal, error) {
+	if handicap.ExtraMoves < 0 || handicap.Delay < 0 {
+		return nil, fmt.Errorf("handicap must not be negative")
+	}
+	idx := room.FindPlayerIndex(playerID)
+	if idx == -1 {
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+	if !room.betweenGames() {
+		return nil, ErrHandicapsLocked
+	}
+
+	room.Players[idx].Handicap = handicap
+	room.LastActivityAt = hm.now()
+
+	return []Signal{
+		BroadcastSignal{Event: HandicapChangedEvent{RoomID: room.ID, PlayerID: playerID, Handicap: handicap}},
+	}, nil
+}
@@ -0,0 +1,65 @@
+package room
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+func TestHandicapManager_SetHandicap(t *testing.T) {
+	hm := NewHandicapManager()
+	room := teamRoom()
+	handicap := Handicap{ExtraMoves: 2, Delay: 5 * time.Second}
+
+	signals, err := hm.SetHandicap(room, "bob", handicap)
+	if err != nil {
+		t.Fatalf("SetHandicap failed: %v", err)
+	}
+	if room.Players[1].Handicap != handicap {
+		t.Errorf("expected bob's handicap %+v, got %+v", handicap, room.Players[1].Handicap)
+	}
+	event, ok := signals[0].(BroadcastSignal).Event.(HandicapChangedEvent)
+	if !ok || event.PlayerID != "bob" || event.Handicap != handicap {
+		t.Errorf("expected HandicapChangedEvent for bob, got %+v", signals)
+	}
+
+	// A zero handicap removes it
+	if _, err := hm.SetHandicap(room, "bob", Handicap{}); err != nil || !room.Players[1].Handicap.IsZero() {
+		t.Errorf("expected bob's handicap removed, got %+v (%v)", room.Players[1].Handicap, err)
+	}
+}
+
+func TestHandicapManager_SetHandicap_Invalid(t *testing.T) {
+	hm := NewHandicapManager()
+
+	if _, err := hm.SetHandicap(teamRoom(), "nobody", Handicap{ExtraMoves: 1}); err == nil {
+		t.Error("expected error for an unknown player")
+	}
+	if _, err := hm.SetHandicap(teamRoom(), "bob", Handicap{ExtraMoves: -1}); err == nil {
+		t.Error("expected error for negative extra moves")
+	}
+	if _, err := hm.SetHandicap(teamRoom(), "bob", Handicap{Delay: -time.Second}); err == nil {
+		t.Error("expected error for a negative delay")
+	}
+
+	room := teamRoom()
+	room.CurrentGame = model.Game1()
+	if _, err := hm.SetHandicap(room, "bob", Handicap{ExtraMoves: 1}); !errors.Is(err, ErrHandicapsLocked) {
+		t.Errorf("expected ErrHandicapsLocked during a game, got %v", err)
+	}
+}
+
+func TestHandicap_ToProto(t *testing.T) {
+	if p := (Handicap{}).ToProto(); p != nil {
+		t.Errorf("expected no proto for no handicap, got %v", p)
+	}
+	h := Handicap{ExtraMoves: 3, Delay: 1500 * time.Millisecond}
+	if got := HandicapFromProto(h.ToProto()); got != h {
+		t.Errorf("expected %+v after a round trip, got %+v", h, got)
+	}
+	if got := HandicapFromProto(nil); !got.IsZero() {
+		t.Errorf("expected no handicap from nil, got %+v", got)
+	}
+}
@@ -1,18 +1,32 @@
 package room
 
-import "github.com/srsalisbury/bouncebot/model"
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
 
 // mockBroadcaster implements EventBroadcaster for testing
 type mockBroadcaster struct {
 	gameEndedCalled         bool
+	gameAnalysed            *GameAnalysis
 	gameStartedCalled       bool
 	playerSolvedCalled      bool
 	solutionRetractedCalled bool
+	spectatorJoinedCalled   bool
+	spectatorLeftCalled     bool
+	teamsChangedCalled      bool
+	matchStartedCalled      bool
+	matchChampionIDs        []string
+	handicapChanged         map[string]Handicap
+	roomClosedIDs           []string
 }
 
 func (m *mockBroadcaster) BroadcastPlayerJoined(roomID, playerID, playerName string) {}
 func (m *mockBroadcaster) BroadcastPlayerLeft(roomID, playerID string)               {}
-func (m *mockBroadcaster) BroadcastGameStarted(roomID string)                         { m.gameStartedCalled = true }
+func (m *mockBroadcaster) BroadcastGameStarted(roomID string, game *model.Game)       { m.gameStartedCalled = true }
 func (m *mockBroadcaster) BroadcastPlayerFinishedSolving(roomID, playerID string)     {}
 func (m *mockBroadcaster) BroadcastPlayerReadyForNext(roomID, playerID string)        {}
 func (m *mockBroadcaster) BroadcastPlayerSolved(roomID, playerID string, moveCount int) {
@@ -24,8 +38,220 @@ func (m *mockBroadcaster) BroadcastSolutionRetracted(roomID, playerID string) {
 func (m *mockBroadcaster) BroadcastGameEnded(roomID, winnerID, winnerName string, moves []MovePayload) {
 	m.gameEndedCalled = true
 }
+func (m *mockBroadcaster) BroadcastGameAnalysed(roomID string, analysis *GameAnalysis) {
+	m.gameAnalysed = analysis
+}
+func (m *mockBroadcaster) BroadcastSpectatorJoined(roomID, spectatorID, spectatorName string) {
+	m.spectatorJoinedCalled = true
+}
+func (m *mockBroadcaster) BroadcastSpectatorLeft(roomID, spectatorID string) {
+	m.spectatorLeftCalled = true
+}
+func (m *mockBroadcaster) BroadcastTeamsChanged(roomID string, teams map[string]string, teamQuorum bool) {
+	m.teamsChangedCalled = true
+}
+func (m *mockBroadcaster) BroadcastMatchStarted(roomID string, rounds, firstTo int, seed int64) {
+	m.matchStartedCalled = true
+}
+func (m *mockBroadcaster) BroadcastMatchOver(roomID, championID, championName string, gamesPlayed int, standings []MatchStanding) {
+	m.matchChampionIDs = append(m.matchChampionIDs, championID)
+}
+func (m *mockBroadcaster) BroadcastHandicapChanged(roomID, playerID string, handicap Handicap) {
+	if m.handicapChanged == nil {
+		m.handicapChanged = make(map[string]Handicap)
+	}
+	m.handicapChanged[playerID] = handicap
+}
+func (m *mockBroadcaster) BroadcastRoomClosed(roomID string) {
+	m.roomClosedIDs = append(m.roomClosedIDs, roomID)
+}
 
 // validSolution returns model.Game1Solution for convenience.
 func validSolution() []model.BotPosition {
 	return model.Game1Solution()
 }
+
+// fullRoom returns a room with every persisted field set.
+func fullRoom(id string) *Room {
+	now := time.Now()
+	started := now.Add(-time.Minute)
+	first := PlayerSolution{PlayerID: "p1", SolvedAt: now.Add(-30 * time.Second), Moves: []model.BotPosition{
+		model.NewBotPosition(0, 1, 2),
+		model.NewBotPosition(1, 3, 4),
+		model.NewBotPosition(0, 5, 6),
+	}}
+	best := PlayerSolution{PlayerID: "p1", SolvedAt: now.Add(-10 * time.Second), Hints: HintFirstMove, Moves: []model.BotPosition{
+		model.NewBotPosition(0, 5, 6),
+	}}
+	other := PlayerSolution{PlayerID: "p2", SolvedAt: now, Handicap: Handicap{ExtraMoves: 1, Delay: 3 * time.Second}, Moves: []model.BotPosition{
+		model.NewBotPosition(2, 7, 8),
+		model.NewBotPosition(0, 5, 6),
+	}}
+
+	return &Room{
+		ID: id,
+		Players: []Player{
+			{ID: "p1", AccountID: "a1", Name: "Alice", Status: PlayerStatusConnected, Team: "Red"},
+			{ID: "p2", Name: "Bob", Status: PlayerStatusDisconnected, DisconnectedAt: now, Handicap: Handicap{ExtraMoves: 1, Delay: 3 * time.Second}},
+			{ID: "p3", Name: "Hard Bot", Status: PlayerStatusConnected, Bot: BotHard, Team: "Blue"},
+		},
+		CreatedAt:      now.Add(-time.Hour),
+		LastActivityAt: now,
+		CurrentGame:    model.Game1(),
+		GameSeed:       1234,
+		GameStartedAt:  &started,
+		Solutions:      []PlayerSolution{best, other},
+		SolutionLog: []SolutionEvent{
+			{PlayerID: "p1", At: first.SolvedAt, Moves: first.Moves},
+			{PlayerID: "p1", At: best.SolvedAt, Moves: best.Moves},
+			{PlayerID: "p1", At: best.SolvedAt.Add(time.Second), Retracted: true, Moves: best.Moves},
+			{PlayerID: "p2", At: other.SolvedAt, Moves: other.Moves},
+		},
+		SolutionHistory: []PlayerSolutionHistory{
+			{PlayerID: "p1", Solutions: []PlayerSolution{first, best}},
+			{PlayerID: "p2", Solutions: []PlayerSolution{other}},
+		},
+		Wins:            map[string]int{"p1": 2, "gone": 1},
+		GamesPlayed:     3,
+		FinishedSolving: []string{"p2"},
+		ReadyForNext:    []string{},
+		Hints:           map[string]HintTier{"p1": HintFirstMove},
+		TeamWins:        map[string]int{"Red": 2, "Green": 1},
+		TeamQuorum:      true,
+		Match:           &Match{Rounds: 5, FirstTo: 3, Seed: 77, StartedAt: started, GamesPlayed: 1, GamesStarted: 2, Wins: map[string]int{"p2": 1}},
+		History: []GameRecord{{
+			Game:         model.Game1(),
+			Seed:         99,
+			StartedAt:    &started,
+			EndedAt:      now,
+			Solutions:    []PlayerSolution{first, other},
+			SolutionLog:  []SolutionEvent{{PlayerID: "p2", At: now, Moves: other.Moves}},
+			PlayerNames:  map[string]string{"p1": "Alice", "p2": "Bob"},
+			AccountIDs:   map[string]string{"p1": "a1"},
+			WinnerID:     "p2",
+			OptimalMoves: 2,
+		}},
+	}
+}
+
+// assertMatchesEqual compares matches field by field, using Equal for times.
+func assertMatchesEqual(t *testing.T, want, got *Match) {
+	t.Helper()
+	if (got == nil) != (want == nil) {
+		t.Fatalf("expected match %+v, got %+v", want, got)
+	}
+	if want == nil {
+		return
+	}
+	if got.Rounds != want.Rounds || got.FirstTo != want.FirstTo || got.Seed != want.Seed || got.GamesPlayed != want.GamesPlayed || got.GamesStarted != want.GamesStarted || got.ChampionID != want.ChampionID ||
+		!got.StartedAt.Equal(want.StartedAt) || (got.EndedAt == nil) != (want.EndedAt == nil) || (want.EndedAt != nil && !got.EndedAt.Equal(*want.EndedAt)) {
+		t.Errorf("expected match %+v, got %+v", want, got)
+	}
+	if !reflect.DeepEqual(got.Wins, want.Wins) {
+		t.Errorf("expected match wins %v, got %v", want.Wins, got.Wins)
+	}
+}
+
+// assertRoomsEqual compares rooms field by field, using Equal for times.
+func assertRoomsEqual(t *testing.T, want, got *Room) {
+	t.Helper()
+	if got == nil {
+		t.Fatalf("room %s not loaded", want.ID)
+	}
+	if got.ID != want.ID || got.GamesPlayed != want.GamesPlayed {
+		t.Errorf("expected room %s with %d games, got %s with %d", want.ID, want.GamesPlayed, got.ID, got.GamesPlayed)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || !got.LastActivityAt.Equal(want.LastActivityAt) {
+		t.Errorf("timestamps differ: want %v/%v, got %v/%v", want.CreatedAt, want.LastActivityAt, got.CreatedAt, got.LastActivityAt)
+	}
+	if len(got.Players) != len(want.Players) {
+		t.Fatalf("expected %d players, got %d", len(want.Players), len(got.Players))
+	}
+	for i, p := range want.Players {
+		g := got.Players[i]
+		if g.ID != p.ID || g.AccountID != p.AccountID || g.Name != p.Name || g.Status != p.Status || !g.DisconnectedAt.Equal(p.DisconnectedAt) || g.Bot != p.Bot || g.Team != p.Team || g.Handicap != p.Handicap {
+			t.Errorf("player %d: expected %+v, got %+v", i, p, g)
+		}
+	}
+	if !reflect.DeepEqual(got.Wins, want.Wins) {
+		t.Errorf("expected wins %v, got %v", want.Wins, got.Wins)
+	}
+	if !reflect.DeepEqual(got.TeamWins, want.TeamWins) || got.TeamQuorum != want.TeamQuorum {
+		t.Errorf("expected team wins %v (quorum %v), got %v (%v)", want.TeamWins, want.TeamQuorum, got.TeamWins, got.TeamQuorum)
+	}
+	if !reflect.DeepEqual(got.Hints, want.Hints) {
+		t.Errorf("expected hints %v, got %v", want.Hints, got.Hints)
+	}
+	assertMatchesEqual(t, want.Match, got.Match)
+	if !reflect.DeepEqual(got.FinishedSolving, want.FinishedSolving) || len(got.ReadyForNext) != len(want.ReadyForNext) {
+		t.Errorf("expected finished %v and ready %v, got %v and %v", want.FinishedSolving, want.ReadyForNext, got.FinishedSolving, got.ReadyForNext)
+	}
+	if (got.CurrentGame == nil) != (want.CurrentGame == nil) {
+		t.Fatalf("expected game %v, got %v", want.CurrentGame, got.CurrentGame)
+	}
+	if want.CurrentGame != nil && !got.CurrentGame.Equals(want.CurrentGame) {
+		t.Error("loaded game differs from saved game")
+	}
+	if (got.GameStartedAt == nil) != (want.GameStartedAt == nil) ||
+		(want.GameStartedAt != nil && !got.GameStartedAt.Equal(*want.GameStartedAt)) {
+		t.Errorf("expected game started at %v, got %v", want.GameStartedAt, got.GameStartedAt)
+	}
+	if got.GameSeed != want.GameSeed {
+		t.Errorf("expected game seed %d, got %d", want.GameSeed, got.GameSeed)
+	}
+	assertSolutionsEqual(t, want.Solutions, got.Solutions)
+	assertSolutionLogsEqual(t, want.SolutionLog, got.SolutionLog)
+	if len(got.SolutionHistory) != len(want.SolutionHistory) {
+		t.Fatalf("expected %d solution histories, got %d", len(want.SolutionHistory), len(got.SolutionHistory))
+	}
+	for i, h := range want.SolutionHistory {
+		if got.SolutionHistory[i].PlayerID != h.PlayerID {
+			t.Errorf("history %d: expected player %s, got %s", i, h.PlayerID, got.SolutionHistory[i].PlayerID)
+		}
+		assertSolutionsEqual(t, h.Solutions, got.SolutionHistory[i].Solutions)
+	}
+	if len(got.History) != len(want.History) {
+		t.Fatalf("expected %d history records, got %d", len(want.History), len(got.History))
+	}
+	for i, h := range want.History {
+		g := got.History[i]
+		if !g.Game.Equals(h.Game) || !g.EndedAt.Equal(h.EndedAt) || g.WinnerID != h.WinnerID || g.OptimalMoves != h.OptimalMoves {
+			t.Errorf("game record %d: expected %+v, got %+v", i, h, g)
+		}
+		if g.Seed != h.Seed {
+			t.Errorf("game record %d: expected seed %d, got %d", i, h.Seed, g.Seed)
+		}
+		if (g.StartedAt == nil) != (h.StartedAt == nil) || (h.StartedAt != nil && !g.StartedAt.Equal(*h.StartedAt)) {
+			t.Errorf("game record %d: expected start %v, got %v", i, h.StartedAt, g.StartedAt)
+		}
+		if !reflect.DeepEqual(g.PlayerNames, h.PlayerNames) || !reflect.DeepEqual(g.AccountIDs, h.AccountIDs) {
+			t.Errorf("game record %d: expected names %v and accounts %v, got %v and %v", i, h.PlayerNames, h.AccountIDs, g.PlayerNames, g.AccountIDs)
+		}
+		assertSolutionsEqual(t, h.Solutions, g.Solutions)
+		assertSolutionLogsEqual(t, h.SolutionLog, g.SolutionLog)
+	}
+}
+
+func assertSolutionLogsEqual(t *testing.T, want, got []SolutionEvent) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d solution events, got %d", len(want), len(got))
+	}
+	for i, e := range want {
+		if got[i].PlayerID != e.PlayerID || !got[i].At.Equal(e.At) || got[i].Retracted != e.Retracted || !reflect.DeepEqual(got[i].Moves, e.Moves) {
+			t.Errorf("solution event %d: expected %+v, got %+v", i, e, got[i])
+		}
+	}
+}
+
+func assertSolutionsEqual(t *testing.T, want, got []PlayerSolution) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d solutions, got %d", len(want), len(got))
+	}
+	for i, s := range want {
+		if got[i].PlayerID != s.PlayerID || !got[i].SolvedAt.Equal(s.SolvedAt) || !reflect.DeepEqual(got[i].Moves, s.Moves) || got[i].Hints != s.Hints || got[i].Handicap != s.Handicap {
+			t.Errorf("solution %d: expected %+v, got %+v", i, s, got[i])
+		}
+	}
+}
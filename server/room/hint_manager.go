@@ -0,0 +1,136 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
+// HintTier is how much of the current game's optimal solution a player has been
+// shown. Each tier includes everything the tiers before it reveal.
+type HintTier int
+
+const (
+	HintNone      HintTier = iota
+	HintMoveCount          // The optimal move count
+	HintBots               // Which bots the optimal solution moves
+	HintFirstMove          // The optimal solution's first move
+	HintSolution           // The whole optimal solution
+)
+
+// ErrNoHint is returned when the solver can't find the current game's optimal
+// solution within its limits, so there is nothing to hint at.
+var ErrNoHint = errors.New("no hint available: the game is too hard for the solver")
+
+// ErrHintGameChanged is returned when a new game starts while the previous one
+// is being solved for a hint.
+var ErrHintGameChanged = errors.New("the game changed while solving it for a hint")
+
+// Hint is everything a player has been shown about the current game's optimal solution.
+type Hint struct {
+	Tier      HintTier
+	MoveCount int
+	Bots      []model.BotId       // Bots that move, in ID order, from HintBots
+	Moves     []model.BotPosition // The first move from HintFirstMove, every move at HintSolution
+}
+
+// ToProto converts a Hint to its protobuf representation.
+func (h *Hint) ToProto() *pb.Hint {
+	out := &pb.Hint{
+		Tier:         int32(h.Tier),
+		OptimalMoves: int32(h.MoveCount),
+	}
+	for _, id := range h.Bots {
+		out.Bots = append(out.Bots, int32(id))
+	}
+	for _, m := range h.Moves {
+		out.Moves = append(out.Moves, m.ToProto())
+	}
+	return out
+}
+
+// newHint reveals an optimal solution up to a tier.
+func newHint(tier HintTier, solution []model.BotPosition) *Hint {
+	h := &Hint{Tier: tier, MoveCount: len(solution)}
+	if tier >= HintBots {
+		for _, m := range solution {
+			if !slices.Contains(h.Bots, m.Id) {
+				h.Bots = append(h.Bots, m.Id)
+			}
+		}
+		slices.Sort(h.Bots)
+	}
+	switch {
+	case tier >= HintSolution:
+		h.Moves = slices.Clone(solution)
+	case tier >= HintFirstMove && len(solution) > 0:
+		h.Moves = solution[:1:1]
+	}
+	return h
+}
+
+// HintManager reveals the current game's optimal solution to stuck players.
+type HintManager interface {
+	// Solve finds a game's optimal solution for hints, or reports there is none
+	// within the solver's limits. It doesn't touch the room, so call it outside
+	// the room lock.
+	Solve(game *model.Game) ([]model.BotPosition, bool)
+
+	// RequestHint moves a player up to the next hint tier, up to HintSolution,
+	// and returns everything revealed so far. If the room has no solution for
+	// its current game yet, solution from Solve is stored for it, unless game is
+	// no longer the current one. Returns hint or error.
+	RequestHint(room *Room, playerID string, game *model.Game, solution []model.BotPosition) (*Hint, error)
+}
+
+// hintManager is the concrete implementation of HintManager.
+type hintManager struct {
+	now   func() time.Time                                   // Clock, replaced when replaying the journal
+	solve func(game *model.Game) ([]model.BotPosition, bool) // Solver, replaced when replaying the journal
+}
+
+// NewHintManager creates a new HintManager.
+func NewHintManager() HintManager {
+	return &hintManager{now: time.Now, solve: solveForHint}
+}
+
+// solveForHint finds an optimal solution within the same limits as GameRecord's optimal move count.
+func solveForHint(game *model.Game) ([]model.BotPosition, bool) {
+	return game.Solve(maxSolverMoves, solverStateLimit)
+}
+
+func (hm *hintManager) Solve(game *model.Game) ([]model.BotPosition, bool) {
+	return hm.solve(game)
+}
+
+func (hm *hintManager) RequestHint(room *Room, playerID string, game *model.Game, solution []model.BotPosition) (*Hint, error) {
+	if room.CurrentGame == nil {
+		return nil, fmt.Errorf("no game in progress")
+	}
+
+	// Verify player exists
+	if room.GetPlayerName(playerID) == "" {
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+
+	// Keep the solution the first time anyone asks, if it is still for this game
+	if room.hintSolution == nil {
+		if game != room.CurrentGame {
+			return nil, ErrHintGameChanged
+		}
+		room.hintSolution = solution
+	}
+
+	tier := min(room.Hints[playerID]+1, HintSolution)
+	if room.Hints == nil {
+		room.Hints = make(map[string]HintTier)
+	}
+	room.Hints[playerID] = tier
+	room.LastActivityAt = hm.now()
+
+	return newHint(tier, room.hintSolution), nil
+}
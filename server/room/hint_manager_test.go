@@ -0,0 +1,130 @@
+package room
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// requestHint asks for a hint as RoomService does, solving the game first if
+// the room has no solution for it yet.
+func requestHint(hm HintManager, room *Room, playerID string) (*Hint, error) {
+	var solution []model.BotPosition
+	if room.CurrentGame != nil && room.hintSolution == nil {
+		var ok bool
+		if solution, ok = hm.Solve(room.CurrentGame); !ok {
+			return nil, ErrNoHint
+		}
+	}
+	return hm.RequestHint(room, playerID, room.CurrentGame, solution)
+}
+
+func TestHintManager_RequestHint_Escalates(t *testing.T) {
+	hm := NewHintManager()
+
+	room := &Room{
+		ID:          "TEST",
+		Players:     []Player{{ID: "alice", Name: "Alice"}, {ID: "bob", Name: "Bob"}},
+		CurrentGame: model.Game1(),
+	}
+
+	var solution []model.BotPosition
+	for tier := HintMoveCount; tier <= HintSolution; tier++ {
+		hint, err := requestHint(hm, room, "alice")
+		if err != nil {
+			t.Fatalf("tier %d: unexpected error: %v", tier, err)
+		}
+		if hint.Tier != tier {
+			t.Errorf("expected tier %d, got %d", tier, hint.Tier)
+		}
+		if hint.MoveCount != len(model.Game1Solution()) {
+			t.Errorf("tier %d: expected %d optimal moves, got %d", tier, len(model.Game1Solution()), hint.MoveCount)
+		}
+		if (len(hint.Bots) > 0) != (tier >= HintBots) {
+			t.Errorf("tier %d: unexpected bots %v", tier, hint.Bots)
+		}
+		switch tier {
+		case HintMoveCount, HintBots:
+			if len(hint.Moves) != 0 {
+				t.Errorf("tier %d: expected no moves, got %v", tier, hint.Moves)
+			}
+		case HintFirstMove:
+			if len(hint.Moves) != 1 {
+				t.Errorf("expected the first move, got %v", hint.Moves)
+			}
+		case HintSolution:
+			solution = hint.Moves
+		}
+	}
+
+	if valid, _ := room.CurrentGame.CheckSolution(solution); !valid || len(solution) != len(model.Game1Solution()) {
+		t.Errorf("expected an optimal solution at the last tier, got %v", solution)
+	}
+	for _, m := range solution {
+		if !slices.Contains(newHint(HintBots, solution).Bots, m.Id) {
+			t.Errorf("bot %d moves but wasn't hinted", m.Id)
+		}
+	}
+
+	// Asking again repeats the last tier, and other players start from the first
+	if hint, _ := requestHint(hm, room, "alice"); hint.Tier != HintSolution {
+		t.Errorf("expected tier to stay at %d, got %d", HintSolution, hint.Tier)
+	}
+	if hint, _ := requestHint(hm, room, "bob"); hint.Tier != HintMoveCount {
+		t.Errorf("expected bob to start at tier %d, got %d", HintMoveCount, hint.Tier)
+	}
+	if room.Hints["alice"] != HintSolution || room.Hints["bob"] != HintMoveCount {
+		t.Errorf("unexpected hint tiers %v", room.Hints)
+	}
+}
+
+func TestHintManager_RequestHint_KeepsFirstSolution(t *testing.T) {
+	hm := NewHintManager()
+	room := &Room{ID: "TEST", Players: []Player{{ID: "alice", Name: "Alice"}}, CurrentGame: model.Game1()}
+	first := model.Game1Solution()
+	hm.RequestHint(room, "alice", room.CurrentGame, first)
+	if hint, _ := hm.RequestHint(room, "alice", room.CurrentGame, first[:1]); hint.MoveCount != len(first) {
+		t.Errorf("expected the first solution to be kept, got %d moves", hint.MoveCount)
+	}
+
+	// A new game clears the hints and the solution
+	room.ClearGameState()
+	room.CurrentGame = model.Game1()
+	hint, _ := hm.RequestHint(room, "alice", room.CurrentGame, first[:1])
+	if hint.Tier != HintMoveCount || hint.MoveCount != 1 {
+		t.Errorf("expected hints to restart with the new game's solution, got %+v", hint)
+	}
+}
+
+func TestHintManager_RequestHint_GameChanged(t *testing.T) {
+	hm := NewHintManager()
+	room := &Room{ID: "TEST", Players: []Player{{ID: "alice", Name: "Alice"}}, CurrentGame: model.Game1()}
+	solved := room.CurrentGame
+	solution, _ := hm.Solve(solved)
+
+	// A new game started while the old one was being solved
+	room.ClearGameState()
+	room.CurrentGame = model.NewRandomGameFromSeed(42)
+	if _, err := hm.RequestHint(room, "alice", solved, solution); !errors.Is(err, ErrHintGameChanged) {
+		t.Errorf("expected ErrHintGameChanged, got %v", err)
+	}
+	if room.hintSolution != nil || room.Hints["alice"] != HintNone {
+		t.Error("expected neither the old game's solution nor a hint tier to be kept")
+	}
+}
+
+func TestHintManager_RequestHint_Errors(t *testing.T) {
+	hm := NewHintManager()
+
+	room := &Room{ID: "TEST", Players: []Player{{ID: "alice", Name: "Alice"}}}
+	if _, err := hm.RequestHint(room, "alice", nil, nil); err == nil {
+		t.Error("expected error without a game in progress")
+	}
+
+	room.CurrentGame = model.Game1()
+	if _, err := hm.RequestHint(room, "nobody", room.CurrentGame, model.Game1Solution()); err == nil {
+		t.Error("expected error for unknown player")
+	}
+}
@@ -0,0 +1,202 @@
+package room
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxHistory is the number of completed games kept per room. Older games are dropped.
+const maxHistory = 50
+
+// solverStateLimit bounds the search for a game's optimal move count, so it
+// comes soon after a game ends. Games that need a longer search are recorded without one.
+const solverStateLimit = 200_000
+
+// GameRecord is a completed game kept in a room's history.
+type GameRecord struct {
+	Game         *model.Game // Board, starting bot positions and target
+	Seed         int64       // Seed the game was generated from, 0 if unknown
+	StartedAt    *time.Time  // Nil if the start time is unknown
+	EndedAt      time.Time
+	Solutions    []PlayerSolution  // Every solution submitted and not retracted, oldest first
+	SolutionLog  []SolutionEvent   // Every submission and retraction, oldest first
+	PlayerNames  map[string]string // Names of the room's players when the game ended, by ID
+	AccountIDs   map[string]string // Accounts of the room's signed-in players when the game ended, by player ID
+	WinnerID     string            // Empty if nobody solved the game
+	OptimalMoves int               // Fewest moves that solve the game, 0 if unknown
+	HintPenalty  int               `json:",omitzero"` // Moves added per hint tier when the winner was chosen
+}
+
+// recordGame adds a completed game to the room's history, dropping the oldest
+// games beyond maxHistory.
+func (r *Room) recordGame(rec GameRecord) {
+	r.History = append(r.History, rec)
+	r.historyVersion++
+	if len(r.History) > maxHistory {
+		r.History = slices.Clone(r.History[len(r.History)-maxHistory:])
+	}
+}
+
+// gameRecorded reports whether the current game has ended and is the last game
+// in the history.
+func (r *Room) gameRecorded() bool {
+	if r.CurrentGame == nil || len(r.History) == 0 {
+		return false
+	}
+	last := r.History[len(r.History)-1]
+	if last.Game == r.CurrentGame {
+		return true
+	}
+	// After a restart the game is a copy, so match it on its start time
+	return last.StartedAt != nil && r.GameStartedAt != nil && last.StartedAt.Equal(*r.GameStartedAt)
+}
+
+// newGameRecord builds the record of the room's current game as it ends.
+// hintPenalty is the one the winner was chosen with. The optimal move count is
+// left for optimalMoves, as solving is too slow to do under the room lock.
+func newGameRecord(room *Room, winner *PlayerSolution, endedAt time.Time, hintPenalty int) GameRecord {
+	var solutions []PlayerSolution
+	for _, h := range room.SolutionHistory {
+		solutions = append(solutions, h.Solutions...)
+	}
+	slices.SortStableFunc(solutions, func(a, b PlayerSolution) int {
+		return a.SolvedAt.Compare(b.SolvedAt)
+	})
+
+	names := make(map[string]string, len(room.Players))
+	var accounts map[string]string
+	for _, p := range room.Players {
+		names[p.ID] = p.Name
+		if p.AccountID != "" {
+			if accounts == nil {
+				accounts = make(map[string]string)
+			}
+			accounts[p.ID] = p.AccountID
+		}
+	}
+
+	rec := GameRecord{
+		Game:        room.CurrentGame,
+		Seed:        room.GameSeed,
+		StartedAt:   room.GameStartedAt,
+		EndedAt:     endedAt,
+		Solutions:   solutions,
+		SolutionLog: room.SolutionLog,
+		PlayerNames: names,
+		AccountIDs:  accounts,
+		HintPenalty: hintPenalty,
+	}
+	if winner != nil {
+		rec.WinnerID = winner.PlayerID
+	}
+	return rec
+}
+
+// maxSolverMoves is the deepest search for the optimal move count of a game nobody solved.
+const maxSolverMoves = 20
+
+// optimalMoves returns the fewest moves that solve a recorded game, or 0 if
+// unknown. The shortest solution submitted bounds the search depth.
+func optimalMoves(rec GameRecord) int {
+	if rec.Game == nil {
+		return 0
+	}
+	maxMoves := 0
+	for _, sol := range rec.Solutions {
+		if maxMoves == 0 || sol.MoveCount() < maxMoves {
+			maxMoves = sol.MoveCount()
+		}
+	}
+	if maxMoves == 0 {
+		maxMoves = maxSolverMoves
+	}
+	if moves, ok := rec.Game.Solve(maxMoves, solverStateLimit); ok {
+		return len(moves)
+	}
+	return 0
+}
+
+// setOptimalMoves stores the optimal move count of the recorded game that ended
+// at endedAt. It reports whether the game is still in the room's history.
+func (r *Room) setOptimalMoves(endedAt time.Time, moves int) bool {
+	for i := range r.History {
+		if r.History[i].EndedAt.Equal(endedAt) {
+			r.History[i].OptimalMoves = moves
+			r.historyVersion++
+			return true
+		}
+	}
+	return false
+}
+
+// BestSolutions returns each player's best standing solution, by player ID, as
+// GetWinningSolution decides winners.
+func (rec *GameRecord) BestSolutions() map[string]*PlayerSolution {
+	best := make(map[string]*PlayerSolution)
+	for i := range rec.Solutions {
+		sol := &rec.Solutions[i]
+		b, ok := best[sol.PlayerID]
+		if !ok || rec.CompareSolutions(sol, b) < 0 {
+			best[sol.PlayerID] = sol
+		}
+	}
+	return best
+}
+
+// CompareSolutions orders two of the game's solutions as GetWinningSolution
+// ranked them when the game ended, hints and handicaps included: negative if a
+// ranks first, positive if b does, 0 if they tie.
+func (rec *GameRecord) CompareSolutions(a, b *PlayerSolution) int {
+	return compareSolutions(a, b, rec.HintPenalty)
+}
+
+// ToProto converts a GameRecord to its protobuf representation.
+func (rec *GameRecord) ToProto() *pb.GameRecord {
+	solutions := make([]*pb.PlayerSolution, len(rec.Solutions))
+	for i, sol := range rec.Solutions {
+		solutions[i] = sol.ToProto()
+	}
+
+	out := &pb.GameRecord{
+		EndedAt:          timestamppb.New(rec.EndedAt),
+		Solutions:        solutions,
+		PlayerNames:      rec.PlayerNames,
+		AccountIds:       rec.AccountIDs,
+		WinnerId:         rec.WinnerID,
+		OptimalMoveCount: int32(rec.OptimalMoves),
+	}
+	if rec.Game != nil {
+		out.Game = rec.Game.ToProto()
+	}
+	if rec.StartedAt != nil {
+		out.StartedAt = timestamppb.New(*rec.StartedAt)
+	}
+	return out
+}
+
+// Replay returns the game as a shareable replay.
+func (rec *GameRecord) Replay(roomID string) *model.Replay {
+	r := &model.Replay{
+		RoomID:       roomID,
+		Game:         rec.Game,
+		Seed:         rec.Seed,
+		EndedAt:      rec.EndedAt,
+		WinnerID:     rec.WinnerID,
+		OptimalMoves: rec.OptimalMoves,
+	}
+	if rec.StartedAt != nil {
+		r.StartedAt = *rec.StartedAt
+	}
+	for _, id := range slices.Sorted(maps.Keys(rec.PlayerNames)) {
+		r.Players = append(r.Players, model.ReplayPlayer{ID: id, Name: rec.PlayerNames[id]})
+	}
+	for _, e := range rec.SolutionLog {
+		r.Events = append(r.Events, model.ReplayEvent{PlayerID: e.PlayerID, At: e.At, Retract: e.Retracted, Moves: e.Moves})
+	}
+	return r
+}
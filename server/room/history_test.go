@@ -0,0 +1,174 @@
+package room
+
+import (
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+func TestRoom_RecordGame_KeepsMostRecent(t *testing.T) {
+	room := newRoom("ROOM1", "p1", "Alice", time.Now())
+	for i := 0; i < maxHistory+5; i++ {
+		room.recordGame(GameRecord{OptimalMoves: i})
+	}
+
+	if len(room.History) != maxHistory {
+		t.Fatalf("expected %d records, got %d", maxHistory, len(room.History))
+	}
+	if room.History[0].OptimalMoves != 5 || room.History[maxHistory-1].OptimalMoves != maxHistory+4 {
+		t.Errorf("expected records 5 to %d, got %d to %d", maxHistory+4, room.History[0].OptimalMoves, room.History[maxHistory-1].OptimalMoves)
+	}
+}
+
+func TestNewGameRecord(t *testing.T) {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	room := newRoom("ROOM1", "p1", "Alice", start)
+	room.Players = append(room.Players, Player{ID: "p2", AccountID: "a2", Name: "Bob"})
+	room.CurrentGame = model.Game1()
+	room.GameStartedAt = &start
+
+	late := PlayerSolution{PlayerID: "p1", SolvedAt: start.Add(2 * time.Minute), Moves: validSolution()}
+	early := PlayerSolution{PlayerID: "p2", SolvedAt: start.Add(time.Minute), Moves: validSolution()}
+	room.SolutionHistory = []PlayerSolutionHistory{
+		{PlayerID: "p1", Solutions: []PlayerSolution{late}},
+		{PlayerID: "p2", Solutions: []PlayerSolution{early}},
+	}
+
+	end := start.Add(3 * time.Minute)
+	rec := newGameRecord(room, &early, end, 0)
+
+	if rec.WinnerID != "p2" || !rec.EndedAt.Equal(end) || rec.StartedAt != &start {
+		t.Errorf("unexpected record %+v", rec)
+	}
+	if len(rec.Solutions) != 2 || rec.Solutions[0].PlayerID != "p2" {
+		t.Errorf("expected both solutions, oldest first, got %+v", rec.Solutions)
+	}
+	if rec.PlayerNames["p2"] != "Bob" {
+		t.Errorf("expected player names to be kept, got %v", rec.PlayerNames)
+	}
+	if len(rec.AccountIDs) != 1 || rec.AccountIDs["p2"] != "a2" {
+		t.Errorf("expected only Bob's account to be kept, got %v", rec.AccountIDs)
+	}
+	if rec.OptimalMoves != 0 {
+		t.Errorf("expected the optimal count to be left for outside the room lock, got %d", rec.OptimalMoves)
+	}
+}
+
+func TestOptimalMoves(t *testing.T) {
+	rec := GameRecord{Game: model.Game1(), Solutions: []PlayerSolution{{PlayerID: "p1", Moves: validSolution()}}}
+	if got := optimalMoves(rec); got == 0 || got > len(validSolution()) {
+		t.Errorf("expected optimal count of at most %d, got %d", len(validSolution()), got)
+	}
+
+	// The shortest solution bounds the search
+	rec.Solutions = append(rec.Solutions, PlayerSolution{PlayerID: "p2", Moves: validSolution()[:1]})
+	if got := optimalMoves(rec); got != 0 {
+		t.Errorf("expected no optimal count within 1 move, got %d", got)
+	}
+}
+
+func TestRoom_SetOptimalMoves(t *testing.T) {
+	end := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	room := newRoom("ROOM1", "p1", "Alice", end)
+	room.recordGame(GameRecord{EndedAt: end.Add(-time.Hour)})
+	room.recordGame(GameRecord{EndedAt: end})
+
+	if !room.setOptimalMoves(end, 6) || room.History[1].OptimalMoves != 6 || room.History[0].OptimalMoves != 0 {
+		t.Errorf("expected only the game ended at %v to be updated, got %+v", end, room.History)
+	}
+	if room.setOptimalMoves(end.Add(time.Minute), 6) {
+		t.Error("expected no game to update")
+	}
+}
+
+func TestGameRecord_ToProto(t *testing.T) {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	rec := GameRecord{
+		Game:         model.Game1(),
+		StartedAt:    &start,
+		EndedAt:      start.Add(time.Minute),
+		Solutions:    []PlayerSolution{{PlayerID: "p1", SolvedAt: start, Moves: validSolution()}},
+		PlayerNames:  map[string]string{"p1": "Alice"},
+		WinnerID:     "p1",
+		OptimalMoves: 7,
+	}
+
+	p := rec.ToProto()
+	if p.WinnerId != "p1" || p.OptimalMoveCount != 7 || p.PlayerNames["p1"] != "Alice" {
+		t.Errorf("unexpected proto %v", p)
+	}
+	if p.Game == nil || !p.StartedAt.AsTime().Equal(start) || len(p.Solutions) != 1 || len(p.Solutions[0].Moves) != 7 {
+		t.Errorf("expected game, start time and solution moves, got %v", p)
+	}
+}
+
+func TestGameRecord_Replay(t *testing.T) {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	rec := GameRecord{
+		Game:        model.Game1(),
+		Seed:        42,
+		StartedAt:   &start,
+		EndedAt:     start.Add(time.Minute),
+		PlayerNames: map[string]string{"p2": "Bob", "p1": "Alice"},
+		SolutionLog: []SolutionEvent{
+			{PlayerID: "p1", At: start.Add(10 * time.Second), Moves: validSolution()},
+			{PlayerID: "p1", At: start.Add(20 * time.Second), Retracted: true, Moves: validSolution()},
+		},
+		WinnerID: "p2",
+	}
+
+	r := rec.Replay("ROOM1")
+	if r.RoomID != "ROOM1" || r.Seed != 42 || !r.StartedAt.Equal(start) || r.WinnerID != "p2" {
+		t.Errorf("unexpected replay %+v", r)
+	}
+	if len(r.Players) != 2 || r.Players[0].ID != "p1" || r.Players[1].Name != "Bob" {
+		t.Errorf("expected players sorted by ID, got %+v", r.Players)
+	}
+	if len(r.Events) != 2 || r.Events[0].Retract || !r.Events[1].Retract {
+		t.Errorf("expected a submission then a retraction, got %+v", r.Events)
+	}
+}
+
+func TestGameRecord_BestSolutions(t *testing.T) {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	long := PlayerSolution{PlayerID: "p1", SolvedAt: start, Moves: validSolution()}
+	short := PlayerSolution{PlayerID: "p1", SolvedAt: start.Add(time.Minute), Moves: validSolution()[:3]}
+	tieEarly := PlayerSolution{PlayerID: "p2", SolvedAt: start, Moves: validSolution()[:3]}
+	tieLate := PlayerSolution{PlayerID: "p2", SolvedAt: start.Add(time.Second), Moves: validSolution()[:3]}
+	rec := GameRecord{Solutions: []PlayerSolution{long, short, tieLate, tieEarly}}
+
+	best := rec.BestSolutions()
+	if len(best) != 2 {
+		t.Fatalf("expected a best solution for 2 players, got %d", len(best))
+	}
+	if best["p1"].MoveCount() != 3 {
+		t.Errorf("expected p1's shorter solution, got %d moves", best["p1"].MoveCount())
+	}
+	if !best["p2"].SolvedAt.Equal(start) {
+		t.Errorf("expected p2's earlier solution of equal length, got %v", best["p2"].SolvedAt)
+	}
+}
+
+func TestGameRecord_BestSolutions_CountsHintsAndHandicaps(t *testing.T) {
+	start := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	// p1 shortened their solution only after taking a hint, which costs 2 moves
+	unaided := PlayerSolution{PlayerID: "p1", SolvedAt: start, Moves: validSolution()[:5]}
+	hinted := PlayerSolution{PlayerID: "p1", SolvedAt: start.Add(time.Minute), Moves: validSolution()[:4], Hints: HintMoveCount}
+	// p2's 6 moves count as 4 with their handicap
+	handicapped := PlayerSolution{PlayerID: "p2", SolvedAt: start.Add(time.Second), Moves: validSolution()[:6], Handicap: Handicap{ExtraMoves: 2}}
+	rec := GameRecord{Solutions: []PlayerSolution{unaided, hinted, handicapped}, HintPenalty: 2}
+
+	best := rec.BestSolutions()
+	if best["p1"].MoveCount() != 5 {
+		t.Errorf("expected p1's unaided solution, got %d moves", best["p1"].MoveCount())
+	}
+
+	// The ranking agrees with GetWinningSolution
+	sm := NewSolutionManager()
+	sm.SetHintPenalty(2)
+	winner := sm.GetWinningSolution(rec.Solutions)
+	if winner.PlayerID != "p2" || rec.CompareSolutions(best["p2"], best["p1"]) >= 0 {
+		t.Errorf("expected p2 to win and rank first, got winner %s", winner.PlayerID)
+	}
+}
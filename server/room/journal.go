@@ -0,0 +1,394 @@
+package room
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	"github.com/srsalisbury/bouncebot/server/logging"
+)
+
+// JournalOp identifies a state-changing room operation.
+type JournalOp string
+
+const (
+	OpCreate     JournalOp = "create"
+	OpJoin       JournalOp = "join"
+	OpStart      JournalOp = "start"
+	OpSubmit     JournalOp = "submit"
+	OpRetract    JournalOp = "retract"
+	OpHint       JournalOp = "hint"
+	OpTeam       JournalOp = "team"
+	OpTeamQuorum JournalOp = "team_quorum"
+	OpMatchStart JournalOp = "match_start"
+	OpMatchStop  JournalOp = "match_stop"
+	OpHandicap   JournalOp = "handicap"
+	OpFinish     JournalOp = "finish"
+	OpReady      JournalOp = "ready"
+	OpEndGame    JournalOp = "end_game"
+	OpNextGame   JournalOp = "next_game"
+	OpSolved     JournalOp = "solved"
+	OpDisconnect JournalOp = "disconnect"
+	OpReconnect  JournalOp = "reconnect"
+	OpRemove     JournalOp = "remove"
+	OpDelete     JournalOp = "delete"
+)
+
+// JournalEntry records one operation and the nondeterministic results it
+// produced (generated IDs, games and timestamps), so replaying it is exact.
+type JournalEntry struct {
+	Seq       uint64              `json:"seq"`
+	Op        JournalOp           `json:"op"`
+	RoomID    string              `json:"room"`
+	Time      time.Time           `json:"time"` // Clock reading used by the operation, or when the game a solved entry solved ended
+	PlayerID  string              `json:"player,omitempty"`
+	Name      string              `json:"name,omitempty"`
+	AccountID string              `json:"account,omitempty"` // Account of the player added by create and join
+	Bot       BotLevel            `json:"bot,omitempty"`     // Level of the computer opponent added by join
+	Moves     []model.BotPosition `json:"moves,omitempty"`
+	Game      *model.Game         `json:"game,omitempty"`     // Game started by start and next_game
+	Seed      int64               `json:"seed,omitempty"`     // Seed of Game, or of the match started by match_start
+	Team      string              `json:"team,omitempty"`     // Team a team entry moves the player to, empty to leave theirs
+	Enabled   bool                `json:"enabled,omitempty"`  // Whether a team_quorum entry turns the team quorum on
+	Rounds    int                 `json:"rounds,omitempty"`   // Rounds of the match started by match_start
+	FirstTo   int                 `json:"first_to,omitempty"` // Wins that take the match started by match_start
+	Handicap  Handicap            `json:"handicap,omitzero"`  // Handicap a handicap entry gives the player, zero to remove it
+	Optimal   int                 `json:"optimal,omitempty"`  // Optimal move count a solved entry found
+}
+
+// Journal is an append-only log of room operations, one JSON entry per line.
+// Appends are written straight to the file, so they survive a process crash;
+// fsyncs are batched every sync interval to bound loss on power failure.
+//
+// Rotate moves the entries so far into a rotated segment before a snapshot,
+// and DiscardRotated deletes it once the snapshot is saved.
+type Journal struct {
+	mu    sync.Mutex
+	path  string
+	file  *os.File
+	seq   uint64
+	dirty bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// rotatedPath returns the path of the segment holding entries awaiting a snapshot.
+func rotatedPath(path string) string {
+	return path + ".old"
+}
+
+// OpenJournal opens or creates the journal at path and starts batching fsyncs.
+// Caller must call Close when done.
+func OpenJournal(path string, syncInterval time.Duration) (*Journal, error) {
+	j := &Journal{
+		path: path,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	entries, err := j.Entries()
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > 0 {
+		j.seq = entries[len(entries)-1].Seq
+	}
+
+	if j.file, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+		return nil, err
+	}
+
+	go j.syncLoop(syncInterval)
+	return j, nil
+}
+
+// Append assigns the next sequence number to an entry and writes it.
+func (j *Journal) Append(e JournalEntry) (uint64, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e.Seq = j.seq + 1
+	data, err := json.Marshal(e)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := j.file.Write(append(data, '\n')); err != nil {
+		return 0, err
+	}
+
+	j.seq = e.Seq
+	j.dirty = true
+	return e.Seq, nil
+}
+
+// advanceSeq makes later entries number after seq, so they sort after a
+// snapshot that was taken with a different journal.
+func (j *Journal) advanceSeq(seq uint64) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if seq > j.seq {
+		j.seq = seq
+	}
+}
+
+// Entries returns the rotated segment's entries followed by the current ones, in order.
+// A torn final line, left by a crash mid-append, is ignored.
+func (j *Journal) Entries() ([]JournalEntry, error) {
+	rotated, err := readJournalFile(rotatedPath(j.path))
+	if err != nil {
+		return nil, err
+	}
+	current, err := readJournalFile(j.path)
+	if err != nil {
+		return nil, err
+	}
+	return append(rotated, current...), nil
+}
+
+// Rotate moves all entries so far into the rotated segment and starts an empty journal.
+// If an earlier rotated segment was never discarded, the entries are added to it.
+func (j *Journal) Rotate() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+	j.dirty = false
+
+	old, err := os.OpenFile(rotatedPath(j.path), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer old.Close()
+
+	current, err := os.Open(j.path)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(old, current)
+	current.Close()
+	if err != nil {
+		return err
+	}
+	if err := old.Sync(); err != nil {
+		return err
+	}
+
+	return j.file.Truncate(0)
+}
+
+// DiscardRotated deletes the rotated segment once a snapshot covering it is saved.
+func (j *Journal) DiscardRotated() error {
+	err := os.Remove(rotatedPath(j.path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Close stops batching, syncs pending entries and closes the file.
+func (j *Journal) Close() error {
+	close(j.stop)
+	<-j.done
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.file.Sync(); err != nil {
+		j.file.Close()
+		return err
+	}
+	return j.file.Close()
+}
+
+// syncLoop fsyncs the journal every interval if anything was appended.
+func (j *Journal) syncLoop(interval time.Duration) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			j.mu.Lock()
+			if j.dirty {
+				if err := j.file.Sync(); err != nil {
+					slog.Error("Journal sync failed", "error", err)
+				}
+				j.dirty = false
+			}
+			j.mu.Unlock()
+		case <-j.stop:
+			return
+		}
+	}
+}
+
+// readJournalFile reads the entries in one journal file. A missing file has no entries.
+func readJournalFile(path string) ([]JournalEntry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []JournalEntry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for line := 1; scanner.Scan(); line++ {
+		var e JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			// Only the last line can be torn by a crash; anything else is corruption
+			if line == bytes.Count(data, []byte{'\n'})+1 {
+				slog.Warn("Ignoring incomplete final journal entry", "file", path)
+				break
+			}
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+// journalReplayer applies journal entries to rooms using the same components as
+// RoomService, with a clock and game generator that return each entry's recorded
+// time and game.
+type journalReplayer struct {
+	at        time.Time
+	game      *model.Game
+	seed      int64
+	playerMgr PlayerManager
+	gameMgr   GameLifecycle
+	solutions SolutionManager
+	hints     HintManager
+	teams     TeamManager
+	matches   MatchManager
+	handicaps HandicapManager
+}
+
+// newJournalReplayer creates a replayer that decides winners with the given hint
+// penalty, as the service that wrote the journal did.
+func newJournalReplayer(hintPenalty int) *journalReplayer {
+	r := &journalReplayer{}
+	clock := func() time.Time { return r.at }
+	r.playerMgr = &playerManager{now: clock}
+	r.solutions = &solutionManager{now: clock, hintPenalty: hintPenalty}
+	// Only the hint tiers matter on replay, so the solver is skipped
+	r.hints = &hintManager{now: clock, solve: func(*model.Game) ([]model.BotPosition, bool) { return nil, true }}
+	r.teams = &teamManager{solutionMgr: r.solutions, now: clock}
+	r.matches = &matchManager{now: clock}
+	r.handicaps = &handicapManager{now: clock}
+	r.gameMgr = &gameLifecycle{
+		solutionMgr: r.solutions,
+		now:         clock,
+		nextGame:    func(*model.Game, int64) (*model.Game, int64) { return r.game, r.seed },
+	}
+	return r
+}
+
+// replayJournal applies entries to rooms in order and returns how many were applied.
+// Entries a room's snapshot already includes (Seq <= Room.JournalSeq) are skipped.
+// Signals are ignored: the game transitions they trigger have their own entries.
+func replayJournal(rooms map[string]*Room, entries []JournalEntry, hintPenalty int) int {
+	r := newJournalReplayer(hintPenalty)
+	applied := 0
+	for _, e := range entries {
+		if room := rooms[e.RoomID]; room != nil && e.Seq <= room.JournalSeq {
+			continue
+		}
+		if err := r.apply(rooms, e); err != nil {
+			slog.Warn("Skipping journal entry", logging.RoomID(e.RoomID), "seq", e.Seq, "op", e.Op, "error", err)
+			continue
+		}
+		if room := rooms[e.RoomID]; room != nil {
+			room.JournalSeq = e.Seq
+		}
+		applied++
+	}
+	return applied
+}
+
+// apply applies a single entry.
+func (r *journalReplayer) apply(rooms map[string]*Room, e JournalEntry) error {
+	r.at = e.Time
+	r.game = e.Game
+	r.seed = e.Seed
+
+	if e.Op == OpCreate {
+		room := newRoom(e.RoomID, e.PlayerID, e.Name, e.Time)
+		room.Players[0].AccountID = e.AccountID
+		rooms[e.RoomID] = room
+		return nil
+	}
+
+	room := rooms[e.RoomID]
+	if room == nil {
+		return fmt.Errorf("room not found: %s", e.RoomID)
+	}
+
+	var err error
+	switch e.Op {
+	case OpJoin:
+		if _, err = r.playerMgr.AddPlayer(room, e.Name); err == nil {
+			room.Players[len(room.Players)-1].ID = e.PlayerID
+			room.Players[len(room.Players)-1].AccountID = e.AccountID
+			room.Players[len(room.Players)-1].Bot = e.Bot
+		}
+	case OpStart:
+		_, err = r.gameMgr.StartGame(room)
+	case OpSubmit:
+		_, _, err = r.solutions.SubmitSolution(room, e.PlayerID, e.Moves)
+	case OpRetract:
+		_, err = r.solutions.RetractSolution(room, e.PlayerID)
+	case OpHint:
+		solution, _ := r.hints.Solve(room.CurrentGame)
+		_, err = r.hints.RequestHint(room, e.PlayerID, room.CurrentGame, solution)
+	case OpTeam:
+		_, err = r.teams.SetTeam(room, e.PlayerID, e.Team)
+	case OpTeamQuorum:
+		_, err = r.teams.SetTeamQuorum(room, e.Enabled)
+	case OpMatchStart:
+		_, err = r.matches.StartMatch(room, e.Rounds, e.FirstTo, e.Seed)
+	case OpMatchStop:
+		_, err = r.matches.StopMatch(room)
+	case OpHandicap:
+		_, err = r.handicaps.SetHandicap(room, e.PlayerID, e.Handicap)
+	case OpFinish:
+		_, err = r.gameMgr.MarkFinishedSolving(room, e.PlayerID)
+	case OpReady:
+		_, err = r.gameMgr.MarkReadyForNext(room, e.PlayerID)
+	case OpEndGame:
+		r.gameMgr.EndGame(room)
+	case OpNextGame:
+		r.gameMgr.StartNextGame(room)
+	case OpSolved:
+		if !room.setOptimalMoves(e.Time, e.Optimal) {
+			err = fmt.Errorf("no game ended at %v", e.Time)
+		}
+	case OpDisconnect:
+		_, err = r.playerMgr.DisconnectPlayer(room, e.PlayerID)
+	case OpReconnect:
+		_, err = r.playerMgr.ReconnectPlayer(room, e.PlayerID)
+	case OpRemove:
+		r.playerMgr.RemovePlayer(room, e.PlayerID)
+	case OpDelete:
+		delete(rooms, e.RoomID)
+	default:
+		err = fmt.Errorf("unknown op %q", e.Op)
+	}
+	return err
+}
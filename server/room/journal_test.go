@@ -0,0 +1,180 @@
+package room
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+func openTestJournal(t *testing.T, path string) *Journal {
+	t.Helper()
+	j, err := OpenJournal(path, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("OpenJournal failed: %v", err)
+	}
+	return j
+}
+
+func TestJournal_AppendAndReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rooms.journal")
+
+	j := openTestJournal(t, path)
+	for _, op := range []JournalOp{OpCreate, OpJoin} {
+		if _, err := j.Append(JournalEntry{Op: op, RoomID: "ROOM1"}); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+	if err := j.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	// Reopening continues the sequence
+	j = openTestJournal(t, path)
+	defer j.Close()
+	seq, err := j.Append(JournalEntry{Op: OpStart, RoomID: "ROOM1"})
+	if err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if seq != 3 {
+		t.Errorf("expected seq 3 after reopen, got %d", seq)
+	}
+
+	entries, err := j.Entries()
+	if err != nil {
+		t.Fatalf("Entries failed: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+	for i, want := range []JournalOp{OpCreate, OpJoin, OpStart} {
+		if entries[i].Op != want || entries[i].Seq != uint64(i+1) {
+			t.Errorf("entry %d: expected %s with seq %d, got %s with seq %d", i, want, i+1, entries[i].Op, entries[i].Seq)
+		}
+	}
+}
+
+func TestJournal_IgnoresTornFinalEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rooms.journal")
+	data := `{"seq":1,"op":"create","room":"ROOM1"}` + "\n" + `{"seq":2,"op":"jo`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := readJournalFile(path)
+	if err != nil {
+		t.Fatalf("expected torn final entry to be ignored, got %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected 1 entry, got %d", len(entries))
+	}
+}
+
+func TestJournal_RejectsCorruptEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rooms.journal")
+	data := `not json` + "\n" + `{"seq":2,"op":"join","room":"ROOM1"}` + "\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readJournalFile(path); err == nil {
+		t.Error("expected error for corrupt entry before the end of the journal")
+	}
+}
+
+func TestJournal_RotateAndDiscard(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rooms.journal")
+	j := openTestJournal(t, path)
+	defer j.Close()
+
+	j.Append(JournalEntry{Op: OpCreate, RoomID: "ROOM1"})
+	if err := j.Rotate(); err != nil {
+		t.Fatalf("Rotate failed: %v", err)
+	}
+	j.Append(JournalEntry{Op: OpJoin, RoomID: "ROOM1"})
+
+	// Rotated entries are still read until discarded
+	entries, _ := j.Entries()
+	if len(entries) != 2 || entries[0].Op != OpCreate || entries[1].Op != OpJoin {
+		t.Fatalf("expected create then join, got %+v", entries)
+	}
+
+	if err := j.DiscardRotated(); err != nil {
+		t.Fatalf("DiscardRotated failed: %v", err)
+	}
+	entries, _ = j.Entries()
+	if len(entries) != 1 || entries[0].Seq != 2 {
+		t.Errorf("expected only entry 2 after discard, got %+v", entries)
+	}
+}
+
+func TestReplayJournal_SkipsEntriesInSnapshot(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	room := newRoom("ROOM1", "p1", "Alice", created)
+	room.JournalSeq = 1
+	rooms := map[string]*Room{"ROOM1": room}
+
+	entries := []JournalEntry{
+		{Seq: 1, Op: OpJoin, RoomID: "ROOM1", Time: created, PlayerID: "p2", Name: "Bob"},
+		{Seq: 2, Op: OpJoin, RoomID: "ROOM1", Time: created.Add(time.Minute), PlayerID: "p3", Name: "Carol"},
+	}
+
+	if applied := replayJournal(rooms, entries, 0); applied != 1 {
+		t.Errorf("expected 1 entry applied, got %d", applied)
+	}
+	if len(room.Players) != 2 || room.Players[1].ID != "p3" {
+		t.Fatalf("expected Alice and Carol, got %+v", room.Players)
+	}
+	if room.JournalSeq != 2 {
+		t.Errorf("expected JournalSeq 2, got %d", room.JournalSeq)
+	}
+	if !room.LastActivityAt.Equal(created.Add(time.Minute)) {
+		t.Errorf("expected replayed activity time, got %v", room.LastActivityAt)
+	}
+}
+
+func TestReplayJournal_Game(t *testing.T) {
+	at := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	rooms := map[string]*Room{}
+
+	entries := []JournalEntry{
+		{Seq: 1, Op: OpCreate, RoomID: "ROOM1", Time: at, PlayerID: "p1", Name: "Alice"},
+		{Seq: 2, Op: OpStart, RoomID: "ROOM1", Time: at, Game: model.Game1()},
+		{Seq: 3, Op: OpSubmit, RoomID: "ROOM1", Time: at.Add(time.Second), PlayerID: "p1", Moves: validSolution()},
+		{Seq: 4, Op: OpFinish, RoomID: "ROOM1", Time: at.Add(2 * time.Second), PlayerID: "p1"},
+		{Seq: 5, Op: OpEndGame, RoomID: "ROOM1"},
+	}
+
+	if applied := replayJournal(rooms, entries, 0); applied != len(entries) {
+		t.Errorf("expected %d entries applied, got %d", len(entries), applied)
+	}
+	room := rooms["ROOM1"]
+	if room == nil {
+		t.Fatal("expected room to be created by replay")
+	}
+	if room.Wins["p1"] != 1 {
+		t.Errorf("expected Alice to have 1 win, got %d", room.Wins["p1"])
+	}
+	if room.GamesPlayed != 1 {
+		t.Errorf("expected 1 game played, got %d", room.GamesPlayed)
+	}
+}
+
+func TestReplayJournal_Delete(t *testing.T) {
+	rooms := map[string]*Room{"ROOM1": newRoom("ROOM1", "p1", "Alice", time.Now())}
+
+	entries := []JournalEntry{
+		{Seq: 1, Op: OpDelete, RoomID: "ROOM1"},
+		{Seq: 2, Op: OpJoin, RoomID: "ROOM1", PlayerID: "p2", Name: "Bob"},
+	}
+
+	// The join after the delete has no room to apply to
+	if applied := replayJournal(rooms, entries, 0); applied != 1 {
+		t.Errorf("expected 1 entry applied, got %d", applied)
+	}
+	if _, ok := rooms["ROOM1"]; ok {
+		t.Error("expected room to be deleted")
+	}
+}
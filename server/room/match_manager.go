@@ -0,0 +1,242 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrMatchLocked is returned when a match is started while a game is in
+// progress, which would count a game some players began outside it.
+var ErrMatchLocked = errors.New("matches can only start between games")
+
+// ErrMatchInProgress is returned when a match is started in a room already playing one.
+var ErrMatchInProgress = errors.New("a match is already in progress")
+
+// ErrNoMatch is returned when a match is stopped in a room not playing one.
+var ErrNoMatch = errors.New("no match in progress")
+
+// Match is a series of games that ends after a number of rounds, or once a
+// player reaches a number of wins, and crowns the player with the most wins.
+// Room.Wins keeps counting across matches; a match counts its own.
+type Match struct {
+	Rounds       int   // Games in the match, 0 for no limit
+	FirstTo      int   // Wins that take the match, 0 for no limit
+	Seed         int64 // Seeds every game, so rooms sharing it play the same puzzles; 0 if unseeded
+	StartedAt    time.Time
+	EndedAt      *time.Time     // Nil while the match is in progress
+	GamesPlayed  int            // Games completed in the match
+	GamesStarted int            // Games started in the match, counting ones skipped without a solution
+	Wins         map[string]int // Wins in the match per player ID
+	ChampionID   string         // Player with the most wins once over, empty if tied or stopped early
+}
+
+// InProgress reports whether the match is still being played.
+func (m *Match) InProgress() bool {
+	return m != nil && m.EndedAt == nil
+}
+
+// roundSeed returns the seed of the match's next game. It counts every game
+// started, so a game skipped without a solution isn't dealt again.
+func (m *Match) roundSeed() int64 {
+	return m.Seed + int64(m.GamesStarted)
+}
+
+// startMatchGame counts a game started in the room toward its match, if one is
+// in progress.
+func startMatchGame(room *Room) {
+	if room.Match.InProgress() {
+		room.Match.GamesStarted++
+	}
+}
+
+// decided reports whether the match has played all its rounds or a player has
+// reached FirstTo wins.
+func (m *Match) decided() bool {
+	if m.Rounds > 0 && m.GamesPlayed >= m.Rounds {
+		return true
+	}
+	if m.FirstTo > 0 {
+		for _, wins := range m.Wins {
+			if wins >= m.FirstTo {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// leader returns the player with the most wins, or empty if nobody has won or
+// the lead is tied.
+func (m *Match) leader() string {
+	leader, best, tied := "", 0, false
+	for playerID, wins := range m.Wins {
+		switch {
+		case wins > best:
+			leader, best, tied = playerID, wins, false
+		case wins == best:
+			tied = true
+		}
+	}
+	if tied {
+		return ""
+	}
+	return leader
+}
+
+// MatchStanding is a player's score in a match.
+type MatchStanding struct {
+	PlayerID   string `json:"playerId"`
+	PlayerName string `json:"playerName"` // Empty if the player has left
+	Wins       int    `json:"wins"`
+}
+
+// ToProto converts a MatchStanding to its protobuf representation.
+func (s MatchStanding) ToProto() *pb.MatchStanding {
+	return &pb.MatchStanding{PlayerId: s.PlayerID, PlayerName: s.PlayerName, Wins: int32(s.Wins)}
+}
+
+// matchStandings returns the match scores of the room's players and of anyone
+// who has left with wins, most wins first, then in room order.
+func (r *Room) matchStandings() []MatchStanding {
+	if r.Match == nil {
+		return nil
+	}
+	var standings []MatchStanding
+	for _, p := range r.Players {
+		standings = append(standings, MatchStanding{PlayerID: p.ID, PlayerName: p.Name, Wins: r.Match.Wins[p.ID]})
+	}
+	var departed []string
+	for playerID := range r.Match.Wins {
+		if r.FindPlayerIndex(playerID) == -1 {
+			departed = append(departed, playerID)
+		}
+	}
+	slices.Sort(departed)
+	for _, playerID := range departed {
+		standings = append(standings, MatchStanding{PlayerID: playerID, Wins: r.Match.Wins[playerID]})
+	}
+	slices.SortStableFunc(standings, func(a, b MatchStanding) int { return b.Wins - a.Wins })
+	return standings
+}
+
+// matchToProto converts the room's match to its protobuf representation, or
+// returns nil if the room has never played one.
+func (r *Room) matchToProto() *pb.Match {
+	m := r.Match
+	if m == nil {
+		return nil
+	}
+	out := &pb.Match{
+		Rounds:      int32(m.Rounds),
+		FirstTo:     int32(m.FirstTo),
+		Seed:        m.Seed,
+		StartedAt:   timestamppb.New(m.StartedAt),
+		GamesPlayed: int32(m.GamesPlayed),
+		ChampionId:  m.ChampionID,
+	}
+	if m.EndedAt != nil {
+		out.EndedAt = timestamppb.New(*m.EndedAt)
+	}
+	for _, s := range r.matchStandings() {
+		out.Standings = append(out.Standings, s.ToProto())
+	}
+	return out
+}
+
+// creditMatchGame counts a completed game toward the room's match, if one is in
+// progress, and ends the match once it is decided. Returns signals.
+func creditMatchGame(room *Room, winnerID string, now time.Time) []Signal {
+	m := room.Match
+	if !m.InProgress() {
+		return nil
+	}
+	m.GamesPlayed++
+	if winnerID != "" {
+		if m.Wins == nil {
+			m.Wins = make(map[string]int)
+		}
+		m.Wins[winnerID]++
+	}
+	if !m.decided() {
+		return nil
+	}
+	return endMatch(room, m.leader(), now)
+}
+
+// endMatch ends the room's match with a champion, who may be nobody, and
+// broadcasts the final standings.
+func endMatch(room *Room, championID string, now time.Time) []Signal {
+	m := room.Match
+	m.EndedAt = &now
+	m.ChampionID = championID
+	return []Signal{
+		BroadcastSignal{Event: MatchOverEvent{
+			RoomID:       room.ID,
+			ChampionID:   championID,
+			ChampionName: room.GetPlayerName(championID),
+			GamesPlayed:  m.GamesPlayed,
+			Standings:    room.matchStandings(),
+		}},
+	}
+}
+
+// MatchManager runs matches: series of games with their own standings and champion.
+type MatchManager interface {
+	// StartMatch starts a match of the given rounds or first to the given wins,
+	// counting from the room's next game. A nonzero seed generates every game of
+	// the match from it, so rooms given the same seed play the same puzzles.
+	// Returns signals or error.
+	StartMatch(room *Room, rounds, firstTo int, seed int64) ([]Signal, error)
+
+	// StopMatch ends the match in progress early, without a champion.
+	// Returns signals or error.
+	StopMatch(room *Room) ([]Signal, error)
+}
+
+// matchManager is the concrete implementation of MatchManager.
+type matchManager struct {
+	now func() time.Time // Clock, replaced when replaying the journal
+}
+
+// NewMatchManager creates a new MatchManager.
+func NewMatchManager() MatchManager {
+	return &matchManager{now: time.Now}
+}
+
+func (mm *matchManager) StartMatch(room *Room, rounds, firstTo int, seed int64) ([]Signal, error) {
+	if rounds < 0 || firstTo < 0 {
+		return nil, fmt.Errorf("rounds and wins must not be negative")
+	}
+	if rounds == 0 && firstTo == 0 {
+		return nil, fmt.Errorf("a match needs a number of rounds or wins")
+	}
+	if room.Match.InProgress() {
+		return nil, ErrMatchInProgress
+	}
+	if !room.betweenGames() {
+		return nil, ErrMatchLocked
+	}
+
+	now := mm.now()
+	room.Match = &Match{Rounds: rounds, FirstTo: firstTo, Seed: seed, StartedAt: now}
+	room.LastActivityAt = now
+
+	return []Signal{
+		BroadcastSignal{Event: MatchStartedEvent{RoomID: room.ID, Rounds: rounds, FirstTo: firstTo, Seed: seed}},
+	}, nil
+}
+
+func (mm *matchManager) StopMatch(room *Room) ([]Signal, error) {
+	if !room.Match.InProgress() {
+		return nil, ErrNoMatch
+	}
+
+	now := mm.now()
+	room.LastActivityAt = now
+	return endMatch(room, "", now), nil
+}
@@ -0,0 +1,151 @@
+package room
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+func TestMatchManager_StartMatch(t *testing.T) {
+	mm := NewMatchManager()
+	room := teamRoom()
+
+	signals, err := mm.StartMatch(room, 5, 3, 42)
+	if err != nil {
+		t.Fatalf("StartMatch failed: %v", err)
+	}
+	if !room.Match.InProgress() || room.Match.Rounds != 5 || room.Match.FirstTo != 3 || room.Match.Seed != 42 {
+		t.Errorf("expected a 5 round, first to 3 match seeded 42, got %+v", room.Match)
+	}
+	if len(signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(signals))
+	}
+	if _, ok := signals[0].(BroadcastSignal).Event.(MatchStartedEvent); !ok {
+		t.Errorf("expected MatchStartedEvent, got %+v", signals[0])
+	}
+
+	if _, err := mm.StartMatch(room, 5, 0, 0); !errors.Is(err, ErrMatchInProgress) {
+		t.Errorf("expected ErrMatchInProgress, got %v", err)
+	}
+}
+
+func TestMatchManager_StartMatch_Invalid(t *testing.T) {
+	mm := NewMatchManager()
+
+	if _, err := mm.StartMatch(teamRoom(), 0, 0, 0); err == nil {
+		t.Error("expected error for a match without rounds or wins")
+	}
+	if _, err := mm.StartMatch(teamRoom(), -1, 3, 0); err == nil {
+		t.Error("expected error for negative rounds")
+	}
+
+	room := teamRoom()
+	room.CurrentGame = model.Game1()
+	if _, err := mm.StartMatch(room, 3, 0, 0); !errors.Is(err, ErrMatchLocked) {
+		t.Errorf("expected ErrMatchLocked during a game, got %v", err)
+	}
+}
+
+func TestMatchManager_StopMatch(t *testing.T) {
+	mm := NewMatchManager()
+	room := teamRoom()
+
+	if _, err := mm.StopMatch(room); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("expected ErrNoMatch, got %v", err)
+	}
+
+	mm.StartMatch(room, 5, 0, 0)
+	room.Match.Wins = map[string]int{"alice": 2}
+	signals, err := mm.StopMatch(room)
+	if err != nil {
+		t.Fatalf("StopMatch failed: %v", err)
+	}
+	if room.Match.InProgress() || room.Match.ChampionID != "" {
+		t.Errorf("expected the match over without a champion, got %+v", room.Match)
+	}
+	event, ok := signals[0].(BroadcastSignal).Event.(MatchOverEvent)
+	if !ok || event.ChampionID != "" || event.Standings[0].PlayerID != "alice" {
+		t.Errorf("expected MatchOverEvent without a champion and alice first, got %+v", signals[0])
+	}
+
+	// A new match can start once it's over
+	if _, err := mm.StartMatch(room, 3, 0, 0); err != nil {
+		t.Errorf("expected a new match to start, got %v", err)
+	}
+}
+
+func TestCreditMatchGame(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		match    Match
+		winners  []string
+		champion string // Empty for no champion
+		over     bool
+	}{
+		{"rounds played", Match{Rounds: 3}, []string{"alice", "bob", "alice"}, "alice", true},
+		{"rounds left", Match{Rounds: 3}, []string{"alice", "bob"}, "", false},
+		{"first to wins", Match{FirstTo: 2}, []string{"bob", "", "bob"}, "bob", true},
+		{"first to before rounds", Match{Rounds: 5, FirstTo: 2}, []string{"carol", "carol"}, "carol", true},
+		{"tied", Match{Rounds: 2}, []string{"alice", "bob"}, "", true},
+		{"nobody won", Match{Rounds: 1}, []string{""}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room := teamRoom()
+			room.Match = &tt.match
+			var signals []Signal
+			for _, w := range tt.winners {
+				signals = append(signals, creditMatchGame(room, w, now)...)
+			}
+
+			if room.Match.GamesPlayed != len(tt.winners) {
+				t.Errorf("expected %d games played, got %d", len(tt.winners), room.Match.GamesPlayed)
+			}
+			if room.Match.InProgress() == tt.over {
+				t.Fatalf("expected over %v, got %+v", tt.over, room.Match)
+			}
+			if room.Match.ChampionID != tt.champion {
+				t.Errorf("expected champion %q, got %q", tt.champion, room.Match.ChampionID)
+			}
+			if hasSignal[BroadcastSignal](signals) != tt.over {
+				t.Errorf("expected a match over broadcast only once over, got %v", signals)
+			}
+		})
+	}
+}
+
+func TestCreditMatchGame_IgnoresFinishedMatch(t *testing.T) {
+	room := teamRoom()
+	ended := time.Now()
+	room.Match = &Match{Rounds: 1, GamesPlayed: 1, EndedAt: &ended}
+
+	if signals := creditMatchGame(room, "alice", time.Now()); signals != nil {
+		t.Errorf("expected no signals, got %v", signals)
+	}
+	if room.Match.GamesPlayed != 1 || room.Match.Wins["alice"] != 0 {
+		t.Errorf("expected the finished match unchanged, got %+v", room.Match)
+	}
+}
+
+func TestRoom_MatchStandings(t *testing.T) {
+	room := teamRoom()
+	room.Match = &Match{Wins: map[string]int{"carol": 2, "gone": 3, "alice": 1}}
+
+	standings := room.matchStandings()
+	want := []string{"gone", "carol", "alice", "bob", "dave"}
+	if len(standings) != len(want) {
+		t.Fatalf("expected %d standings, got %v", len(want), standings)
+	}
+	for i, id := range want {
+		if standings[i].PlayerID != id {
+			t.Errorf("standing %d: expected %s, got %s", i, id, standings[i].PlayerID)
+		}
+	}
+	if standings[0].PlayerName != "" || standings[1].PlayerName != "Carol" {
+		t.Errorf("expected names only for players in the room, got %v", standings)
+	}
+}
@@ -0,0 +1,274 @@
+package room
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// currentVersion is the persisted format version written by Save.
+//
+// Version history:
+//   - 1: original format. Rooms saved before LastActivityAt existed lack it.
+//   - 2: every room has LastActivityAt; rooms may carry a JournalSeq.
+//   - 3: rooms have a History of completed games.
+//   - 4: rooms and game records have a game Seed and a SolutionLog of submissions and retractions.
+//   - 5: players may have an AccountID; game records have the AccountIDs of their players.
+//   - 6: players may be bots, with a Bot level.
+//   - 7: rooms have the Hints players took this game; solutions record their player's Hints.
+//   - 8: players may be on a Team; rooms have TeamWins and a TeamQuorum setting.
+//   - 9: rooms may have a Match.
+//   - 10: players may have a Handicap; solutions record their player's Handicap.
+//   - 11: matches count GamesStarted, which seeds their games.
+const currentVersion = 11
+
+// migration upgrades a persisted document by one version. Documents are decoded
+// generically, so a migration can rename or restructure fields the current
+// types no longer have.
+type migration func(doc map[string]interface{}) error
+
+// migrations[v] upgrades a document from version v to v+1. To change the format,
+// bump currentVersion, add its migration here and add a golden file in testdata.
+var migrations = map[int]migration{
+	1:  migrateV1ToV2,
+	2:  migrateV2ToV3,
+	3:  migrateV3ToV4,
+	4:  migrateV4ToV5,
+	5:  migrateV5ToV6,
+	6:  migrateV6ToV7,
+	7:  migrateV7ToV8,
+	8:  migrateV8ToV9,
+	9:  migrateV9ToV10,
+	10: migrateV10ToV11,
+}
+
+// migrate upgrades persisted data to currentVersion and returns it with the
+// version it was read at. Data from a newer version is refused rather than
+// loaded with unknown fields silently dropped.
+func migrate(data []byte) ([]byte, int, error) {
+	var doc map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber() // keep integers exact through the round trip
+	if err := dec.Decode(&doc); err != nil {
+		return nil, 0, err
+	}
+
+	version, err := documentVersion(doc)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version > currentVersion {
+		return nil, version, fmt.Errorf("room data version %d is newer than supported version %d", version, currentVersion)
+	}
+	if version == currentVersion {
+		return data, version, nil
+	}
+
+	for v := version; v < currentVersion; v++ {
+		m, ok := migrations[v]
+		if !ok {
+			return nil, version, fmt.Errorf("no migration from room data version %d", v)
+		}
+		if err := m(doc); err != nil {
+			return nil, version, fmt.Errorf("migrating room data from version %d: %w", v, err)
+		}
+		doc["version"] = v + 1
+	}
+
+	migrated, err := json.Marshal(doc)
+	return migrated, version, err
+}
+
+// documentVersion returns the version of a persisted document.
+// A missing version is read as 1, the first format.
+func documentVersion(doc map[string]interface{}) (int, error) {
+	raw, ok := doc["version"]
+	if !ok || raw == nil {
+		return 1, nil
+	}
+	n, ok := raw.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("invalid room data version: %v", raw)
+	}
+	version, err := n.Int64()
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid room data version: %v", raw)
+	}
+	if version == 0 {
+		return 1, nil
+	}
+	return int(version), nil
+}
+
+// forEachRoom calls fn with each room object in a persisted document.
+func forEachRoom(doc map[string]interface{}, fn func(room map[string]interface{}) error) error {
+	rooms, _ := doc["rooms"].(map[string]interface{})
+	for id, v := range rooms {
+		room, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("room %s is not an object", id)
+		}
+		if err := fn(room); err != nil {
+			return fmt.Errorf("room %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// migrateV1ToV2 sets LastActivityAt to CreatedAt for rooms saved before it existed,
+// so they aren't cleaned up as stale on the first pass.
+func migrateV1ToV2(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		if at, _ := room["LastActivityAt"].(string); at == "" || at == zeroTimeJSON {
+			room["LastActivityAt"] = room["CreatedAt"]
+		}
+		return nil
+	})
+}
+
+// migrateV2ToV3 starts every room with an empty history; games before version 3 weren't kept.
+func migrateV2ToV3(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		room["History"] = nil
+		return nil
+	})
+}
+
+// migrateV3ToV4 marks the seeds and solution logs of earlier games as unknown;
+// neither was kept before version 4.
+func migrateV3ToV4(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		room["GameSeed"] = 0
+		room["SolutionLog"] = nil
+		history, _ := room["History"].([]interface{})
+		for _, v := range history {
+			if rec, ok := v.(map[string]interface{}); ok {
+				rec["Seed"] = 0
+				rec["SolutionLog"] = nil
+			}
+		}
+		return nil
+	})
+}
+
+// migrateV4ToV5 makes every existing player a guest; accounts didn't exist before version 5.
+func migrateV4ToV5(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		players, _ := room["Players"].([]interface{})
+		for _, v := range players {
+			if p, ok := v.(map[string]interface{}); ok {
+				p["AccountID"] = ""
+			}
+		}
+		history, _ := room["History"].([]interface{})
+		for _, v := range history {
+			if rec, ok := v.(map[string]interface{}); ok {
+				rec["AccountIDs"] = nil
+			}
+		}
+		return nil
+	})
+}
+
+// migrateV5ToV6 makes every existing player a person; bots didn't exist before version 6.
+func migrateV5ToV6(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		players, _ := room["Players"].([]interface{})
+		for _, v := range players {
+			if p, ok := v.(map[string]interface{}); ok {
+				p["Bot"] = ""
+			}
+		}
+		return nil
+	})
+}
+
+// migrateV6ToV7 records that nobody has taken hints; they didn't exist before
+// version 7. Solutions without Hints load as HintNone.
+func migrateV6ToV7(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		room["Hints"] = nil
+		return nil
+	})
+}
+
+// migrateV7ToV8 takes every player off teams and leaves the team quorum off;
+// teams didn't exist before version 8.
+func migrateV7ToV8(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		players, _ := room["Players"].([]interface{})
+		for _, v := range players {
+			if p, ok := v.(map[string]interface{}); ok {
+				p["Team"] = ""
+			}
+		}
+		room["TeamWins"] = nil
+		room["TeamQuorum"] = false
+		return nil
+	})
+}
+
+// migrateV8ToV9 adds the match rooms didn't have before matches existed.
+func migrateV8ToV9(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		room["Match"] = nil
+		return nil
+	})
+}
+
+// migrateV9ToV10 gives every player no handicap; handicaps didn't exist before
+// version 10. Solutions without a Handicap load without one.
+func migrateV9ToV10(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		players, _ := room["Players"].([]interface{})
+		for _, v := range players {
+			if p, ok := v.(map[string]interface{}); ok {
+				p["Handicap"] = map[string]interface{}{"ExtraMoves": 0, "Delay": 0}
+			}
+		}
+		return nil
+	})
+}
+
+// migrateV10ToV11 counts the games each match has started. Before version 11
+// a seeded match dealt its game seeded Seed+GamesPlayed, so if the room's
+// current game has a seed in that range it was the match's last game started;
+// otherwise every started game was completed.
+func migrateV10ToV11(doc map[string]interface{}) error {
+	return forEachRoom(doc, func(room map[string]interface{}) error {
+		match, ok := room["Match"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		seed, err := jsonInt(match["Seed"])
+		if err != nil {
+			return fmt.Errorf("match seed: %w", err)
+		}
+		played, err := jsonInt(match["GamesPlayed"])
+		if err != nil {
+			return fmt.Errorf("match games played: %w", err)
+		}
+		started := played
+		if gameSeed, err := jsonInt(room["GameSeed"]); err == nil && room["CurrentGame"] != nil &&
+			seed != 0 && gameSeed >= seed && gameSeed <= seed+played {
+			started = gameSeed - seed + 1
+		}
+		match["GamesStarted"] = started
+		return nil
+	})
+}
+
+// jsonInt returns an integer decoded as a json.Number, or 0 if absent.
+func jsonInt(v interface{}) (int64, error) {
+	if v == nil {
+		return 0, nil
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expected a number, got %v", v)
+	}
+	return n.Int64()
+}
+
+// zeroTimeJSON is how a zero time.Time is encoded.
+const zeroTimeJSON = "0001-01-01T00:00:00Z"
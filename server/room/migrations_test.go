@@ -0,0 +1,311 @@
+package room
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden file for the current persisted version")
+
+// goldenPath returns the golden file holding goldenRoom saved at the given version.
+func goldenPath(version int) string {
+	return filepath.Join("testdata", fmt.Sprintf("rooms_v%d.json", version))
+}
+
+// goldenRoom is the room saved in every golden file, in its current form.
+func goldenRoom() *Room {
+	created := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	started := created.Add(10 * time.Minute)
+	solved := started.Add(45 * time.Second)
+	solution := PlayerSolution{PlayerID: "p1", SolvedAt: solved, Moves: validSolution(), Hints: HintMoveCount}
+	previousStart := created.Add(2 * time.Minute)
+
+	return &Room{
+		ID: "GOLD1",
+		Players: []Player{
+			{ID: "p1", AccountID: "a1", Name: "Alice", Status: PlayerStatusConnected, Team: "Red"},
+			{ID: "p2", Name: "Bob", Status: PlayerStatusDisconnected, DisconnectedAt: solved, Team: "Blue", Handicap: Handicap{ExtraMoves: 2, Delay: 5 * time.Second}},
+			{ID: "p3", Name: "Easy Bot", Status: PlayerStatusConnected, Bot: BotEasy, Team: "Red"},
+		},
+		CreatedAt:       created,
+		LastActivityAt:  solved,
+		CurrentGame:     model.Game1(),
+		GameSeed:        1234,
+		GameStartedAt:   &started,
+		Solutions:       []PlayerSolution{solution},
+		SolutionLog:     []SolutionEvent{{PlayerID: "p1", At: solved, Moves: validSolution()}},
+		SolutionHistory: []PlayerSolutionHistory{{PlayerID: "p1", Solutions: []PlayerSolution{solution}}},
+		Wins:            map[string]int{"p1": 1, "p2": 2},
+		GamesPlayed:     3,
+		FinishedSolving: []string{"p1"},
+		ReadyForNext:    []string{},
+		Hints:           map[string]HintTier{"p1": HintBots},
+		TeamWins:        map[string]int{"Blue": 2},
+		TeamQuorum:      true,
+		Match: &Match{
+			Rounds:       3,
+			Seed:         500,
+			StartedAt:    previousStart,
+			EndedAt:      &started,
+			GamesPlayed:  3,
+			GamesStarted: 3,
+			Wins:         map[string]int{"p1": 1, "p2": 2},
+			ChampionID:   "p2",
+		},
+		History: []GameRecord{{
+			Game:      model.Game1(),
+			Seed:      99,
+			StartedAt: &previousStart,
+			EndedAt:   started,
+			Solutions: []PlayerSolution{{PlayerID: "p2", SolvedAt: started, Moves: validSolution(), Hints: HintSolution, Handicap: Handicap{ExtraMoves: 2}}},
+			SolutionLog: []SolutionEvent{
+				{PlayerID: "p1", At: previousStart.Add(time.Minute), Moves: validSolution()},
+				{PlayerID: "p1", At: previousStart.Add(2 * time.Minute), Retracted: true, Moves: validSolution()},
+				{PlayerID: "p2", At: started, Moves: validSolution()},
+			},
+			PlayerNames:  map[string]string{"p1": "Alice", "p2": "Bob"},
+			AccountIDs:   map[string]string{"p1": "a1"},
+			WinnerID:     "p2",
+			OptimalMoves: 7,
+		}},
+		JournalSeq: 42,
+	}
+}
+
+// comparableDoc decodes a persisted document for comparison, dropping the save
+// time and games. Games list bots in map order; the load tests compare them.
+func comparableDoc(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid persisted JSON: %v", err)
+	}
+	delete(doc, "saved_at")
+	forEachRoom(doc, func(room map[string]interface{}) error {
+		delete(room, "CurrentGame")
+		history, _ := room["History"].([]interface{})
+		for _, rec := range history {
+			delete(rec.(map[string]interface{}), "Game")
+		}
+		return nil
+	})
+	return doc
+}
+
+// withoutSolutionLogs clears the seeds and solution logs versions before 4 didn't keep.
+func withoutSolutionLogs(r *Room) {
+	r.GameSeed = 0
+	r.SolutionLog = nil
+	for i := range r.History {
+		r.History[i].Seed = 0
+		r.History[i].SolutionLog = nil
+	}
+}
+
+// withoutAccounts makes every player a guest, as in versions before 5.
+func withoutAccounts(r *Room) {
+	for i := range r.Players {
+		r.Players[i].AccountID = ""
+	}
+	for i := range r.History {
+		r.History[i].AccountIDs = nil
+	}
+}
+
+// withoutBots removes the bot players versions before 6 couldn't have.
+func withoutBots(r *Room) {
+	r.Players = slices.DeleteFunc(r.Players, func(p Player) bool { return p.IsBot() })
+}
+
+// withoutHints clears the hints versions before 7 didn't keep.
+func withoutHints(r *Room) {
+	r.Hints = nil
+	none := func(solutions []PlayerSolution) {
+		for i := range solutions {
+			solutions[i].Hints = HintNone
+		}
+	}
+	none(r.Solutions)
+	for _, h := range r.SolutionHistory {
+		none(h.Solutions)
+	}
+	for _, rec := range r.History {
+		none(rec.Solutions)
+	}
+}
+
+// withoutTeams takes every player off the teams versions before 8 didn't have.
+func withoutTeams(r *Room) {
+	for i := range r.Players {
+		r.Players[i].Team = ""
+	}
+	r.TeamWins = nil
+	r.TeamQuorum = false
+}
+
+// withoutMatch clears the match versions before 9 didn't have.
+func withoutMatch(r *Room) {
+	r.Match = nil
+}
+
+// withoutHandicaps clears the handicaps versions before 10 didn't have.
+func withoutHandicaps(r *Room) {
+	for i := range r.Players {
+		r.Players[i].Handicap = Handicap{}
+	}
+	for _, rec := range r.History {
+		for i := range rec.Solutions {
+			rec.Solutions[i].Handicap = Handicap{}
+		}
+	}
+}
+
+func TestMigrations_CoverEveryVersion(t *testing.T) {
+	for v := 1; v < currentVersion; v++ {
+		if migrations[v] == nil {
+			t.Errorf("no migration from version %d", v)
+		}
+	}
+}
+
+// Each historical format must still load as the room it was saved from.
+func TestPersistenceManager_Load_GoldenFiles(t *testing.T) {
+	tests := []struct {
+		version int
+		want    func(r *Room) // adjusts goldenRoom for what the version could hold
+	}{
+		{1, func(r *Room) {
+			r.LastActivityAt = r.CreatedAt
+			r.JournalSeq = 0
+			r.History = nil
+			withoutSolutionLogs(r)
+			withoutAccounts(r)
+			withoutBots(r)
+			withoutHints(r)
+			withoutTeams(r)
+			withoutMatch(r)
+			withoutHandicaps(r)
+		}},
+		{2, func(r *Room) {
+			r.History = nil
+			withoutSolutionLogs(r)
+			withoutAccounts(r)
+			withoutBots(r)
+			withoutHints(r)
+			withoutTeams(r)
+			withoutMatch(r)
+			withoutHandicaps(r)
+		}},
+		{3, func(r *Room) {
+			withoutSolutionLogs(r)
+			withoutAccounts(r)
+			withoutBots(r)
+			withoutHints(r)
+			withoutTeams(r)
+			withoutMatch(r)
+			withoutHandicaps(r)
+		}},
+		{4, func(r *Room) {
+			withoutAccounts(r)
+			withoutBots(r)
+			withoutHints(r)
+			withoutTeams(r)
+			withoutMatch(r)
+			withoutHandicaps(r)
+		}},
+		{5, func(r *Room) { withoutBots(r); withoutHints(r); withoutTeams(r); withoutMatch(r); withoutHandicaps(r) }},
+		{6, func(r *Room) { withoutHints(r); withoutTeams(r); withoutMatch(r); withoutHandicaps(r) }},
+		{7, func(r *Room) { withoutTeams(r); withoutMatch(r); withoutHandicaps(r) }},
+		{8, func(r *Room) { withoutMatch(r); withoutHandicaps(r) }},
+		{9, withoutHandicaps},
+		{10, func(r *Room) {}},
+		{11, func(r *Room) {}},
+	}
+	if len(tests) != currentVersion {
+		t.Fatalf("expected a golden file test for each of %d versions, got %d", currentVersion, len(tests))
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("v%d", tt.version), func(t *testing.T) {
+			rooms, err := NewPersistenceManager().Load(goldenPath(tt.version))
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			want := goldenRoom()
+			tt.want(want)
+			assertRoomsEqual(t, want, rooms[want.ID])
+			if got := rooms[want.ID].JournalSeq; got != want.JournalSeq {
+				t.Errorf("expected JournalSeq %d, got %d", want.JournalSeq, got)
+			}
+		})
+	}
+}
+
+// Save must write exactly the current golden file, so any format change
+// shows up here and gets a new version, migration and golden file.
+func TestPersistenceManager_Save_MatchesGoldenFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+	room := goldenRoom()
+	if err := NewPersistenceManager().Save(filename, map[string]*Room{room.ID: room}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	saved, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *update {
+		if err := os.WriteFile(goldenPath(currentVersion), saved, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	golden, err := os.ReadFile(goldenPath(currentVersion))
+	if err != nil {
+		t.Fatalf("missing golden file (run with -update to create it): %v", err)
+	}
+	if !reflect.DeepEqual(comparableDoc(t, saved), comparableDoc(t, golden)) {
+		t.Errorf("saved format differs from %s; bump currentVersion and add a migration", goldenPath(currentVersion))
+	}
+}
+
+func TestPersistenceManager_Load_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+	data := fmt.Sprintf(`{"rooms":{},"saved_at":"2025-03-01T18:00:00Z","version":%d}`, currentVersion+1)
+	if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewPersistenceManager().Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
+
+func TestMigrate_KeepsUnknownFields(t *testing.T) {
+	data := []byte(`{"rooms":{"R":{"ID":"R","CreatedAt":"2025-03-01T18:00:00Z","Extra":{"n":12345678901234567}}},"version":1}`)
+
+	migrated, version, err := migrate(data)
+	if err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+	if version != 1 {
+		t.Errorf("expected to read version 1, got %d", version)
+	}
+	if !strings.Contains(string(migrated), `"Extra":{"n":12345678901234567}`) {
+		t.Errorf("expected unknown fields to pass through migrations unchanged, got %s", migrated)
+	}
+	if !strings.Contains(string(migrated), fmt.Sprintf(`"version":%d`, currentVersion)) {
+		t.Errorf("expected version %d after migration, got %s", currentVersion, migrated)
+	}
+}
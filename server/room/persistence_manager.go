@@ -2,7 +2,7 @@ package room
 
 import (
 	"encoding/json"
-	"log"
+	"log/slog"
 	"os"
 	"time"
 )
@@ -16,14 +16,23 @@ type PersistenceManager interface {
 	// Save saves the given rooms to the file.
 	Save(filename string, rooms map[string]*Room) error
 
+	// SaveRoom saves a single room after it changes.
+	// Backends that only write whole files rely on Save and do nothing here.
+	SaveRoom(filename string, room *Room) error
+
+	// DeleteRoom removes a single room from the file.
+	// Backends that only write whole files rely on Save and do nothing here.
+	DeleteRoom(filename, roomID string) error
+
 	// FindStaleRooms returns room IDs that have been inactive longer than maxAge.
 	FindStaleRooms(rooms map[string]*Room, maxAge time.Duration) []string
 }
 
 // persistenceManager is the concrete implementation of PersistenceManager.
+// It stores all rooms in a single JSON file.
 type persistenceManager struct{}
 
-// NewPersistenceManager creates a new PersistenceManager.
+// NewPersistenceManager creates a new PersistenceManager that writes a JSON file.
 func NewPersistenceManager() PersistenceManager {
 	return &persistenceManager{}
 }
@@ -38,7 +47,7 @@ type persistedData struct {
 func (pm *persistenceManager) Load(filename string) (map[string]*Room, error) {
 	data, err := os.ReadFile(filename)
 	if os.IsNotExist(err) {
-		log.Printf("No room data file found at %s, starting fresh", filename)
+		slog.Info("No room data file found, starting fresh", "file", filename)
 		return make(map[string]*Room), nil
 	}
 	if err != nil {
@@ -46,10 +55,18 @@ func (pm *persistenceManager) Load(filename string) (map[string]*Room, error) {
 	}
 
 	if len(data) == 0 {
-		log.Printf("Room data file is empty, starting fresh")
+		slog.Info("Room data file is empty, starting fresh", "file", filename)
 		return make(map[string]*Room), nil
 	}
 
+	data, version, err := migrate(data)
+	if err != nil {
+		return nil, err
+	}
+	if version != currentVersion {
+		slog.Info("Migrated room data", "file", filename, "from_version", version, "to_version", currentVersion)
+	}
+
 	var pd persistedData
 	if err := json.Unmarshal(data, &pd); err != nil {
 		return nil, err
@@ -60,18 +77,14 @@ func (pm *persistenceManager) Load(filename string) (map[string]*Room, error) {
 		rooms = make(map[string]*Room)
 	}
 
-	// Ensure Wins maps and LastActivityAt are initialized
+	// Ensure Wins maps are initialized
 	for _, room := range rooms {
 		if room.Wins == nil {
 			room.Wins = make(map[string]int)
 		}
-		// For backward compatibility: if LastActivityAt is zero, use CreatedAt
-		if room.LastActivityAt.IsZero() {
-			room.LastActivityAt = room.CreatedAt
-		}
 	}
 
-	log.Printf("Loaded %d rooms from %s (saved at %s)", len(rooms), filename, pd.SavedAt.Format(time.RFC3339))
+	slog.Info("Loaded rooms", "count", len(rooms), "file", filename, "saved_at", pd.SavedAt)
 	return rooms, nil
 }
 
@@ -79,7 +92,7 @@ func (pm *persistenceManager) Save(filename string, rooms map[string]*Room) erro
 	pd := persistedData{
 		Rooms:   rooms,
 		SavedAt: time.Now(),
-		Version: 1,
+		Version: currentVersion,
 	}
 
 	data, err := json.MarshalIndent(pd, "", "  ")
@@ -98,11 +111,24 @@ func (pm *persistenceManager) Save(filename string, rooms map[string]*Room) erro
 		return err
 	}
 
-	log.Printf("Saved %d rooms to %s", len(rooms), filename)
+	slog.Debug("Saved rooms", "count", len(rooms), "file", filename)
+	return nil
+}
+
+func (pm *persistenceManager) SaveRoom(filename string, room *Room) error {
+	return nil
+}
+
+func (pm *persistenceManager) DeleteRoom(filename, roomID string) error {
 	return nil
 }
 
 func (pm *persistenceManager) FindStaleRooms(rooms map[string]*Room, maxAge time.Duration) []string {
+	return findStaleRooms(rooms, maxAge)
+}
+
+// findStaleRooms returns room IDs that have been inactive longer than maxAge.
+func findStaleRooms(rooms map[string]*Room, maxAge time.Duration) []string {
 	cutoff := time.Now().Add(-maxAge)
 	var stale []string
 
@@ -5,11 +5,29 @@ import "time"
 // Player represents a player in a room.
 type Player struct {
 	ID             string
+	AccountID      string // Account the player is signed in to, empty for guests
 	Name           string
 	Status         PlayerStatus
 	DisconnectedAt time.Time
+	Bot            BotLevel // Skill of a computer opponent, empty for people
+	Team           string   // Team the player is on, empty if none
+	Handicap       Handicap // Evens out the player's solutions against stronger players
 }
 
+// IsBot reports whether the player is a computer opponent.
+func (p *Player) IsBot() bool {
+	return p.Bot != ""
+}
+
+// BotLevel is the skill of a computer opponent.
+type BotLevel string
+
+const (
+	BotEasy   BotLevel = "easy"
+	BotMedium BotLevel = "medium"
+	BotHard   BotLevel = "hard"
+)
+
 // PlayerStatus represents the connection status of a player.
 type PlayerStatus string
 
@@ -19,3 +37,10 @@ const (
 	// PlayerStatusDisconnected means the player has disconnected but is within the grace period.
 	PlayerStatusDisconnected PlayerStatus = "disconnected"
 )
+
+// Spectator represents a connection that watches a room without playing.
+// Spectators receive room events but never count toward game quorums.
+type Spectator struct {
+	ID   string
+	Name string
+}
@@ -23,14 +23,32 @@ type PlayerManager interface {
 	// RemovePlayer removes a disconnected player from the room.
 	// Returns signals indicating state changes (including potential game transitions).
 	RemovePlayer(room *Room, playerID string) []Signal
+
+	// AddSpectator adds a spectator to a room.
+	// Returns the new spectator and signals.
+	AddSpectator(room *Room, spectatorName string) (*Spectator, []Signal)
+
+	// DisconnectSpectator starts the grace period after a spectator's connection drops.
+	// Returns signals, or nil if the spectator was not found.
+	DisconnectSpectator(room *Room, spectatorID string) []Signal
+
+	// ReconnectSpectator ends a spectator's grace period.
+	// Returns signals or error.
+	ReconnectSpectator(room *Room, spectatorID string) ([]Signal, error)
+
+	// RemoveSpectator removes a spectator from the room.
+	// Returns signals, or nil if the spectator was not found.
+	RemoveSpectator(room *Room, spectatorID string) []Signal
 }
 
 // playerManager is the concrete implementation of PlayerManager.
-type playerManager struct{}
+type playerManager struct {
+	now func() time.Time // Clock, replaced when replaying the journal
+}
 
 // NewPlayerManager creates a new PlayerManager.
 func NewPlayerManager() PlayerManager {
-	return &playerManager{}
+	return &playerManager{now: time.Now}
 }
 
 func (pm *playerManager) AddPlayer(room *Room, playerName string) ([]Signal, error) {
@@ -40,7 +58,7 @@ func (pm *playerManager) AddPlayer(room *Room, playerName string) ([]Signal, err
 		Name:   playerName,
 		Status: PlayerStatusConnected,
 	})
-	room.LastActivityAt = time.Now()
+	room.LastActivityAt = pm.now()
 
 	signals := []Signal{
 		BroadcastSignal{Event: PlayerJoinedEvent{
@@ -62,7 +80,7 @@ func (pm *playerManager) DisconnectPlayer(room *Room, playerID string) ([]Signal
 
 	player := &room.Players[idx]
 	player.Status = PlayerStatusDisconnected
-	player.DisconnectedAt = time.Now()
+	player.DisconnectedAt = pm.now()
 
 	signals := []Signal{
 		StartTimerSignal{RoomID: room.ID, PlayerID: playerID},
@@ -103,6 +121,8 @@ func (pm *playerManager) RemovePlayer(room *Room, playerID string) []Signal {
 
 	// Track game state BEFORE removal
 	hasGame := room.CurrentGame != nil
+	ended := room.quorum(room.FinishedSolving)
+	started := room.quorum(room.ReadyForNext)
 
 	// Remove player
 	room.Players = append(room.Players[:idx], room.Players[idx+1:]...)
@@ -131,21 +151,81 @@ func (pm *playerManager) RemovePlayer(room *Room, playerID string) []Signal {
 		}
 	}
 
+	// Clean up from Hints
+	delete(room.Hints, playerID)
+
 	signals := []Signal{
 		CancelTimerSignal{PlayerID: playerID},
 		BroadcastSignal{Event: PlayerLeftEvent{RoomID: room.ID, PlayerID: playerID}},
 	}
 
-	// Check if removal triggers game state changes
-	if len(room.Players) > 0 {
-		// If game is active and all remaining players are finished, signal end game
-		if hasGame && len(room.FinishedSolving) == len(room.Players) {
-			signals = append(signals, EndGameSignal{RoomID: room.ID})
-		}
-		// If all remaining players are ready for next, signal start next game
-		if len(room.ReadyForNext) == len(room.Players) {
-			signals = append(signals, StartNextGameSignal{RoomID: room.ID})
-		}
+	// Check if removal triggers game state changes. Quorums already reached
+	// before the removal have been acted on.
+	// If game is active and all remaining human players are finished, signal end game
+	if hasGame && !ended && room.quorum(room.FinishedSolving) {
+		signals = append(signals, EndGameSignal{RoomID: room.ID})
+	}
+	// If all remaining human players are ready for next, signal start next game
+	if !started && room.quorum(room.ReadyForNext) {
+		signals = append(signals, StartNextGameSignal{RoomID: room.ID})
+	}
+
+	return signals
+}
+
+func (pm *playerManager) AddSpectator(room *Room, spectatorName string) (*Spectator, []Signal) {
+	spectator := Spectator{
+		ID:   generatePlayerID(),
+		Name: spectatorName,
+	}
+	room.Spectators = append(room.Spectators, spectator)
+
+	// The spectator has no connection yet, so they are removed unless one opens
+	// within the grace period.
+	signals := []Signal{
+		BroadcastSignal{Event: SpectatorJoinedEvent{
+			RoomID:        room.ID,
+			SpectatorID:   spectator.ID,
+			SpectatorName: spectator.Name,
+		}},
+		StartTimerSignal{RoomID: room.ID, PlayerID: spectator.ID, Spectator: true},
+	}
+
+	return &spectator, signals
+}
+
+func (pm *playerManager) DisconnectSpectator(room *Room, spectatorID string) []Signal {
+	if room.FindSpectatorIndex(spectatorID) == -1 {
+		return nil
+	}
+
+	return []Signal{
+		StartTimerSignal{RoomID: room.ID, PlayerID: spectatorID, Spectator: true},
+	}
+}
+
+func (pm *playerManager) ReconnectSpectator(room *Room, spectatorID string) ([]Signal, error) {
+	if room.FindSpectatorIndex(spectatorID) == -1 {
+		return nil, fmt.Errorf("spectator not found: %s", spectatorID)
+	}
+
+	return []Signal{
+		CancelTimerSignal{PlayerID: spectatorID},
+	}, nil
+}
+
+func (pm *playerManager) RemoveSpectator(room *Room, spectatorID string) []Signal {
+	idx := room.FindSpectatorIndex(spectatorID)
+	if idx == -1 {
+		return nil
+	}
+
+	room.Spectators = append(room.Spectators[:idx], room.Spectators[idx+1:]...)
+
+	// Spectators never count toward quorums, so removal cannot trigger game transitions
+	signals := []Signal{
+		BroadcastSignal{Event: SpectatorLeftEvent{RoomID: room.ID, SpectatorID: spectatorID}},
+		CancelTimerSignal{PlayerID: spectatorID},
 	}
 
 	return signals
@@ -421,3 +421,149 @@ func TestPlayerManager_RemovePlayer_NoTriggersIfNoPlayersLeft(t *testing.T) {
 		}
 	}
 }
+
+func TestPlayerManager_AddSpectator(t *testing.T) {
+	pm := NewPlayerManager()
+
+	room := &Room{
+		ID:      "TEST",
+		Players: []Player{{ID: "alice", Name: "Alice", Status: PlayerStatusConnected}},
+	}
+
+	spectator, signals := pm.AddSpectator(room, "Big Screen")
+
+	if spectator.ID == "" {
+		t.Error("expected spectator ID to be set")
+	}
+	if len(room.Spectators) != 1 {
+		t.Fatalf("expected 1 spectator, got %d", len(room.Spectators))
+	}
+	if room.Spectators[0].Name != "Big Screen" {
+		t.Errorf("expected spectator name 'Big Screen', got '%s'", room.Spectators[0].Name)
+	}
+	// Spectators must not be added as players
+	if len(room.Players) != 1 {
+		t.Errorf("expected 1 player, got %d", len(room.Players))
+	}
+
+	if len(signals) != 2 {
+		t.Fatalf("expected 2 signals, got %d", len(signals))
+	}
+	broadcast, ok := signals[0].(BroadcastSignal)
+	if !ok {
+		t.Fatal("expected BroadcastSignal")
+	}
+	// Until the spectator connects, they are on the disconnect timer
+	if timer, ok := signals[1].(StartTimerSignal); !ok || timer.PlayerID != spectator.ID || !timer.Spectator {
+		t.Errorf("expected a spectator StartTimerSignal, got %v", signals[1])
+	}
+	event, ok := broadcast.Event.(SpectatorJoinedEvent)
+	if !ok {
+		t.Fatal("expected SpectatorJoinedEvent")
+	}
+	if event.SpectatorID != spectator.ID {
+		t.Errorf("expected spectator ID %s in event, got %s", spectator.ID, event.SpectatorID)
+	}
+}
+
+func TestPlayerManager_RemoveSpectator(t *testing.T) {
+	pm := NewPlayerManager()
+
+	room := &Room{
+		ID:              "TEST",
+		Players:         []Player{{ID: "alice", Name: "Alice", Status: PlayerStatusConnected}},
+		CurrentGame:     model.Game1(),
+		FinishedSolving: []string{"alice"},
+		Spectators:      []Spectator{{ID: "screen", Name: "Big Screen"}},
+	}
+
+	signals := pm.RemoveSpectator(room, "screen")
+
+	if len(room.Spectators) != 0 {
+		t.Errorf("expected 0 spectators, got %d", len(room.Spectators))
+	}
+
+	// Only a broadcast and the timer - spectators never trigger game transitions
+	if len(signals) != 2 {
+		t.Fatalf("expected 2 signals, got %d", len(signals))
+	}
+	broadcast, ok := signals[0].(BroadcastSignal)
+	if !ok {
+		t.Fatal("expected BroadcastSignal")
+	}
+	if _, ok := broadcast.Event.(SpectatorLeftEvent); !ok {
+		t.Error("expected SpectatorLeftEvent")
+	}
+	if _, ok := signals[1].(CancelTimerSignal); !ok {
+		t.Error("expected CancelTimerSignal")
+	}
+}
+
+func TestPlayerManager_DisconnectReconnectSpectator(t *testing.T) {
+	pm := NewPlayerManager()
+
+	room := &Room{ID: "TEST", Spectators: []Spectator{{ID: "screen", Name: "Big Screen"}}}
+
+	signals := pm.DisconnectSpectator(room, "screen")
+	if len(signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(signals))
+	}
+	if timer, ok := signals[0].(StartTimerSignal); !ok || timer.PlayerID != "screen" || !timer.Spectator {
+		t.Errorf("expected a spectator StartTimerSignal, got %v", signals[0])
+	}
+	// The spectator stays in the room during the grace period
+	if len(room.Spectators) != 1 {
+		t.Errorf("expected 1 spectator, got %d", len(room.Spectators))
+	}
+
+	signals, err := pm.ReconnectSpectator(room, "screen")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(signals))
+	}
+	if _, ok := signals[0].(CancelTimerSignal); !ok {
+		t.Error("expected CancelTimerSignal")
+	}
+
+	if signals := pm.DisconnectSpectator(room, "nonexistent"); signals != nil {
+		t.Errorf("expected nil signals, got %v", signals)
+	}
+	if _, err := pm.ReconnectSpectator(room, "nonexistent"); err == nil {
+		t.Error("expected error for unknown spectator")
+	}
+}
+
+func TestPlayerManager_RemoveSpectator_NotFound(t *testing.T) {
+	pm := NewPlayerManager()
+
+	room := &Room{ID: "TEST"}
+
+	signals := pm.RemoveSpectator(room, "nonexistent")
+	if signals != nil {
+		t.Errorf("expected nil signals, got %v", signals)
+	}
+}
+
+func TestPlayerManager_RemovePlayer_NoTriggersIfOnlyBotsLeft(t *testing.T) {
+	pm := NewPlayerManager()
+
+	room := &Room{
+		ID: "TEST",
+		Players: []Player{
+			{ID: "alice", Name: "Alice", Status: PlayerStatusDisconnected},
+			{ID: "bot", Name: "Easy Bot", Status: PlayerStatusConnected, Bot: BotEasy},
+		},
+		CurrentGame:     model.Game1(),
+		FinishedSolving: []string{"bot"},
+		ReadyForNext:    []string{"bot"},
+	}
+
+	for _, sig := range pm.RemovePlayer(room, "alice") {
+		switch sig.(type) {
+		case EndGameSignal, StartNextGameSignal:
+			t.Errorf("expected no game transition with only bots left, got %T", sig)
+		}
+	}
+}
@@ -77,10 +77,15 @@ func (r *roomRepository) Create(playerName string) *Room {
 		roomID = generateRoomID()
 	}
 
-	playerID := generatePlayerID()
-	now := time.Now()
+	room := newRoom(roomID, generatePlayerID(), playerName, time.Now())
+	r.rooms[roomID] = room
+	r.locks[roomID] = &sync.Mutex{}
+	return room
+}
 
-	room := &Room{
+// newRoom creates a room whose first player is connected.
+func newRoom(roomID, playerID, playerName string, now time.Time) *Room {
+	return &Room{
 		ID: roomID,
 		Players: []Player{
 			{ID: playerID, Name: playerName, Status: PlayerStatusConnected},
@@ -89,10 +94,6 @@ func (r *roomRepository) Create(playerName string) *Room {
 		LastActivityAt: now,
 		Wins:           make(map[string]int),
 	}
-
-	r.rooms[roomID] = room
-	r.locks[roomID] = &sync.Mutex{}
-	return room
 }
 
 func (r *roomRepository) Get(roomID string) *Room {
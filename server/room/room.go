@@ -2,6 +2,7 @@
 package room
 
 import (
+	"slices"
 	"time"
 
 	"github.com/srsalisbury/bouncebot/model"
@@ -14,7 +15,7 @@ type Room struct {
 	ID              string
 	Players         []Player
 	CreatedAt       time.Time
-	LastActivityAt  time.Time               // Last user action timestamp (for cleanup)
+	LastActivityAt  time.Time // Last user action timestamp (for cleanup)
 	CurrentGame     *model.Game
 	GameStartedAt   *time.Time
 	Solutions       []PlayerSolution        // Current best solution per player
@@ -23,6 +24,27 @@ type Room struct {
 	GamesPlayed     int                     // Total games completed in room
 	FinishedSolving []string                // Player IDs who are finished solving (triggers game end)
 	ReadyForNext    []string                // Player IDs who are ready for next game
+	SolutionLog     []SolutionEvent         // Every submission and retraction this game, oldest first
+	GameSeed        int64                   // Seed the current game was generated from, 0 if unknown
+	History         []GameRecord            // Completed games, oldest first, at most maxHistory
+	JournalSeq      uint64                  // Last journal entry applied to this room
+	Hints           map[string]HintTier     // Hint tier each player has reached this game, by player ID
+	TeamWins        map[string]int          // Wins per team name
+	TeamQuorum      bool                    // A team counts toward quorums once any of its members does
+	Match           *Match                  // Current or last match, nil if the room has never played one
+
+	// hintSolution is the current game's optimal solution, found when the first
+	// hint is asked for. It isn't persisted; the solver finds it again.
+	hintSolution []model.BotPosition
+
+	// gameVersion changes with each new game, and historyVersion whenever History
+	// does, so SQLite saves can tell when to rewrite the solution log and history.
+	gameVersion    uint64
+	historyVersion uint64
+
+	// Spectators watch the room without playing. They are tied to live
+	// connections, so they are not persisted.
+	Spectators []Spectator `json:"-"`
 }
 
 // GetPlayerName returns the name of the player with the given ID, or empty string if not found.
@@ -45,6 +67,111 @@ func (r *Room) FindPlayerIndex(playerID string) int {
 	return -1
 }
 
+// FindAccountPlayerIndex returns the index of the player signed in to the given account, or -1 if not found.
+func (r *Room) FindAccountPlayerIndex(accountID string) int {
+	for i, p := range r.Players {
+		if p.AccountID == accountID {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindSpectatorIndex returns the index of the spectator with the given ID, or -1 if not found.
+func (r *Room) FindSpectatorIndex(spectatorID string) int {
+	for i, s := range r.Spectators {
+		if s.ID == spectatorID {
+			return i
+		}
+	}
+	return -1
+}
+
+// quorum reports whether every human player is in ids. With TeamQuorum, a
+// player on a team also counts if a human teammate is in ids. Bots never count
+// toward quorums, and a room without human players never reaches one.
+func (r *Room) quorum(ids []string) bool {
+	humans := 0
+	for _, p := range r.Players {
+		if p.IsBot() {
+			continue
+		}
+		humans++
+		if !containsString(ids, p.ID) && !(r.TeamQuorum && r.teamIn(p.Team, ids)) {
+			return false
+		}
+	}
+	return humans > 0
+}
+
+// betweenGames reports whether no game is being played: none has started, or
+// the current one has ended.
+func (r *Room) betweenGames() bool {
+	return r.CurrentGame == nil || r.quorum(r.FinishedSolving)
+}
+
+// teamIn reports whether a human player on the team is in ids.
+func (r *Room) teamIn(team string, ids []string) bool {
+	if team == "" {
+		return false
+	}
+	for _, p := range r.Players {
+		if p.Team == team && !p.IsBot() && containsString(ids, p.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPlayerTeam returns the team of the player with the given ID, or empty string if
+// the player isn't on a team or not found.
+func (r *Room) GetPlayerTeam(playerID string) string {
+	if i := r.FindPlayerIndex(playerID); i != -1 {
+		return r.Players[i].Team
+	}
+	return ""
+}
+
+// creditWin counts a game won by a player, and by the player's team. The rest
+// of the team get no win of their own.
+func (r *Room) creditWin(playerID string) {
+	r.Wins[playerID]++
+	if team := r.GetPlayerTeam(playerID); team != "" {
+		if r.TeamWins == nil {
+			r.TeamWins = make(map[string]int)
+		}
+		r.TeamWins[team]++
+	}
+}
+
+// teamNames returns the teams players are on or that have won, sorted.
+func (r *Room) teamNames() []string {
+	var names []string
+	for _, p := range r.Players {
+		if p.Team != "" && !slices.Contains(names, p.Team) {
+			names = append(names, p.Team)
+		}
+	}
+	for team := range r.TeamWins {
+		if !slices.Contains(names, team) {
+			names = append(names, team)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// countBots returns the number of computer opponents of a level in the room.
+func (r *Room) countBots(level BotLevel) int {
+	n := 0
+	for _, p := range r.Players {
+		if p.Bot == level {
+			n++
+		}
+	}
+	return n
+}
+
 // containsString returns true if the string is in the slice.
 func containsString(slice []string, s string) bool {
 	for _, v := range slice {
@@ -64,8 +191,12 @@ func removeStringAt(slice []string, i int) []string {
 func (r *Room) ClearGameState() {
 	r.Solutions = nil
 	r.SolutionHistory = nil
+	r.SolutionLog = nil
 	r.FinishedSolving = nil
 	r.ReadyForNext = nil
+	r.Hints = nil
+	r.hintSolution = nil
+	r.gameVersion++
 }
 
 // ToProto converts a Room to its protobuf representation.
@@ -73,21 +204,25 @@ func (r *Room) ToProto() *pb.Room {
 	players := make([]*pb.Player, len(r.Players))
 	for i, p := range r.Players {
 		players[i] = &pb.Player{
-			Id:   p.ID,
-			Name: p.Name,
+			Id:        p.ID,
+			Name:      p.Name,
+			AccountId: p.AccountID,
+			Bot:       string(p.Bot),
+			Team:      p.Team,
+			Handicap:  p.Handicap.ToProto(),
 		}
 	}
 
 	solutions := make([]*pb.PlayerSolution, len(r.Solutions))
 	for i, sol := range r.Solutions {
-		moves := make([]*pb.BotPos, len(sol.Moves))
-		for j, move := range sol.Moves {
-			moves[j] = move.ToProto()
-		}
-		solutions[i] = &pb.PlayerSolution{
-			PlayerId: sol.PlayerID,
-			SolvedAt: timestamppb.New(sol.SolvedAt),
-			Moves:    moves,
+		solutions[i] = sol.ToProto()
+	}
+
+	spectators := make([]*pb.Spectator, len(r.Spectators))
+	for i, s := range r.Spectators {
+		spectators[i] = &pb.Spectator{
+			Id:   s.ID,
+			Name: s.Name,
 		}
 	}
 
@@ -100,6 +235,12 @@ func (r *Room) ToProto() *pb.Room {
 		})
 	}
 
+	teams := r.teamNames()
+	teamScores := make([]*pb.TeamScore, len(teams))
+	for i, team := range teams {
+		teamScores[i] = &pb.TeamScore{Team: team, Wins: int32(r.TeamWins[team])}
+	}
+
 	room := &pb.Room{
 		Id:              r.ID,
 		Players:         players,
@@ -109,6 +250,10 @@ func (r *Room) ToProto() *pb.Room {
 		GamesPlayed:     int32(r.GamesPlayed),
 		FinishedSolving: r.FinishedSolving,
 		ReadyForNext:    r.ReadyForNext,
+		Spectators:      spectators,
+		TeamScores:      teamScores,
+		TeamQuorum:      r.TeamQuorum,
+		Match:           r.matchToProto(),
 	}
 
 	if r.CurrentGame != nil {
@@ -129,14 +274,36 @@ type MovePayload struct {
 	Y       int `json:"y"`
 }
 
+// ToProto converts a MovePayload to its protobuf representation.
+func (m MovePayload) ToProto() *pb.BotPos {
+	return &pb.BotPos{
+		Id:  int32(m.RobotId),
+		Pos: &pb.Position{X: int32(m.X), Y: int32(m.Y)},
+	}
+}
+
+// GameRecorder receives each completed game, e.g. to credit the accounts that
+// played it. RecordGame is called outside the room lock.
+type GameRecorder interface {
+	RecordGame(roomID string, rec GameRecord)
+}
+
 // EventBroadcaster is an interface for broadcasting room events.
 type EventBroadcaster interface {
 	BroadcastPlayerJoined(roomID, playerID, playerName string)
 	BroadcastPlayerLeft(roomID, playerID string)
-	BroadcastGameStarted(roomID string)
+	BroadcastGameStarted(roomID string, game *model.Game)
 	BroadcastPlayerFinishedSolving(roomID, playerID string)
 	BroadcastPlayerReadyForNext(roomID, playerID string)
 	BroadcastPlayerSolved(roomID, playerID string, moveCount int)
 	BroadcastSolutionRetracted(roomID, playerID string)
 	BroadcastGameEnded(roomID, winnerID, winnerName string, moves []MovePayload)
+	BroadcastGameAnalysed(roomID string, analysis *GameAnalysis)
+	BroadcastSpectatorJoined(roomID, spectatorID, spectatorName string)
+	BroadcastSpectatorLeft(roomID, spectatorID string)
+	BroadcastTeamsChanged(roomID string, teams map[string]string, teamQuorum bool)
+	BroadcastMatchStarted(roomID string, rounds, firstTo int, seed int64)
+	BroadcastMatchOver(roomID, championID, championName string, gamesPlayed int, standings []MatchStanding)
+	BroadcastHandicapChanged(roomID, playerID string, handicap Handicap)
+	BroadcastRoomClosed(roomID string)
 }
@@ -1,11 +1,17 @@
 package room
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/metrics"
 )
 
 // RoomService is the facade that orchestrates all room operations.
@@ -15,13 +21,34 @@ type RoomService struct {
 	playerMgr   PlayerManager
 	gameMgr     GameLifecycle
 	solutionMgr SolutionManager
+	hintMgr     HintManager
+	teamMgr     TeamManager
+	matchMgr    MatchManager
+	handicapMgr HandicapManager
 	persistence PersistenceManager
 	timerMgr    TimerManager
+	botMgr      BotManager
 
-	broadcaster           EventBroadcaster
+	// dataFile is where changed rooms are saved, set by Load
+	dataFile string
+	// journal records every change between snapshots, set by EnableJournal
+	journal *Journal
+
+	broadcasters          []EventBroadcaster
+	recorders             []GameRecorder
 	disconnectGracePeriod time.Duration
+	hintPenalty           int
+
+	// records queues work for the record worker, which solves, records and
+	// analyses ended games in order; both are created on first use
+	records     chan func()
+	recordsOnce sync.Once
 }
 
+// recordQueueSize is the number of ended games that can wait for the record
+// worker before the goroutine ending another game waits too.
+const recordQueueSize = 64
+
 // NewRoomService creates a new RoomService with all components.
 func NewRoomService() *RoomService {
 	solutionMgr := NewSolutionManager()
@@ -30,15 +57,37 @@ func NewRoomService() *RoomService {
 		playerMgr:             NewPlayerManager(),
 		gameMgr:               NewGameLifecycle(solutionMgr),
 		solutionMgr:           solutionMgr,
+		hintMgr:               NewHintManager(),
+		teamMgr:               NewTeamManager(solutionMgr),
+		matchMgr:              NewMatchManager(),
+		handicapMgr:           NewHandicapManager(),
 		persistence:           NewPersistenceManager(),
 		timerMgr:              NewTimerManager(),
+		botMgr:                NewBotManager(),
 		disconnectGracePeriod: 30 * time.Second,
 	}
 }
 
-// SetBroadcaster sets the event broadcaster.
+// SetBroadcaster sets the event broadcaster, replacing any others.
 func (s *RoomService) SetBroadcaster(b EventBroadcaster) {
-	s.broadcaster = b
+	s.broadcasters = []EventBroadcaster{b}
+}
+
+// AddBroadcaster adds an event broadcaster. Events are sent to all broadcasters in the order added.
+// Must be called before the service starts handling requests.
+func (s *RoomService) AddBroadcaster(b EventBroadcaster) {
+	s.broadcasters = append(s.broadcasters, b)
+}
+
+// AddGameRecorder adds a recorder that is passed every completed game.
+// Must be called before the service starts handling requests.
+func (s *RoomService) AddGameRecorder(r GameRecorder) {
+	s.recorders = append(s.recorders, r)
+}
+
+// SetPersistenceManager sets the storage backend. Must be called before Load.
+func (s *RoomService) SetPersistenceManager(pm PersistenceManager) {
+	s.persistence = pm
 }
 
 // SetDisconnectGracePeriod sets the grace period for player disconnection.
@@ -46,6 +95,14 @@ func (s *RoomService) SetDisconnectGracePeriod(d time.Duration) {
 	s.disconnectGracePeriod = d
 }
 
+// SetHintPenalty sets the moves added to a solution for each hint tier its player
+// took when choosing a game's winner. Zero, the default, ignores hints. Must be
+// called before EnableJournal, so replayed games are decided the same way.
+func (s *RoomService) SetHintPenalty(moves int) {
+	s.hintPenalty = moves
+	s.solutionMgr.SetHintPenalty(moves)
+}
+
 // processSignals interprets and executes signals.
 // This is where the orchestration happens.
 func (s *RoomService) processSignals(signals []Signal) {
@@ -58,7 +115,9 @@ func (s *RoomService) processSignals(signals []Signal) {
 			room, unlock := s.repo.GetWithLock(signal.RoomID)
 			if room != nil {
 				newSignals := s.gameMgr.EndGame(room)
+				s.record(room, JournalEntry{Op: OpEndGame, Time: room.History[len(room.History)-1].EndedAt})
 				unlock()
+				s.persistRoom(signal.RoomID)
 				s.processSignals(newSignals)
 			} else {
 				unlock()
@@ -68,48 +127,147 @@ func (s *RoomService) processSignals(signals []Signal) {
 			room, unlock := s.repo.GetWithLock(signal.RoomID)
 			if room != nil {
 				newSignals := s.gameMgr.StartNextGame(room)
+				s.record(room, JournalEntry{Op: OpNextGame, Time: *room.GameStartedAt, Game: room.CurrentGame, Seed: room.GameSeed})
 				unlock()
+				s.persistRoom(signal.RoomID)
 				s.processSignals(newSignals)
 			} else {
 				unlock()
 			}
 
+		case GameRecordedSignal:
+			// Solving and analysing take seconds, too long for the RPC, WebSocket
+			// read pump or timer that ended the game to wait for
+			s.queueRecord(func() {
+				rec := s.solveGame(signal.RoomID, signal.Record)
+				for _, r := range s.recorders {
+					r.RecordGame(signal.RoomID, rec)
+				}
+				if signal.Analyse {
+					s.processBroadcast(GameAnalysedEvent{RoomID: signal.RoomID, Analysis: analyseGame(rec)})
+				}
+			})
+
 		case StartTimerSignal:
+			callback := s.onTimerFired
+			if signal.Spectator {
+				callback = s.RemoveSpectator
+			}
 			s.timerMgr.StartTimer(
 				signal.RoomID,
 				signal.PlayerID,
 				s.disconnectGracePeriod,
-				s.onTimerFired,
+				callback,
 			)
 
 		case CancelTimerSignal:
 			s.timerMgr.CancelTimer(signal.PlayerID)
+
+		case BotTurnSignal:
+			s.botMgr.Play(signal.RoomID, signal.PlayerID, signal.Level, signal.Game, s.onBotSolved)
+
+		case BotReadySignal:
+			if err := s.MarkReadyForNext(signal.RoomID, signal.PlayerID); err != nil {
+				slog.Warn("Bot could not get ready", logging.RoomID(signal.RoomID), logging.PlayerID(signal.PlayerID), "error", err)
+			}
 		}
 	}
 }
 
+// queueRecord hands work to the record worker, starting it if needed. It waits
+// while the queue is full.
+func (s *RoomService) queueRecord(work func()) {
+	s.recordsOnce.Do(func() {
+		s.records = make(chan func(), recordQueueSize)
+		go func() {
+			for work := range s.records {
+				work()
+			}
+		}()
+	})
+	s.records <- work
+}
+
+// FlushRecords waits until every game that ended before the call has been
+// solved, passed to the recorders and analysed.
+func (s *RoomService) FlushRecords() {
+	done := make(chan struct{})
+	s.queueRecord(func() { close(done) })
+	<-done
+}
+
+// solveGame finds a recorded game's optimal move count outside the room lock and
+// stores it in the room's history. It returns the record with the count.
+func (s *RoomService) solveGame(roomID string, rec GameRecord) GameRecord {
+	rec.OptimalMoves = optimalMoves(rec)
+	if rec.OptimalMoves == 0 {
+		return rec
+	}
+
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return rec
+	}
+	stored := room.setOptimalMoves(rec.EndedAt, rec.OptimalMoves)
+	if stored {
+		s.record(room, JournalEntry{Op: OpSolved, Time: rec.EndedAt, Optimal: rec.OptimalMoves})
+	}
+	unlock()
+
+	if stored {
+		s.persistRoom(roomID)
+	}
+	return rec
+}
+
 func (s *RoomService) processBroadcast(event BroadcastEvent) {
-	if s.broadcaster == nil {
-		return
+	switch event.(type) {
+	case GameStartedEvent:
+		metrics.GamesStarted.Inc()
+	case GameEndedEvent:
+		metrics.GamesEnded.Inc()
 	}
+	for _, b := range s.broadcasters {
+		broadcastTo(b, event)
+	}
+}
 
+// broadcastTo sends a single event to a broadcaster.
+func broadcastTo(b EventBroadcaster, event BroadcastEvent) {
 	switch e := event.(type) {
 	case PlayerJoinedEvent:
-		s.broadcaster.BroadcastPlayerJoined(e.RoomID, e.PlayerID, e.PlayerName)
+		b.BroadcastPlayerJoined(e.RoomID, e.PlayerID, e.PlayerName)
 	case PlayerLeftEvent:
-		s.broadcaster.BroadcastPlayerLeft(e.RoomID, e.PlayerID)
+		b.BroadcastPlayerLeft(e.RoomID, e.PlayerID)
 	case GameStartedEvent:
-		s.broadcaster.BroadcastGameStarted(e.RoomID)
+		b.BroadcastGameStarted(e.RoomID, e.Game)
 	case PlayerFinishedSolvingEvent:
-		s.broadcaster.BroadcastPlayerFinishedSolving(e.RoomID, e.PlayerID)
+		b.BroadcastPlayerFinishedSolving(e.RoomID, e.PlayerID)
 	case PlayerReadyForNextEvent:
-		s.broadcaster.BroadcastPlayerReadyForNext(e.RoomID, e.PlayerID)
+		b.BroadcastPlayerReadyForNext(e.RoomID, e.PlayerID)
 	case PlayerSolvedEvent:
-		s.broadcaster.BroadcastPlayerSolved(e.RoomID, e.PlayerID, e.MoveCount)
+		b.BroadcastPlayerSolved(e.RoomID, e.PlayerID, e.MoveCount)
 	case SolutionRetractedEvent:
-		s.broadcaster.BroadcastSolutionRetracted(e.RoomID, e.PlayerID)
+		b.BroadcastSolutionRetracted(e.RoomID, e.PlayerID)
 	case GameEndedEvent:
-		s.broadcaster.BroadcastGameEnded(e.RoomID, e.WinnerID, e.WinnerName, e.Moves)
+		b.BroadcastGameEnded(e.RoomID, e.WinnerID, e.WinnerName, e.Moves)
+	case GameAnalysedEvent:
+		b.BroadcastGameAnalysed(e.RoomID, e.Analysis)
+	case SpectatorJoinedEvent:
+		b.BroadcastSpectatorJoined(e.RoomID, e.SpectatorID, e.SpectatorName)
+	case SpectatorLeftEvent:
+		b.BroadcastSpectatorLeft(e.RoomID, e.SpectatorID)
+	case TeamsChangedEvent:
+		b.BroadcastTeamsChanged(e.RoomID, e.Teams, e.TeamQuorum)
+	case MatchStartedEvent:
+		b.BroadcastMatchStarted(e.RoomID, e.Rounds, e.FirstTo, e.Seed)
+	case MatchOverEvent:
+		b.BroadcastMatchOver(e.RoomID, e.ChampionID, e.ChampionName, e.GamesPlayed, e.Standings)
+	case HandicapChangedEvent:
+		b.BroadcastHandicapChanged(e.RoomID, e.PlayerID, e.Handicap)
+	case RoomClosedEvent:
+		b.BroadcastRoomClosed(e.RoomID)
 	}
 }
 
@@ -117,28 +275,169 @@ func (s *RoomService) onTimerFired(roomID, playerID string) {
 	s.RemovePlayer(roomID, playerID)
 }
 
+// onBotSolved submits a computer opponent's solution, if it found one, and
+// marks it finished solving. Turns for a game that has since ended or been
+// replaced, or by a bot that has left, are dropped.
+func (s *RoomService) onBotSolved(roomID, playerID string, game *model.Game, moves []model.BotPosition) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil || room.CurrentGame != game || room.FindPlayerIndex(playerID) == -1 || room.quorum(room.FinishedSolving) {
+		unlock()
+		return
+	}
+
+	var signals []Signal
+	if moves != nil {
+		_, submitted, err := s.solutionMgr.SubmitSolution(room, playerID, moves)
+		if err == nil {
+			s.record(room, JournalEntry{Op: OpSubmit, Time: room.LastActivityAt, PlayerID: playerID, Moves: moves})
+			signals = append(signals, submitted...)
+		} else {
+			slog.Warn("Bot submitted a bad solution", logging.RoomID(roomID), logging.PlayerID(playerID), "error", err)
+		}
+	}
+	finished, err := s.gameMgr.MarkFinishedSolving(room, playerID)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpFinish, Time: room.LastActivityAt, PlayerID: playerID})
+		signals = append(signals, finished...)
+	}
+	unlock()
+
+	s.persistRoom(roomID)
+	s.processSignals(signals)
+}
+
 // ---- Public API (backward compatible with old Store) ----
 
 // Create creates a new room with the given player.
 func (s *RoomService) Create(playerName string) *Room {
-	return s.repo.Create(playerName)
+	return s.CreateWithAccount(playerName, "")
+}
+
+// CreateWithAccount creates a new room whose first player is signed in to the
+// given account. An empty accountID creates a guest player.
+func (s *RoomService) CreateWithAccount(playerName, accountID string) *Room {
+	room := s.repo.Create(playerName)
+
+	locked, unlock := s.repo.GetWithLock(room.ID)
+	if locked != nil {
+		locked.Players[0].AccountID = accountID
+		s.record(locked, JournalEntry{Op: OpCreate, Time: room.CreatedAt, PlayerID: room.Players[0].ID, Name: playerName, AccountID: accountID})
+	}
+	unlock()
+
+	s.persistRoom(room.ID)
+	return room
 }
 
 // Join adds a player to an existing room.
 func (s *RoomService) Join(roomID, playerName string) (*Room, error) {
+	return s.JoinWithAccount(roomID, playerName, "")
+}
+
+// JoinWithAccount adds a player signed in to the given account to an existing
+// room. If the account already has a player in the room, the room is returned
+// unchanged so the account keeps its seat. An empty accountID joins as a guest.
+func (s *RoomService) JoinWithAccount(roomID, playerName, accountID string) (*Room, error) {
 	room, unlock := s.repo.GetWithLock(roomID)
 	if room == nil {
 		unlock()
 		return nil, fmt.Errorf("room not found: %s", roomID)
 	}
+	if accountID != "" && room.FindAccountPlayerIndex(accountID) != -1 {
+		unlock()
+		return room, nil
+	}
 
 	signals, err := s.playerMgr.AddPlayer(room, playerName)
+	if err == nil {
+		player := &room.Players[len(room.Players)-1]
+		player.AccountID = accountID
+		s.record(room, JournalEntry{Op: OpJoin, Time: room.LastActivityAt, PlayerID: player.ID, Name: playerName, AccountID: accountID})
+	}
 	unlock()
 
 	if err != nil {
 		return nil, err
 	}
 
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
+// botNames are the default names of computer opponents by level.
+var botNames = map[BotLevel]string{
+	BotEasy:   "Easy Bot",
+	BotMedium: "Medium Bot",
+	BotHard:   "Hard Bot",
+}
+
+// AddBot adds a computer opponent of the given level to a room. If a game is
+// in progress, the bot starts solving it straight away. Bots play until removed
+// but aren't rescheduled after recovering from the journal until the next game.
+func (s *RoomService) AddBot(roomID string, level BotLevel) (*Room, *Player, error) {
+	if !ValidBotLevel(level) {
+		return nil, nil, fmt.Errorf("unknown bot level: %s", level)
+	}
+
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	name := botNames[level]
+	if n := room.countBots(level); n > 0 {
+		name = fmt.Sprintf("%s %d", name, n+1)
+	}
+	signals, err := s.playerMgr.AddPlayer(room, name)
+	var player Player
+	if err == nil {
+		p := &room.Players[len(room.Players)-1]
+		p.Bot = level
+		player = *p
+		s.record(room, JournalEntry{Op: OpJoin, Time: room.LastActivityAt, PlayerID: p.ID, Name: name, Bot: level})
+		if room.CurrentGame != nil && !room.quorum(room.FinishedSolving) {
+			signals = append(signals, BotTurnSignal{RoomID: room.ID, PlayerID: p.ID, Level: level, Game: room.CurrentGame})
+		}
+	}
+	unlock()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, &player, nil
+}
+
+// RemoveBot removes a computer opponent from a room. Bots have no connection,
+// so it is disconnected and removed at once, without a grace period.
+func (s *RoomService) RemoveBot(roomID, playerID string) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+	idx := room.FindPlayerIndex(playerID)
+	if idx == -1 || !room.Players[idx].IsBot() {
+		unlock()
+		return nil, fmt.Errorf("bot not found: %s", playerID)
+	}
+
+	// Disconnecting only starts the grace timer, which isn't wanted here
+	if _, err := s.playerMgr.DisconnectPlayer(room, playerID); err != nil {
+		unlock()
+		return nil, err
+	}
+	s.record(room, JournalEntry{Op: OpDisconnect, Time: room.Players[idx].DisconnectedAt, PlayerID: playerID})
+	signals := s.playerMgr.RemovePlayer(room, playerID)
+	s.record(room, JournalEntry{Op: OpRemove, Time: time.Now(), PlayerID: playerID})
+	unlock()
+
+	s.botMgr.Cancel(playerID)
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return room, nil
 }
@@ -154,19 +453,29 @@ func (s *RoomService) Get(roomID string) (*Room, error) {
 
 // StartGame starts a new game in the room.
 func (s *RoomService) StartGame(roomID string) (*Room, error) {
+	return s.StartGameWith(roomID, nil, 0)
+}
+
+// StartGameWith starts the given game, generated from seed, in a room. A nil
+// game starts a generated one, like StartGame.
+func (s *RoomService) StartGameWith(roomID string, game *model.Game, seed int64) (*Room, error) {
 	room, unlock := s.repo.GetWithLock(roomID)
 	if room == nil {
 		unlock()
 		return nil, fmt.Errorf("room not found: %s", roomID)
 	}
 
-	signals, err := s.gameMgr.StartGame(room)
+	signals, err := s.gameMgr.StartGameWith(room, game, seed)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpStart, Time: room.LastActivityAt, Game: room.CurrentGame, Seed: room.GameSeed})
+	}
 	unlock()
 
 	if err != nil {
 		return nil, err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return room, nil
 }
@@ -180,12 +489,19 @@ func (s *RoomService) SubmitSolution(roomID, playerID string, moves []model.BotP
 	}
 
 	solution, signals, err := s.solutionMgr.SubmitSolution(room, playerID, moves)
+	if errors.Is(err, ErrInvalidSolution) {
+		metrics.SolutionsSubmitted.WithLabelValues(metrics.SolutionInvalid).Inc()
+	} else if err == nil {
+		metrics.SolutionsSubmitted.WithLabelValues(metrics.SolutionValid).Inc()
+		s.record(room, JournalEntry{Op: OpSubmit, Time: room.LastActivityAt, PlayerID: playerID, Moves: moves})
+	}
 	unlock()
 
 	if err != nil {
 		return nil, err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return solution, nil
 }
@@ -199,16 +515,236 @@ func (s *RoomService) RetractSolution(roomID, playerID string) error {
 	}
 
 	signals, err := s.solutionMgr.RetractSolution(room, playerID)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpRetract, Time: room.LastActivityAt, PlayerID: playerID})
+	}
 	unlock()
 
 	if err != nil {
 		return err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return nil
 }
 
+// RequestHint reveals the next tier of the current game's optimal solution to a
+// player and returns everything they have been shown.
+func (s *RoomService) RequestHint(roomID, playerID string) (*Hint, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+	if room.GetPlayerName(playerID) == "" {
+		unlock()
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+	game, solved := room.CurrentGame, room.hintSolution != nil
+	unlock()
+
+	// The first hint of a game solves it, which is too slow for the room lock
+	var solution []model.BotPosition
+	if game != nil && !solved {
+		var ok bool
+		if solution, ok = s.hintMgr.Solve(game); !ok {
+			return nil, ErrNoHint
+		}
+	}
+
+	room, unlock = s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+	hint, err := s.hintMgr.RequestHint(room, playerID, game, solution)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpHint, Time: room.LastActivityAt, PlayerID: playerID})
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	return hint, nil
+}
+
+// SetTeam puts a player on a team, or takes them off their team if team is empty.
+// Teams can only change between games.
+func (s *RoomService) SetTeam(roomID, playerID, team string) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.teamMgr.SetTeam(room, playerID, team)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpTeam, Time: room.LastActivityAt, PlayerID: playerID, Team: team})
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
+// AssignTeams deals a room's players onto the teams at random, evenly.
+// Teams can only change between games.
+func (s *RoomService) AssignTeams(roomID string, teams []string) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.teamMgr.AssignTeams(room, teams)
+	if err == nil {
+		// The deal is random, so each player's team is journaled
+		for _, p := range room.Players {
+			s.record(room, JournalEntry{Op: OpTeam, Time: room.LastActivityAt, PlayerID: p.ID, Team: p.Team})
+		}
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
+// SetTeamQuorum sets whether a team counts toward a room's quorums once any of
+// its members does. It can only change between games.
+func (s *RoomService) SetTeamQuorum(roomID string, enabled bool) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.teamMgr.SetTeamQuorum(room, enabled)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpTeamQuorum, Time: room.LastActivityAt, Enabled: enabled})
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
+// Teams returns the standings of a room's teams, sorted by name, taken under
+// the room lock.
+func (s *RoomService) Teams(roomID string) ([]*pb.TeamStanding, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	defer unlock()
+	if room == nil {
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	best := s.teamMgr.GetTeamSolutions(room)
+	var standings []*pb.TeamStanding
+	for _, team := range room.teamNames() {
+		standing := &pb.TeamStanding{Team: team, Wins: int32(room.TeamWins[team])}
+		for _, p := range room.Players {
+			if p.Team == team {
+				standing.PlayerIds = append(standing.PlayerIds, p.ID)
+			}
+		}
+		if sol := best[team]; sol != nil {
+			standing.BestSolution = sol.ToProto()
+		}
+		standings = append(standings, standing)
+	}
+	return standings, nil
+}
+
+// StartMatch starts a match in a room, of the given rounds or first to the given
+// wins, counting from its next game. Rooms given the same nonzero seed play the
+// same puzzles. Matches can only start between games.
+func (s *RoomService) StartMatch(roomID string, rounds, firstTo int, seed int64) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.matchMgr.StartMatch(room, rounds, firstTo, seed)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpMatchStart, Time: room.LastActivityAt, Rounds: rounds, FirstTo: firstTo, Seed: seed})
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
+// StopMatch ends a room's match in progress early, without a champion.
+func (s *RoomService) StopMatch(roomID string) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.matchMgr.StopMatch(room)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpMatchStop, Time: room.LastActivityAt})
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
+// SetHandicap sets a player's handicap; a zero Handicap removes it.
+// Handicaps can only change between games.
+func (s *RoomService) SetHandicap(roomID, playerID string, handicap Handicap) (*Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.handicapMgr.SetHandicap(room, playerID, handicap)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpHandicap, Time: room.LastActivityAt, PlayerID: playerID, Handicap: handicap})
+	}
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.persistRoom(room.ID)
+	s.processSignals(signals)
+	return room, nil
+}
+
 // MarkFinishedSolving marks a player as finished solving.
 func (s *RoomService) MarkFinishedSolving(roomID, playerID string) error {
 	room, unlock := s.repo.GetWithLock(roomID)
@@ -218,12 +754,16 @@ func (s *RoomService) MarkFinishedSolving(roomID, playerID string) error {
 	}
 
 	signals, err := s.gameMgr.MarkFinishedSolving(room, playerID)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpFinish, Time: room.LastActivityAt, PlayerID: playerID})
+	}
 	unlock()
 
 	if err != nil {
 		return err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return nil
 }
@@ -237,12 +777,16 @@ func (s *RoomService) MarkReadyForNext(roomID, playerID string) error {
 	}
 
 	signals, err := s.gameMgr.MarkReadyForNext(room, playerID)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpReady, Time: room.LastActivityAt, PlayerID: playerID})
+	}
 	unlock()
 
 	if err != nil {
 		return err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return nil
 }
@@ -256,12 +800,16 @@ func (s *RoomService) DisconnectPlayer(roomID, playerID string) error {
 	}
 
 	signals, err := s.playerMgr.DisconnectPlayer(room, playerID)
+	if idx := room.FindPlayerIndex(playerID); err == nil && idx != -1 {
+		s.record(room, JournalEntry{Op: OpDisconnect, Time: room.Players[idx].DisconnectedAt, PlayerID: playerID})
+	}
 	unlock()
 
 	if err != nil {
 		return err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return nil
 }
@@ -275,12 +823,16 @@ func (s *RoomService) ReconnectPlayer(roomID, playerID string) error {
 	}
 
 	signals, err := s.playerMgr.ReconnectPlayer(room, playerID)
+	if err == nil {
+		s.record(room, JournalEntry{Op: OpReconnect, PlayerID: playerID})
+	}
 	unlock()
 
 	if err != nil {
 		return err
 	}
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 	return nil
 }
@@ -294,15 +846,135 @@ func (s *RoomService) RemovePlayer(roomID, playerID string) {
 	}
 
 	signals := s.playerMgr.RemovePlayer(room, playerID)
+	if signals == nil {
+		// Not found, or reconnected before the grace period ran out
+		unlock()
+		return
+	}
+	s.record(room, JournalEntry{Op: OpRemove, Time: time.Now(), PlayerID: playerID})
 	unlock()
 
+	s.persistRoom(room.ID)
 	s.processSignals(signals)
 }
 
+// Spectate adds a spectator to an existing room.
+// Spectators receive room events but do not take part in games. A spectator
+// who doesn't connect within the disconnect grace period is removed.
+func (s *RoomService) Spectate(roomID, spectatorName string) (*Room, *Spectator, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return nil, nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	spectator, signals := s.playerMgr.AddSpectator(room, spectatorName)
+	unlock()
+
+	s.processSignals(signals)
+	return room, spectator, nil
+}
+
+// DisconnectSpectator starts the grace period after a spectator's connection
+// drops. The spectator is removed unless they reconnect before it ends.
+func (s *RoomService) DisconnectSpectator(roomID, spectatorID string) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return
+	}
+
+	signals := s.playerMgr.DisconnectSpectator(room, spectatorID)
+	unlock()
+
+	s.processSignals(signals)
+}
+
+// ReconnectSpectator ends a spectator's grace period.
+func (s *RoomService) ReconnectSpectator(roomID, spectatorID string) error {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return fmt.Errorf("room not found: %s", roomID)
+	}
+
+	signals, err := s.playerMgr.ReconnectSpectator(room, spectatorID)
+	unlock()
+
+	if err != nil {
+		return err
+	}
+
+	s.processSignals(signals)
+	return nil
+}
+
+// RemoveSpectator removes a spectator from a room.
+func (s *RoomService) RemoveSpectator(roomID, spectatorID string) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	if room == nil {
+		unlock()
+		return
+	}
+
+	signals := s.playerMgr.RemoveSpectator(room, spectatorID)
+	unlock()
+
+	s.processSignals(signals)
+}
+
+// Snapshot returns the protobuf representation of a room, taken under the room lock.
+func (s *RoomService) Snapshot(roomID string) (*pb.Room, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	defer unlock()
+	if room == nil {
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+	return room.ToProto(), nil
+}
+
+// History returns the protobuf records of a room's completed games, oldest first,
+// taken under the room lock.
+func (s *RoomService) History(roomID string) ([]*pb.GameRecord, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	defer unlock()
+	if room == nil {
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	records := make([]*pb.GameRecord, len(room.History))
+	for i := range room.History {
+		records[i] = room.History[i].ToProto()
+	}
+	return records, nil
+}
+
+// ExportReplay returns a replay of one of a room's completed games, taken under
+// the room lock. A negative index counts back from the latest game, so -1 is
+// the latest.
+func (s *RoomService) ExportReplay(roomID string, index int) (*pb.Replay, error) {
+	room, unlock := s.repo.GetWithLock(roomID)
+	defer unlock()
+	if room == nil {
+		return nil, fmt.Errorf("room not found: %s", roomID)
+	}
+
+	i := index
+	if i < 0 {
+		i += len(room.History)
+	}
+	if i < 0 || i >= len(room.History) {
+		return nil, fmt.Errorf("game not found: %d", index)
+	}
+	return room.History[i].Replay(room.ID).ToProto(), nil
+}
+
 // ---- Persistence Methods ----
 
-// Load loads rooms from the data file.
+// Load loads rooms from the data file. Afterwards, each change to a room is
+// saved to the same file if the backend supports per-room writes.
 func (s *RoomService) Load(filename string) error {
+	s.dataFile = filename
 	rooms, err := s.persistence.Load(filename)
 	if err != nil {
 		return err
@@ -311,36 +983,157 @@ func (s *RoomService) Load(filename string) error {
 	return nil
 }
 
+// persistRoom saves a room after it changes. The room lock is held during the
+// write, so each write sees the latest state and writes of one room never reorder.
+func (s *RoomService) persistRoom(roomID string) {
+	if s.dataFile == "" {
+		return
+	}
+
+	room, unlock := s.repo.GetWithLock(roomID)
+	defer unlock()
+	if room == nil {
+		return
+	}
+	if err := s.persistence.SaveRoom(s.dataFile, room); err != nil {
+		slog.Error("Failed to save room", logging.RoomID(room.ID), "error", err)
+	}
+}
+
 // Save saves all rooms to the data file.
+// With a journal, the entries the snapshot covers are discarded once it is saved.
 func (s *RoomService) Save(filename string) error {
-	return s.persistence.Save(filename, s.repo.All())
+	if s.journal != nil {
+		if err := s.journal.Rotate(); err != nil {
+			return fmt.Errorf("failed to rotate journal: %w", err)
+		}
+	}
+
+	rooms, err := s.snapshotRooms()
+	if err != nil {
+		return err
+	}
+	if err := s.persistence.Save(filename, rooms); err != nil {
+		return err
+	}
+
+	if s.journal != nil {
+		return s.journal.DiscardRotated()
+	}
+	return nil
 }
 
-// StartAutoSave starts a goroutine that periodically saves rooms.
-func (s *RoomService) StartAutoSave(filename string, interval time.Duration) chan struct{} {
-	stop := make(chan struct{})
+// snapshotRooms returns deep copies of all rooms, each taken under its room lock,
+// so every copy is consistent with its JournalSeq.
+func (s *RoomService) snapshotRooms() (map[string]*Room, error) {
+	rooms := make(map[string]*Room)
+	for id := range s.repo.All() {
+		room, unlock := s.repo.GetWithLock(id)
+		if room == nil {
+			unlock()
+			continue
+		}
+		data, err := json.Marshal(room)
+		unlock()
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot room %s: %w", id, err)
+		}
+
+		var copied Room
+		if err := json.Unmarshal(data, &copied); err != nil {
+			return nil, fmt.Errorf("failed to snapshot room %s: %w", id, err)
+		}
+		rooms[id] = &copied
+	}
+	return rooms, nil
+}
+
+// EnableJournal opens the journal at path, replays the entries newer than the
+// loaded snapshot, and then records every change to it. Call after Load.
+func (s *RoomService) EnableJournal(path string, syncInterval time.Duration) error {
+	journal, err := OpenJournal(path, syncInterval)
+	if err != nil {
+		return err
+	}
+	entries, err := journal.Entries()
+	if err != nil {
+		journal.Close()
+		return err
+	}
+
+	rooms := s.repo.All()
+	applied := replayJournal(rooms, entries, s.hintPenalty)
+	for _, room := range rooms {
+		journal.advanceSeq(room.JournalSeq)
+	}
+	s.repo.Replace(rooms)
+	s.journal = journal
+
+	if applied > 0 {
+		slog.Info("Replayed journal entries", "count", applied, "file", path)
+	}
+	return nil
+}
+
+// CloseJournal syncs and closes the journal, if enabled.
+func (s *RoomService) CloseJournal() error {
+	if s.journal == nil {
+		return nil
+	}
+	return s.journal.Close()
+}
+
+// record appends an entry for a change to the room. Caller must hold the room lock,
+// so entries for a room are journaled in the order they were applied.
+func (s *RoomService) record(room *Room, e JournalEntry) {
+	if s.journal == nil {
+		return
+	}
+
+	e.RoomID = room.ID
+	seq, err := s.journal.Append(e)
+	if err != nil {
+		slog.Error("Failed to journal change", logging.RoomID(room.ID), "op", e.Op, "error", err)
+		return
+	}
+	room.JournalSeq = seq
+}
+
+// StartAutoSave starts a goroutine that periodically saves rooms. The returned
+// stop function makes a final save and returns once it is written.
+func (s *RoomService) StartAutoSave(filename string, interval time.Duration) (stop func()) {
+	stopChan := make(chan struct{})
+	done := make(chan struct{})
 
 	go func() {
+		defer close(done)
 		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
 
 		for {
 			select {
 			case <-ticker.C:
-				if err := s.Save(filename); err != nil {
-					log.Printf("Auto-save failed: %v", err)
+				start := time.Now()
+				err := s.Save(filename)
+				metrics.AutoSaveDuration.Observe(time.Since(start).Seconds())
+				if err != nil {
+					metrics.AutoSaveFailures.Inc()
+					slog.Error("Auto-save failed", "file", filename, "error", err)
 				}
-			case <-stop:
+			case <-stopChan:
 				// Final save before stopping
 				if err := s.Save(filename); err != nil {
-					log.Printf("Final save failed: %v", err)
+					slog.Error("Final save failed", "file", filename, "error", err)
 				}
 				return
 			}
 		}
 	}()
 
-	return stop
+	return func() {
+		close(stopChan)
+		<-done
+	}
 }
 
 // CleanupStaleRooms removes rooms that have been inactive for longer than maxAge.
@@ -348,15 +1141,45 @@ func (s *RoomService) CleanupStaleRooms(maxAge time.Duration) int {
 	stale := s.persistence.FindStaleRooms(s.repo.All(), maxAge)
 	for _, id := range stale {
 		s.repo.Delete(id)
+		if s.journal != nil {
+			if _, err := s.journal.Append(JournalEntry{Op: OpDelete, RoomID: id, Time: time.Now()}); err != nil {
+				slog.Error("Failed to journal delete", logging.RoomID(id), "error", err)
+			}
+		}
+		if s.dataFile != "" {
+			if err := s.persistence.DeleteRoom(s.dataFile, id); err != nil {
+				slog.Error("Failed to delete room", logging.RoomID(id), "error", err)
+			}
+		}
+		s.processBroadcast(RoomClosedEvent{RoomID: id})
 	}
 
+	metrics.StaleRoomsCleaned.Add(float64(len(stale)))
 	if len(stale) > 0 {
-		log.Printf("Cleaned up %d stale rooms (inactive for >%v)", len(stale), maxAge)
+		slog.Info("Cleaned up stale rooms", "count", len(stale), "max_age", maxAge)
 	}
 
 	return len(stale)
 }
 
+// Activity returns the number of rooms and of players connected to them, not
+// counting bots.
+func (s *RoomService) Activity() (rooms, players int) {
+	for id := range s.repo.All() {
+		room, unlock := s.repo.GetWithLock(id)
+		if room != nil {
+			rooms++
+			for _, p := range room.Players {
+				if !p.IsBot() && p.Status == PlayerStatusConnected {
+					players++
+				}
+			}
+		}
+		unlock()
+	}
+	return rooms, players
+}
+
 // StartCleanup starts a goroutine that periodically removes stale rooms.
 func (s *RoomService) StartCleanup(interval, maxAge time.Duration) chan struct{} {
 	stop := make(chan struct{})
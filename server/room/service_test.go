@@ -1,12 +1,17 @@
 package room
 
 import (
+	"errors"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
 	"github.com/srsalisbury/bouncebot/server/config"
+	"github.com/srsalisbury/bouncebot/server/metrics"
 )
 
 // Integration tests for RoomService - tests the full component composition
@@ -231,6 +236,10 @@ func TestService_MarkFinishedSolving_TriggersGameEnd(t *testing.T) {
 	if !mock.gameEndedCalled {
 		t.Error("expected game to end when all players finished")
 	}
+	svc.FlushRecords()
+	if mock.gameAnalysed == nil {
+		t.Error("expected the ended game's analysis to follow")
+	}
 }
 
 func TestService_MarkReadyForNext_StartsNextGame(t *testing.T) {
@@ -255,6 +264,268 @@ func TestService_MarkReadyForNext_StartsNextGame(t *testing.T) {
 	}
 }
 
+func TestService_History_RecordsEndedGames(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetBroadcaster(&mockBroadcaster{})
+
+	room := svc.Create("Alice")
+	svc.StartGame(room.ID)
+	room.CurrentGame = model.Game1()
+	aliceID := room.Players[0].ID
+
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	svc.FlushRecords()
+
+	history, err := svc.History(room.ID)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected 1 game in history, got %d", len(history))
+	}
+	rec := history[0]
+	if rec.WinnerId != aliceID || len(rec.Solutions) != 1 || rec.PlayerNames[aliceID] != "Alice" {
+		t.Errorf("unexpected record %v", rec)
+	}
+	if rec.OptimalMoveCount == 0 || rec.OptimalMoveCount > 7 {
+		t.Errorf("expected optimal count of at most 7, got %d", rec.OptimalMoveCount)
+	}
+
+	// The next game leaves the record in place
+	svc.MarkReadyForNext(room.ID, aliceID)
+	if history, _ := svc.History(room.ID); len(history) != 1 {
+		t.Errorf("expected history to survive the next game, got %d records", len(history))
+	}
+}
+
+func TestService_History_NotFound(t *testing.T) {
+	svc := NewRoomService()
+	if _, err := svc.History("NOPE"); err == nil {
+		t.Error("expected error for unknown room")
+	}
+}
+
+func TestService_ExportReplay(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetBroadcaster(&mockBroadcaster{})
+
+	room := svc.Create("Alice")
+	svc.StartGame(room.ID)
+	room.CurrentGame = model.Game1()
+	aliceID := room.Players[0].ID
+
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.RetractSolution(room.ID, aliceID)
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+
+	replay, err := svc.ExportReplay(room.ID, -1)
+	if err != nil {
+		t.Fatalf("ExportReplay failed: %v", err)
+	}
+	if replay.RoomId != room.ID || replay.WinnerId != aliceID || len(replay.Events) != 3 {
+		t.Errorf("unexpected replay %v", replay)
+	}
+	if replay.Events[1].Action != pb.ReplayEvent_ACTION_RETRACT {
+		t.Errorf("expected the second event to be a retraction, got %v", replay.Events[1].Action)
+	}
+	if first, _ := svc.ExportReplay(room.ID, 0); first.WinnerId != aliceID {
+		t.Errorf("expected index 0 to be the same game, got %v", first)
+	}
+
+	if _, err := svc.ExportReplay(room.ID, 1); err == nil {
+		t.Error("expected error for a game past the end of the history")
+	}
+	if _, err := svc.ExportReplay(room.ID, -2); err == nil {
+		t.Error("expected error for a game before the start of the history")
+	}
+	if _, err := svc.ExportReplay("NOPE", -1); err == nil {
+		t.Error("expected error for unknown room")
+	}
+}
+
+func TestService_JoinWithAccount(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetBroadcaster(&mockBroadcaster{})
+
+	room := svc.CreateWithAccount("Alice", "acct-alice")
+	if room.Players[0].AccountID != "acct-alice" {
+		t.Errorf("expected creator to be signed in, got %+v", room.Players[0])
+	}
+
+	svc.JoinWithAccount(room.ID, "Bob", "acct-bob")
+	svc.Join(room.ID, "Guest")
+	if len(room.Players) != 3 || room.Players[1].AccountID != "acct-bob" || room.Players[2].AccountID != "" {
+		t.Fatalf("expected Bob signed in and a guest, got %+v", room.Players)
+	}
+
+	// Joining again with the same account keeps the existing seat
+	if _, err := svc.JoinWithAccount(room.ID, "Bob again", "acct-bob"); err != nil {
+		t.Fatalf("JoinWithAccount failed: %v", err)
+	}
+	if len(room.Players) != 3 {
+		t.Errorf("expected no new player for an account already in the room, got %d players", len(room.Players))
+	}
+}
+
+// recordingGameRecorder collects recorded games.
+type recordingGameRecorder struct {
+	records []GameRecord
+}
+
+func (r *recordingGameRecorder) RecordGame(roomID string, rec GameRecord) {
+	r.records = append(r.records, rec)
+}
+
+func TestService_GameRecorder_ReceivesEndedGames(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetBroadcaster(&mockBroadcaster{})
+	recorder := &recordingGameRecorder{}
+	svc.AddGameRecorder(recorder)
+
+	room := svc.CreateWithAccount("Alice", "acct-alice")
+	svc.StartGame(room.ID)
+	room.CurrentGame = model.Game1()
+	aliceID := room.Players[0].ID
+
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	svc.FlushRecords()
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("expected 1 recorded game, got %d", len(recorder.records))
+	}
+	rec := recorder.records[0]
+	if rec.WinnerID != aliceID || rec.AccountIDs[aliceID] != "acct-alice" {
+		t.Errorf("expected Alice's win with her account, got %+v", rec)
+	}
+}
+
+// blockingGameRecorder holds up recording until release is closed.
+type blockingGameRecorder struct {
+	release chan struct{}
+}
+
+func (r *blockingGameRecorder) RecordGame(roomID string, rec GameRecord) {
+	<-r.release
+}
+
+func TestService_EndGame_DoesNotWaitForRecorders(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetBroadcaster(&mockBroadcaster{})
+	recorder := &blockingGameRecorder{release: make(chan struct{})}
+	svc.AddGameRecorder(recorder)
+
+	room := svc.Create("Alice")
+	svc.StartGame(room.ID)
+	aliceID := room.Players[0].ID
+
+	done := make(chan struct{})
+	go func() {
+		svc.MarkFinishedSolving(room.ID, aliceID)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ending the game waited for the recorder")
+	}
+	close(recorder.release)
+	svc.FlushRecords()
+}
+
+func TestService_StartGameAfterEndGame_RecordsOnce(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetBroadcaster(&mockBroadcaster{})
+	recorder := &recordingGameRecorder{}
+	svc.AddGameRecorder(recorder)
+
+	room := svc.Create("Alice")
+	svc.StartGame(room.ID)
+	room.CurrentGame = model.Game1()
+	aliceID := room.Players[0].ID
+
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	// A StartGame after the game ended must not record it again
+	if _, err := svc.StartGame(room.ID); err != nil {
+		t.Fatalf("StartGame failed: %v", err)
+	}
+	svc.FlushRecords()
+
+	if len(room.History) != 1 {
+		t.Errorf("expected 1 history record, got %d", len(room.History))
+	}
+	if len(recorder.records) != 1 {
+		t.Errorf("expected 1 recorded game, got %d", len(recorder.records))
+	}
+	if room.Wins[aliceID] != 1 || room.GamesPlayed != 1 {
+		t.Errorf("expected 1 win and 1 game played, got %d and %d", room.Wins[aliceID], room.GamesPlayed)
+	}
+}
+
+func TestService_Journal_RecoversAccounts(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.CreateWithAccount("Alice", "acct-alice")
+	svc1.JoinWithAccount(room.ID, "Bob", "acct-bob")
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+}
+
+func TestService_Journal_RecoversGivenGame(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	if _, err := svc1.StartGameWith(room.ID, model.NewRandomGameFromSeed(42), 42); err != nil {
+		t.Fatalf("StartGameWith failed: %v", err)
+	}
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+	if got.GameSeed != 42 {
+		t.Errorf("expected seed 42 after recovery, got %d", got.GameSeed)
+	}
+}
+
+func TestService_Journal_RecoversOptimalMoves(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	aliceID := room.Players[0].ID
+	svc1.StartGameWith(room.ID, model.Game1(), 0)
+	svc1.SubmitSolution(room.ID, aliceID, validSolution())
+	svc1.MarkFinishedSolving(room.ID, aliceID)
+	svc1.FlushRecords()
+	want, _ := svc1.Get(room.ID)
+	if want.History[0].OptimalMoves == 0 {
+		t.Fatal("expected the ended game to be solved")
+	}
+
+	// Replay takes the count from the journal rather than solving again
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+}
+
 func TestService_RemovePlayer_TriggersGameEnd(t *testing.T) {
 	svc := NewRoomService()
 	mock := &mockBroadcaster{}
@@ -308,6 +579,130 @@ func TestService_Persistence_SaveAndLoad(t *testing.T) {
 	}
 }
 
+// recoverService loads the snapshot and journal the way the server does at startup.
+func recoverService(t *testing.T, filename string) *RoomService {
+	t.Helper()
+	svc := NewRoomService()
+	if err := svc.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if err := svc.EnableJournal(filename+".journal", time.Millisecond); err != nil {
+		t.Fatalf("EnableJournal failed: %v", err)
+	}
+	t.Cleanup(func() { svc.CloseJournal() })
+	return svc
+}
+
+func TestService_Journal_RecoversWithoutSnapshot(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	// The first process never saves a snapshot, as if it crashed
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	svc1.Join(room.ID, "Bob")
+	svc1.StartGame(room.ID)
+	aliceID := room.Players[0].ID
+	bobID := room.Players[1].ID
+	svc1.MarkFinishedSolving(room.ID, aliceID)
+	svc1.MarkFinishedSolving(room.ID, bobID)
+	svc1.MarkReadyForNext(room.ID, aliceID)
+	svc1.MarkReadyForNext(room.ID, bobID)
+	svc1.DisconnectPlayer(room.ID, bobID)
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+}
+
+func TestService_Journal_RecoversSnapshotAndTail(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	svc1.Join(room.ID, "Bob")
+	if err := svc1.Save(filename); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	svc1.Join(room.ID, "Carol")
+	svc1.StartGame(room.ID)
+	other := svc1.Create("Dave")
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+	if _, err := svc2.Get(other.ID); err != nil {
+		t.Errorf("room created after snapshot not recovered: %v", err)
+	}
+
+	// Entries after recovery continue the sequence, so a second recovery sees them too
+	svc2.Join(room.ID, "Erin")
+	svc3 := recoverService(t, filename)
+	got, _ = svc3.Get(room.ID)
+	if got == nil || len(got.Players) != 4 {
+		t.Fatalf("expected 4 players after second recovery, got %+v", got)
+	}
+}
+
+func TestService_Journal_RemovePlayer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rooms.journal")
+
+	svc := NewRoomService()
+	if err := svc.EnableJournal(path, time.Millisecond); err != nil {
+		t.Fatalf("EnableJournal failed: %v", err)
+	}
+	room := svc.Create("Alice")
+	svc.Join(room.ID, "Bob")
+	aliceID := room.Players[0].ID
+	bobID := room.Players[1].ID
+	// Connected and unknown players aren't removed, so nothing is journaled
+	svc.RemovePlayer(room.ID, aliceID)
+	svc.RemovePlayer(room.ID, "nobody")
+	svc.DisconnectPlayer(room.ID, bobID)
+	svc.RemovePlayer(room.ID, bobID)
+	if err := svc.CloseJournal(); err != nil {
+		t.Fatalf("CloseJournal failed: %v", err)
+	}
+
+	entries, err := readJournalFile(path)
+	if err != nil {
+		t.Fatalf("readJournalFile failed: %v", err)
+	}
+	var removes []JournalEntry
+	for _, e := range entries {
+		if e.Op == OpRemove {
+			removes = append(removes, e)
+		}
+	}
+	if len(removes) != 1 || removes[0].PlayerID != bobID {
+		t.Fatalf("expected one remove entry for Bob, got %+v", removes)
+	}
+	if removes[0].Time.IsZero() {
+		t.Error("expected the remove entry to be timed")
+	}
+}
+
+func TestService_Journal_CleanupDeletesRoom(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	room.LastActivityAt = time.Now().Add(-2 * time.Hour)
+	svc1.CleanupStaleRooms(time.Hour)
+
+	svc2 := recoverService(t, filename)
+	if _, err := svc2.Get(room.ID); err == nil {
+		t.Error("expected cleaned up room to stay deleted after recovery")
+	}
+}
+
 func TestService_StartAutoSave_SavesOnStop(t *testing.T) {
 	tmpDir := t.TempDir()
 	filename := filepath.Join(tmpDir, "rooms.json")
@@ -315,12 +710,9 @@ func TestService_StartAutoSave_SavesOnStop(t *testing.T) {
 	svc := NewRoomService()
 	svc.Create("Alice")
 
-	// Start auto-save and immediately stop
+	// Start auto-save and immediately stop; stopping waits for the final save
 	stop := svc.StartAutoSave(filename, config.DefaultConfig().AutoSaveInterval)
-	close(stop)
-
-	// Give it a moment
-	time.Sleep(50 * time.Millisecond)
+	stop()
 
 	// Load into new service to verify
 	svc2 := NewRoomService()
@@ -349,11 +741,15 @@ func TestService_CleanupStaleRooms(t *testing.T) {
 		Wins:           map[string]int{},
 	})
 
+	cleaned := testutil.ToFloat64(metrics.StaleRoomsCleaned)
 	removed := svc.CleanupStaleRooms(24 * time.Hour)
 
 	if removed != 1 {
 		t.Errorf("expected 1 room removed, got %d", removed)
 	}
+	if got := testutil.ToFloat64(metrics.StaleRoomsCleaned) - cleaned; got != 1 {
+		t.Errorf("expected 1 cleanup counted, got %v", got)
+	}
 	if len(svc.rooms()) != 1 {
 		t.Errorf("expected 1 room remaining, got %d", len(svc.rooms()))
 	}
@@ -365,6 +761,24 @@ func TestService_CleanupStaleRooms(t *testing.T) {
 	}
 }
 
+func TestService_CleanupStaleRooms_BroadcastsRoomClosed(t *testing.T) {
+	svc := NewRoomService()
+	mock := &mockBroadcaster{}
+	svc.SetBroadcaster(mock)
+
+	svc.setRoom("STALE", &Room{
+		ID:             "STALE",
+		LastActivityAt: time.Now().Add(-48 * time.Hour),
+		Wins:           map[string]int{},
+	})
+
+	svc.CleanupStaleRooms(24 * time.Hour)
+
+	if len(mock.roomClosedIDs) != 1 || mock.roomClosedIDs[0] != "STALE" {
+		t.Errorf("expected room closed broadcast for STALE, got %v", mock.roomClosedIDs)
+	}
+}
+
 func TestService_ToProto(t *testing.T) {
 	svc := NewRoomService()
 
@@ -388,3 +802,655 @@ func TestService_ToProto(t *testing.T) {
 		t.Error("expected game_started_at in proto")
 	}
 }
+
+func TestService_Spectate(t *testing.T) {
+	svc := NewRoomService()
+	mock := &mockBroadcaster{}
+	svc.SetBroadcaster(mock)
+
+	room := svc.Create("Alice")
+	room, spectator, err := svc.Spectate(room.ID, "Big Screen")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(room.Players) != 1 {
+		t.Errorf("expected 1 player, got %d", len(room.Players))
+	}
+	if len(room.Spectators) != 1 || room.Spectators[0].ID != spectator.ID {
+		t.Errorf("expected spectator %s in room, got %v", spectator.ID, room.Spectators)
+	}
+	if !mock.spectatorJoinedCalled {
+		t.Error("expected BroadcastSpectatorJoined to be called")
+	}
+
+	pbRoom := room.ToProto()
+	if len(pbRoom.Spectators) != 1 || pbRoom.Spectators[0].Name != "Big Screen" {
+		t.Errorf("expected spectator in proto, got %v", pbRoom.Spectators)
+	}
+
+	svc.RemoveSpectator(room.ID, spectator.ID)
+	if len(room.Spectators) != 0 {
+		t.Errorf("expected 0 spectators after removal, got %d", len(room.Spectators))
+	}
+	if !mock.spectatorLeftCalled {
+		t.Error("expected BroadcastSpectatorLeft to be called")
+	}
+}
+
+func TestService_SpectatorGracePeriod(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetDisconnectGracePeriod(20 * time.Millisecond)
+
+	room := svc.Create("Alice")
+	_, spectator, _ := svc.Spectate(room.ID, "Big Screen")
+	if !svc.hasTimer(spectator.ID) {
+		t.Error("expected timer for spectator who hasn't connected")
+	}
+
+	if err := svc.ReconnectSpectator(room.ID, spectator.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if svc.hasTimer(spectator.ID) {
+		t.Error("expected timer to be cancelled once the spectator connects")
+	}
+
+	svc.DisconnectSpectator(room.ID, spectator.ID)
+	if !svc.hasTimer(spectator.ID) {
+		t.Error("expected timer for disconnected spectator")
+	}
+	if room.FindSpectatorIndex(spectator.ID) == -1 {
+		t.Fatal("expected spectator to be kept during the grace period")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for spectatorCount(svc, room.ID) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := spectatorCount(svc, room.ID); n != 0 {
+		t.Errorf("expected spectator to be removed after the grace period, got %d", n)
+	}
+	if err := svc.ReconnectSpectator(room.ID, spectator.ID); err == nil {
+		t.Error("expected error reconnecting a removed spectator")
+	}
+}
+
+func TestService_SpectatorNeverConnects(t *testing.T) {
+	svc := NewRoomService()
+	svc.SetDisconnectGracePeriod(20 * time.Millisecond)
+
+	room := svc.Create("Alice")
+	svc.Spectate(room.ID, "Big Screen")
+
+	deadline := time.Now().Add(time.Second)
+	for spectatorCount(svc, room.ID) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := spectatorCount(svc, room.ID); n != 0 {
+		t.Errorf("expected spectator who never connected to be removed, got %d", n)
+	}
+}
+
+// spectatorCount returns the number of spectators in a room, read under the room lock.
+func spectatorCount(svc *RoomService, roomID string) int {
+	pbRoom, err := svc.Snapshot(roomID)
+	if err != nil {
+		return 0
+	}
+	return len(pbRoom.Spectators)
+}
+
+func TestService_Spectate_NotFound(t *testing.T) {
+	svc := NewRoomService()
+
+	_, _, err := svc.Spectate("nonexistent", "Big Screen")
+	if err == nil {
+		t.Error("expected error for nonexistent room")
+	}
+}
+
+func TestService_SpectatorsDoNotBlockTransitions(t *testing.T) {
+	svc := NewRoomService()
+	mock := &mockBroadcaster{}
+	svc.SetBroadcaster(mock)
+
+	room := svc.Create("Alice")
+	svc.Spectate(room.ID, "Big Screen")
+	svc.StartGame(room.ID)
+	aliceID := room.Players[0].ID
+
+	// The only player finishing ends the game despite the spectator
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	if !mock.gameEndedCalled {
+		t.Error("expected game to end when all players finished")
+	}
+
+	// The only player being ready starts the next game
+	mock.gameStartedCalled = false
+	svc.MarkReadyForNext(room.ID, aliceID)
+	if !mock.gameStartedCalled {
+		t.Error("expected next game to start when all players ready")
+	}
+}
+
+func TestService_SpectatorsNotPersisted(t *testing.T) {
+	tmpDir := t.TempDir()
+	filename := filepath.Join(tmpDir, "rooms.json")
+
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+	svc.Spectate(room.ID, "Big Screen")
+
+	if err := svc.Save(filename); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	svc2 := NewRoomService()
+	if err := svc2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	loaded, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not found after load: %v", err)
+	}
+	if len(loaded.Spectators) != 0 {
+		t.Errorf("expected spectators not to be persisted, got %d", len(loaded.Spectators))
+	}
+}
+
+func TestService_AddBroadcaster_FansOut(t *testing.T) {
+	svc := NewRoomService()
+	first := &mockBroadcaster{}
+	second := &mockBroadcaster{}
+	svc.AddBroadcaster(first)
+	svc.AddBroadcaster(second)
+
+	room := svc.Create("Alice")
+	svc.StartGame(room.ID)
+
+	if !first.gameStartedCalled || !second.gameStartedCalled {
+		t.Error("expected every broadcaster to receive game_started")
+	}
+}
+
+// waitForRoom polls a room until cond holds, failing the test after a few seconds.
+func waitForRoom(t *testing.T, svc *RoomService, roomID string, cond func(*pb.Room) bool) *pb.Room {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		snap, err := svc.Snapshot(roomID)
+		if err != nil {
+			t.Fatalf("Snapshot failed: %v", err)
+		}
+		if cond(snap) {
+			return snap
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("room never reached the expected state: %v", snap)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestService_AddBot_PlaysAlong(t *testing.T) {
+	svc := NewRoomService()
+	svc.botMgr = newInstantBotManager()
+
+	room := svc.Create("Alice")
+	aliceID := room.Players[0].ID
+	_, bot, err := svc.AddBot(room.ID, BotHard)
+	if err != nil {
+		t.Fatalf("AddBot failed: %v", err)
+	}
+	if !bot.IsBot() || bot.Name != "Hard Bot" {
+		t.Errorf("expected a bot named Hard Bot, got %+v", bot)
+	}
+
+	// The bot finishes by itself but doesn't end the game for Alice
+	svc.StartGame(room.ID)
+	snap := waitForRoom(t, svc, room.ID, func(r *pb.Room) bool { return slices.Contains(r.FinishedSolving, bot.ID) })
+	if snap.GamesPlayed != 0 {
+		t.Error("expected game to wait for Alice")
+	}
+
+	// Once Alice finishes the bot gets ready by itself, and Alice alone starts the next game
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	waitForRoom(t, svc, room.ID, func(r *pb.Room) bool { return slices.Contains(r.ReadyForNext, bot.ID) })
+	svc.MarkReadyForNext(room.ID, aliceID)
+	snap, _ = svc.Snapshot(room.ID)
+	if snap.GamesPlayed != 1 || len(snap.ReadyForNext) != 0 {
+		t.Errorf("expected next game to start, got %d games played and ready %v", snap.GamesPlayed, snap.ReadyForNext)
+	}
+	waitForRoom(t, svc, room.ID, func(r *pb.Room) bool { return slices.Contains(r.FinishedSolving, bot.ID) })
+}
+
+func TestService_AddBot_JoinsGameInProgress(t *testing.T) {
+	svc := NewRoomService()
+	svc.botMgr = newInstantBotManager()
+
+	room := svc.Create("Alice")
+	svc.StartGame(room.ID)
+	_, bot, err := svc.AddBot(room.ID, BotEasy)
+	if err != nil {
+		t.Fatalf("AddBot failed: %v", err)
+	}
+
+	waitForRoom(t, svc, room.ID, func(r *pb.Room) bool { return slices.Contains(r.FinishedSolving, bot.ID) })
+}
+
+func TestService_AddBot_Names(t *testing.T) {
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+
+	_, first, _ := svc.AddBot(room.ID, BotMedium)
+	_, second, _ := svc.AddBot(room.ID, BotMedium)
+	if first.Name != "Medium Bot" || second.Name != "Medium Bot 2" {
+		t.Errorf("expected Medium Bot and Medium Bot 2, got %q and %q", first.Name, second.Name)
+	}
+}
+
+func TestService_AddBot_Errors(t *testing.T) {
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+
+	if _, _, err := svc.AddBot(room.ID, "grandmaster"); err == nil {
+		t.Error("expected error for unknown bot level")
+	}
+	if _, _, err := svc.AddBot("NOPE", BotEasy); err == nil {
+		t.Error("expected error for unknown room")
+	}
+}
+
+func TestService_RemoveBot(t *testing.T) {
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+	_, bot, _ := svc.AddBot(room.ID, BotEasy)
+
+	if _, err := svc.RemoveBot(room.ID, room.Players[0].ID); err == nil {
+		t.Error("expected error removing a person as a bot")
+	}
+	if _, err := svc.RemoveBot(room.ID, bot.ID); err != nil {
+		t.Fatalf("RemoveBot failed: %v", err)
+	}
+	if room.FindPlayerIndex(bot.ID) != -1 {
+		t.Error("expected bot to be removed")
+	}
+	if svc.hasTimer(bot.ID) {
+		t.Error("expected no disconnect timer for a removed bot")
+	}
+	if _, err := svc.RemoveBot(room.ID, bot.ID); err == nil {
+		t.Error("expected error removing a bot twice")
+	}
+}
+
+func TestService_BotTurn_DroppedAfterGameChanges(t *testing.T) {
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+	_, bot, _ := svc.AddBot(room.ID, BotHard)
+	svc.StartGame(room.ID)
+	svc.botMgr.StopAll()
+
+	// A turn for a game that's no longer current is dropped
+	svc.onBotSolved(room.ID, bot.ID, model.Game1(), model.Game1Solution())
+	snap, _ := svc.Snapshot(room.ID)
+	if len(snap.Solutions) != 0 || len(snap.FinishedSolving) != 0 {
+		t.Errorf("expected stale turn to be dropped, got solutions %v and finished %v", snap.Solutions, snap.FinishedSolving)
+	}
+}
+
+func TestService_Journal_RecoversBots(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	_, bot, _ := svc1.AddBot(room.ID, BotHard)
+	_, removed, _ := svc1.AddBot(room.ID, BotEasy)
+	svc1.RemoveBot(room.ID, removed.ID)
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+	if idx := got.FindPlayerIndex(bot.ID); idx == -1 || got.Players[idx].Bot != BotHard {
+		t.Error("expected hard bot after recovery")
+	}
+}
+
+func TestService_RequestHint_PenalisesWinner(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	svc1.SetHintPenalty(2)
+	room := svc1.Create("Alice")
+	svc1.Join(room.ID, "Bob")
+	svc1.StartGameWith(room.ID, model.Game1(), 0)
+	aliceID, bobID := room.Players[0].ID, room.Players[1].ID
+
+	hint, err := svc1.RequestHint(room.ID, aliceID)
+	if err != nil {
+		t.Fatalf("RequestHint failed: %v", err)
+	}
+	if hint.Tier != HintMoveCount {
+		t.Errorf("expected first hint tier, got %d", hint.Tier)
+	}
+
+	// Alice solves first with the same moves, but her hint costs her the win
+	svc1.SubmitSolution(room.ID, aliceID, validSolution())
+	svc1.SubmitSolution(room.ID, bobID, validSolution())
+	svc1.MarkFinishedSolving(room.ID, aliceID)
+	svc1.MarkFinishedSolving(room.ID, bobID)
+	if room.Wins[bobID] != 1 {
+		t.Errorf("expected Bob to win, got wins %v", room.Wins)
+	}
+	want, _ := svc1.Get(room.ID)
+
+	// Replaying the journal decides the game the same way
+	svc2 := NewRoomService()
+	svc2.SetHintPenalty(2)
+	if err := svc2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if err := svc2.EnableJournal(filename+".journal", time.Millisecond); err != nil {
+		t.Fatalf("EnableJournal failed: %v", err)
+	}
+	t.Cleanup(func() { svc2.CloseJournal() })
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+	if got.History[0].Solutions[0].Hints != HintMoveCount {
+		t.Errorf("expected recorded solution to keep its hint tier, got %d", got.History[0].Solutions[0].Hints)
+	}
+}
+
+func TestService_RequestHint_SolvesOncePerGame(t *testing.T) {
+	svc := NewRoomService()
+	solves := 0
+	svc.hintMgr = &hintManager{now: time.Now, solve: func(g *model.Game) ([]model.BotPosition, bool) {
+		solves++
+		return solveForHint(g)
+	}}
+	room := svc.Create("Alice")
+	aliceID := room.Players[0].ID
+	svc.StartGameWith(room.ID, model.Game1(), 0)
+
+	svc.RequestHint(room.ID, aliceID)
+	hint, err := svc.RequestHint(room.ID, aliceID)
+	if err != nil || hint.Tier != HintBots || solves != 1 {
+		t.Errorf("expected the second tier from 1 solve, got %+v (%v) from %d solves", hint, err, solves)
+	}
+
+	// A new game is solved again
+	svc.StartGame(room.ID)
+	svc.RequestHint(room.ID, aliceID)
+	if solves != 2 {
+		t.Errorf("expected the new game to be solved, got %d solves", solves)
+	}
+}
+
+func TestService_RequestHint_Unsolvable(t *testing.T) {
+	svc := NewRoomService()
+	svc.hintMgr = &hintManager{now: time.Now, solve: func(*model.Game) ([]model.BotPosition, bool) { return nil, false }}
+	room := svc.Create("Alice")
+	aliceID := room.Players[0].ID
+	svc.StartGame(room.ID)
+
+	if _, err := svc.RequestHint(room.ID, aliceID); !errors.Is(err, ErrNoHint) {
+		t.Errorf("expected ErrNoHint, got %v", err)
+	}
+	if room.Hints[aliceID] != HintNone {
+		t.Error("expected no hint tier taken when there is no hint")
+	}
+}
+
+func TestService_RequestHint_NotFound(t *testing.T) {
+	svc := NewRoomService()
+
+	if _, err := svc.RequestHint("NOPE", "p1"); err == nil {
+		t.Error("expected error for unknown room")
+	}
+}
+
+func TestService_Teams(t *testing.T) {
+	svc := NewRoomService()
+	mock := &mockBroadcaster{}
+	svc.SetBroadcaster(mock)
+	room := svc.Create("Alice")
+	svc.Join(room.ID, "Bob")
+	aliceID, bobID := room.Players[0].ID, room.Players[1].ID
+
+	if _, err := svc.SetTeam(room.ID, aliceID, "Red"); err != nil {
+		t.Fatalf("SetTeam failed: %v", err)
+	}
+	if _, err := svc.SetTeam(room.ID, bobID, "Blue"); err != nil {
+		t.Fatalf("SetTeam failed: %v", err)
+	}
+	if !mock.teamsChangedCalled {
+		t.Error("expected teams_changed broadcast")
+	}
+
+	// Bob wins for Blue
+	svc.StartGameWith(room.ID, model.Game1(), 0)
+	if _, err := svc.SetTeam(room.ID, aliceID, "Blue"); !errors.Is(err, ErrTeamsLocked) {
+		t.Errorf("expected ErrTeamsLocked during a game, got %v", err)
+	}
+	svc.SubmitSolution(room.ID, bobID, validSolution())
+	standings, err := svc.Teams(room.ID)
+	if err != nil {
+		t.Fatalf("Teams failed: %v", err)
+	}
+	if len(standings) != 2 || standings[0].Team != "Blue" || standings[0].BestSolution.GetPlayerId() != bobID {
+		t.Errorf("expected Blue first with Bob's solution, got %v", standings)
+	}
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	svc.MarkFinishedSolving(room.ID, bobID)
+	if room.TeamWins["Blue"] != 1 {
+		t.Errorf("expected Blue to be credited, got %v", room.TeamWins)
+	}
+	if scores := room.ToProto().TeamScores; len(scores) != 2 || scores[0].Wins != 1 {
+		t.Errorf("expected team scores in the room proto, got %v", scores)
+	}
+
+	if _, err := svc.Teams("NOPE"); err == nil {
+		t.Error("expected error for unknown room")
+	}
+	if _, err := svc.SetTeam("NOPE", aliceID, "Red"); err == nil {
+		t.Error("expected error for unknown room")
+	}
+}
+
+func TestService_Journal_RecoversTeams(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	svc1.Join(room.ID, "Bob")
+	svc1.Join(room.ID, "Carol")
+	if _, err := svc1.AssignTeams(room.ID, DefaultTeams); err != nil {
+		t.Fatalf("AssignTeams failed: %v", err)
+	}
+	if _, err := svc1.SetTeamQuorum(room.ID, true); err != nil {
+		t.Fatalf("SetTeamQuorum failed: %v", err)
+	}
+	want, _ := svc1.Get(room.ID)
+
+	// The random deal is replayed exactly
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+}
+
+func TestService_Match(t *testing.T) {
+	svc := NewRoomService()
+	mock := &mockBroadcaster{}
+	svc.SetBroadcaster(mock)
+	room := svc.Create("Alice")
+	aliceID := room.Players[0].ID
+
+	if _, err := svc.StartMatch(room.ID, 2, 0, 0); err != nil {
+		t.Fatalf("StartMatch failed: %v", err)
+	}
+	if !mock.matchStartedCalled {
+		t.Error("expected match_started broadcast")
+	}
+
+	svc.StartGameWith(room.ID, model.Game1(), 0)
+	if _, err := svc.StartMatch(room.ID, 2, 0, 0); !errors.Is(err, ErrMatchInProgress) {
+		t.Errorf("expected ErrMatchInProgress, got %v", err)
+	}
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	svc.MarkReadyForNext(room.ID, aliceID)
+
+	// Alice wins the second game too
+	svc.StartGameWith(room.ID, model.Game1(), 0)
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	if len(mock.matchChampionIDs) != 1 || mock.matchChampionIDs[0] != aliceID {
+		t.Errorf("expected match_over with Alice champion, got %v", mock.matchChampionIDs)
+	}
+	if m := room.ToProto().Match; m.GetChampionId() != aliceID || m.GetStandings()[0].GetWins() != 2 || m.GetEndedAt() == nil {
+		t.Errorf("expected the finished match in the room proto, got %v", m)
+	}
+
+	if _, err := svc.StopMatch(room.ID); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("expected ErrNoMatch, got %v", err)
+	}
+	if _, err := svc.StartMatch("NOPE", 2, 0, 0); err == nil {
+		t.Error("expected error for unknown room")
+	}
+}
+
+func TestService_Journal_RecoversMatch(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	aliceID := room.Players[0].ID
+	if _, err := svc1.StartMatch(room.ID, 3, 0, 500); err != nil {
+		t.Fatalf("StartMatch failed: %v", err)
+	}
+	svc1.StartGame(room.ID)
+	svc1.MarkFinishedSolving(room.ID, aliceID)
+	svc1.MarkReadyForNext(room.ID, aliceID)
+	svc1.StopMatch(room.ID)
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+	if got.GameSeed != 501 {
+		t.Errorf("expected the second seeded game recovered, got seed %d", got.GameSeed)
+	}
+}
+
+func TestService_SetHandicap(t *testing.T) {
+	svc := NewRoomService()
+	mock := &mockBroadcaster{}
+	svc.SetBroadcaster(mock)
+	room := svc.Create("Alice")
+	svc.Join(room.ID, "Bob")
+	aliceID, bobID := room.Players[0].ID, room.Players[1].ID
+
+	if _, err := svc.SetHandicap(room.ID, aliceID, Handicap{Delay: time.Hour}); err != nil {
+		t.Fatalf("SetHandicap failed: %v", err)
+	}
+	if mock.handicapChanged[aliceID].Delay != time.Hour {
+		t.Errorf("expected handicap_changed broadcast for alice, got %v", mock.handicapChanged)
+	}
+
+	svc.StartGameWith(room.ID, model.Game1(), 0)
+	if _, err := svc.SetHandicap(room.ID, aliceID, Handicap{}); !errors.Is(err, ErrHandicapsLocked) {
+		t.Errorf("expected ErrHandicapsLocked during a game, got %v", err)
+	}
+
+	// Alice solves first, but her delay puts Bob's equal solution ahead
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.SubmitSolution(room.ID, bobID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+	svc.MarkFinishedSolving(room.ID, bobID)
+
+	room, _ = svc.Get(room.ID)
+	if room.Wins[bobID] != 1 {
+		t.Errorf("expected bob to win against alice's handicap, got wins %v", room.Wins)
+	}
+}
+
+func TestService_Journal_RecoversHandicaps(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.json")
+
+	svc1 := recoverService(t, filename)
+	room := svc1.Create("Alice")
+	svc1.Join(room.ID, "Bob")
+	bobID := room.Players[1].ID
+	if _, err := svc1.SetHandicap(room.ID, bobID, Handicap{ExtraMoves: 1, Delay: 2 * time.Second}); err != nil {
+		t.Fatalf("SetHandicap failed: %v", err)
+	}
+	svc1.StartGameWith(room.ID, model.Game1(), 0)
+	svc1.SubmitSolution(room.ID, bobID, validSolution())
+	want, _ := svc1.Get(room.ID)
+
+	svc2 := recoverService(t, filename)
+	got, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("room not recovered: %v", err)
+	}
+	assertRoomsEqual(t, want, got)
+}
+
+func TestService_Activity(t *testing.T) {
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+	svc.Join(room.ID, "Bob")
+	svc.AddBot(room.ID, BotEasy)
+	svc.Create("Carol")
+
+	// Bots and disconnected players aren't active
+	svc.DisconnectPlayer(room.ID, room.Players[1].ID)
+
+	rooms, players := svc.Activity()
+	if rooms != 2 || players != 2 {
+		t.Errorf("expected 2 rooms and 2 players, got %d and %d", rooms, players)
+	}
+}
+
+func TestService_Metrics(t *testing.T) {
+	started := testutil.ToFloat64(metrics.GamesStarted)
+	ended := testutil.ToFloat64(metrics.GamesEnded)
+	valid := testutil.ToFloat64(metrics.SolutionsSubmitted.WithLabelValues(metrics.SolutionValid))
+	invalid := testutil.ToFloat64(metrics.SolutionsSubmitted.WithLabelValues(metrics.SolutionInvalid))
+
+	svc := NewRoomService()
+	room := svc.Create("Alice")
+	aliceID := room.Players[0].ID
+	svc.StartGameWith(room.ID, model.Game1(), 0)
+	svc.SubmitSolution(room.ID, aliceID, validSolution()[:1])
+	// Only moves that fail to solve the game count as invalid
+	svc.SubmitSolution(room.ID, "nobody", validSolution())
+	svc.SubmitSolution(room.ID, aliceID, validSolution())
+	svc.MarkFinishedSolving(room.ID, aliceID)
+
+	if got := testutil.ToFloat64(metrics.GamesStarted) - started; got != 1 {
+		t.Errorf("expected 1 game started, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.GamesEnded) - ended; got != 1 {
+		t.Errorf("expected 1 game ended, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.SolutionsSubmitted.WithLabelValues(metrics.SolutionValid)) - valid; got != 1 {
+		t.Errorf("expected 1 valid solution, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.SolutionsSubmitted.WithLabelValues(metrics.SolutionInvalid)) - invalid; got != 1 {
+		t.Errorf("expected 1 invalid solution, got %v", got)
+	}
+}
@@ -1,5 +1,10 @@
 package room
 
+import (
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+)
+
 // Signal represents an action that should be taken by the orchestrator.
 // Using a sealed interface pattern for type safety.
 type Signal interface {
@@ -27,10 +32,21 @@ type StartNextGameSignal struct {
 
 func (StartNextGameSignal) signalMarker() {}
 
+// GameRecordedSignal indicates a completed game was added to the room's history
+// and should be passed to the GameRecorders.
+type GameRecordedSignal struct {
+	RoomID  string
+	Record  GameRecord
+	Analyse bool // Whether to analyse the game and broadcast a GameAnalysedEvent
+}
+
+func (GameRecordedSignal) signalMarker() {}
+
 // StartTimerSignal indicates a disconnect timer should be started.
 type StartTimerSignal struct {
-	RoomID   string
-	PlayerID string
+	RoomID    string
+	PlayerID  string // Player or spectator ID
+	Spectator bool   // Whether the timer removes a spectator rather than a player
 }
 
 func (StartTimerSignal) signalMarker() {}
@@ -42,10 +58,32 @@ type CancelTimerSignal struct {
 
 func (CancelTimerSignal) signalMarker() {}
 
+// BotTurnSignal indicates a computer opponent should start solving a game.
+type BotTurnSignal struct {
+	RoomID   string
+	PlayerID string
+	Level    BotLevel
+	Game     *model.Game
+}
+
+func (BotTurnSignal) signalMarker() {}
+
+// BotReadySignal indicates a computer opponent should be marked ready for the
+// next game.
+type BotReadySignal struct {
+	RoomID   string
+	PlayerID string
+}
+
+func (BotReadySignal) signalMarker() {}
+
 // BroadcastEvent is the specific event type to broadcast.
 // Using a sealed interface pattern for type safety.
 type BroadcastEvent interface {
 	broadcastEventMarker() // unexported method makes this a sealed interface
+
+	// ToProto converts the event to its protobuf representation (without a sequence number).
+	ToProto() *pb.RoomEvent
 }
 
 // PlayerJoinedEvent is broadcast when a player joins a room.
@@ -57,6 +95,13 @@ type PlayerJoinedEvent struct {
 
 func (PlayerJoinedEvent) broadcastEventMarker() {}
 
+func (e PlayerJoinedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_PlayerJoined{PlayerJoined: &pb.PlayerJoinedEvent{
+		PlayerId:   e.PlayerID,
+		PlayerName: e.PlayerName,
+	}}}
+}
+
 // PlayerLeftEvent is broadcast when a player leaves a room.
 type PlayerLeftEvent struct {
 	RoomID   string
@@ -65,13 +110,28 @@ type PlayerLeftEvent struct {
 
 func (PlayerLeftEvent) broadcastEventMarker() {}
 
+func (e PlayerLeftEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_PlayerLeft{PlayerLeft: &pb.PlayerLeftEvent{
+		PlayerId: e.PlayerID,
+	}}}
+}
+
 // GameStartedEvent is broadcast when a new game starts.
 type GameStartedEvent struct {
 	RoomID string
+	Game   *model.Game
 }
 
 func (GameStartedEvent) broadcastEventMarker() {}
 
+func (e GameStartedEvent) ToProto() *pb.RoomEvent {
+	started := &pb.GameStartedEvent{}
+	if e.Game != nil {
+		started.Game = e.Game.ToProto()
+	}
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_GameStarted{GameStarted: started}}
+}
+
 // PlayerFinishedSolvingEvent is broadcast when a player is done looking for solutions.
 type PlayerFinishedSolvingEvent struct {
 	RoomID   string
@@ -80,6 +140,12 @@ type PlayerFinishedSolvingEvent struct {
 
 func (PlayerFinishedSolvingEvent) broadcastEventMarker() {}
 
+func (e PlayerFinishedSolvingEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_PlayerFinishedSolving{PlayerFinishedSolving: &pb.PlayerFinishedSolvingEvent{
+		PlayerId: e.PlayerID,
+	}}}
+}
+
 // PlayerReadyForNextEvent is broadcast when a player is ready for the next game.
 type PlayerReadyForNextEvent struct {
 	RoomID   string
@@ -88,6 +154,12 @@ type PlayerReadyForNextEvent struct {
 
 func (PlayerReadyForNextEvent) broadcastEventMarker() {}
 
+func (e PlayerReadyForNextEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_PlayerReadyForNext{PlayerReadyForNext: &pb.PlayerReadyForNextEvent{
+		PlayerId: e.PlayerID,
+	}}}
+}
+
 // PlayerSolvedEvent is broadcast when a player submits a solution.
 type PlayerSolvedEvent struct {
 	RoomID    string
@@ -97,6 +169,13 @@ type PlayerSolvedEvent struct {
 
 func (PlayerSolvedEvent) broadcastEventMarker() {}
 
+func (e PlayerSolvedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_PlayerSolved{PlayerSolved: &pb.PlayerSolvedEvent{
+		PlayerId:  e.PlayerID,
+		MoveCount: int32(e.MoveCount),
+	}}}
+}
+
 // SolutionRetractedEvent is broadcast when a player retracts their solution.
 type SolutionRetractedEvent struct {
 	RoomID   string
@@ -105,6 +184,12 @@ type SolutionRetractedEvent struct {
 
 func (SolutionRetractedEvent) broadcastEventMarker() {}
 
+func (e SolutionRetractedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_SolutionRetracted{SolutionRetracted: &pb.SolutionRetractedEvent{
+		PlayerId: e.PlayerID,
+	}}}
+}
+
 // GameEndedEvent is broadcast when the game ends.
 type GameEndedEvent struct {
 	RoomID     string
@@ -114,3 +199,146 @@ type GameEndedEvent struct {
 }
 
 func (GameEndedEvent) broadcastEventMarker() {}
+
+func (e GameEndedEvent) ToProto() *pb.RoomEvent {
+	moves := make([]*pb.BotPos, len(e.Moves))
+	for i, m := range e.Moves {
+		moves[i] = m.ToProto()
+	}
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_GameEnded{GameEnded: &pb.GameEndedEvent{
+		WinnerId:   e.WinnerID,
+		WinnerName: e.WinnerName,
+		Moves:      moves,
+	}}}
+}
+
+// GameAnalysedEvent is broadcast after GameEndedEvent, once the ended game has
+// been analysed outside the room lock.
+type GameAnalysedEvent struct {
+	RoomID   string
+	Analysis *GameAnalysis
+}
+
+func (GameAnalysedEvent) broadcastEventMarker() {}
+
+func (e GameAnalysedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_GameAnalysed{GameAnalysed: &pb.GameAnalysedEvent{
+		Analysis: e.Analysis.ToProto(),
+	}}}
+}
+
+// SpectatorJoinedEvent is broadcast when a spectator starts watching a room.
+type SpectatorJoinedEvent struct {
+	RoomID        string
+	SpectatorID   string
+	SpectatorName string
+}
+
+func (SpectatorJoinedEvent) broadcastEventMarker() {}
+
+func (e SpectatorJoinedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_SpectatorJoined{SpectatorJoined: &pb.SpectatorJoinedEvent{
+		SpectatorId:   e.SpectatorID,
+		SpectatorName: e.SpectatorName,
+	}}}
+}
+
+// SpectatorLeftEvent is broadcast when a spectator stops watching a room.
+type SpectatorLeftEvent struct {
+	RoomID      string
+	SpectatorID string
+}
+
+func (SpectatorLeftEvent) broadcastEventMarker() {}
+
+func (e SpectatorLeftEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_SpectatorLeft{SpectatorLeft: &pb.SpectatorLeftEvent{
+		SpectatorId: e.SpectatorID,
+	}}}
+}
+
+// TeamsChangedEvent is broadcast when players change teams or the team quorum
+// rule changes.
+type TeamsChangedEvent struct {
+	RoomID     string
+	Teams      map[string]string // Team of each player on one, by player ID
+	TeamQuorum bool
+}
+
+func (TeamsChangedEvent) broadcastEventMarker() {}
+
+func (e TeamsChangedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_TeamsChanged{TeamsChanged: &pb.TeamsChangedEvent{
+		Teams:      e.Teams,
+		TeamQuorum: e.TeamQuorum,
+	}}}
+}
+
+// HandicapChangedEvent is broadcast when a player's handicap is set or removed.
+type HandicapChangedEvent struct {
+	RoomID   string
+	PlayerID string
+	Handicap Handicap // Zero if removed
+}
+
+func (HandicapChangedEvent) broadcastEventMarker() {}
+
+func (e HandicapChangedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_HandicapChanged{HandicapChanged: &pb.HandicapChangedEvent{
+		PlayerId: e.PlayerID,
+		Handicap: e.Handicap.ToProto(),
+	}}}
+}
+
+// MatchStartedEvent is broadcast when a match starts.
+type MatchStartedEvent struct {
+	RoomID  string
+	Rounds  int
+	FirstTo int
+	Seed    int64
+}
+
+func (MatchStartedEvent) broadcastEventMarker() {}
+
+func (e MatchStartedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_MatchStarted{MatchStarted: &pb.MatchStartedEvent{
+		Rounds:  int32(e.Rounds),
+		FirstTo: int32(e.FirstTo),
+		Seed:    e.Seed,
+	}}}
+}
+
+// MatchOverEvent is broadcast when a match is decided or stopped early.
+type MatchOverEvent struct {
+	RoomID       string
+	ChampionID   string // Empty if tied or stopped early
+	ChampionName string
+	GamesPlayed  int
+	Standings    []MatchStanding
+}
+
+func (MatchOverEvent) broadcastEventMarker() {}
+
+func (e MatchOverEvent) ToProto() *pb.RoomEvent {
+	standings := make([]*pb.MatchStanding, len(e.Standings))
+	for i, s := range e.Standings {
+		standings[i] = s.ToProto()
+	}
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_MatchOver{MatchOver: &pb.MatchOverEvent{
+		ChampionId:   e.ChampionID,
+		ChampionName: e.ChampionName,
+		GamesPlayed:  int32(e.GamesPlayed),
+		Standings:    standings,
+	}}}
+}
+
+// RoomClosedEvent is broadcast when a room is removed from the server.
+type RoomClosedEvent struct {
+	RoomID string
+}
+
+func (RoomClosedEvent) broadcastEventMarker() {}
+
+func (e RoomClosedEvent) ToProto() *pb.RoomEvent {
+	return &pb.RoomEvent{RoomId: e.RoomID, Event: &pb.RoomEvent_RoomClosed{RoomClosed: &pb.RoomClosedEvent{}}}
+}
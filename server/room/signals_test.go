@@ -0,0 +1,122 @@
+package room
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBroadcastEvent_ToProto(t *testing.T) {
+	tests := []struct {
+		name  string
+		event BroadcastEvent
+		check func(t *testing.T, e BroadcastEvent)
+	}{
+		{"player joined", PlayerJoinedEvent{RoomID: "R", PlayerID: "p", PlayerName: "Alice"}, func(t *testing.T, e BroadcastEvent) {
+			if got := e.ToProto().GetPlayerJoined(); got.GetPlayerId() != "p" || got.GetPlayerName() != "Alice" {
+				t.Errorf("unexpected player_joined %v", got)
+			}
+		}},
+		{"player left", PlayerLeftEvent{RoomID: "R", PlayerID: "p"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetPlayerLeft().GetPlayerId() != "p" {
+				t.Error("expected player_left for p")
+			}
+		}},
+		{"game started", GameStartedEvent{RoomID: "R"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetGameStarted() == nil {
+				t.Error("expected game_started")
+			}
+		}},
+		{"finished solving", PlayerFinishedSolvingEvent{RoomID: "R", PlayerID: "p"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetPlayerFinishedSolving().GetPlayerId() != "p" {
+				t.Error("expected player_finished_solving for p")
+			}
+		}},
+		{"ready for next", PlayerReadyForNextEvent{RoomID: "R", PlayerID: "p"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetPlayerReadyForNext().GetPlayerId() != "p" {
+				t.Error("expected player_ready_for_next for p")
+			}
+		}},
+		{"player solved", PlayerSolvedEvent{RoomID: "R", PlayerID: "p", MoveCount: 7}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetPlayerSolved().GetMoveCount() != 7 {
+				t.Error("expected player_solved with 7 moves")
+			}
+		}},
+		{"solution retracted", SolutionRetractedEvent{RoomID: "R", PlayerID: "p"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetSolutionRetracted().GetPlayerId() != "p" {
+				t.Error("expected solution_retracted for p")
+			}
+		}},
+		{"game ended", GameEndedEvent{RoomID: "R", WinnerID: "p", Moves: []MovePayload{{RobotId: 1, X: 2, Y: 3}}}, func(t *testing.T, e BroadcastEvent) {
+			got := e.ToProto().GetGameEnded()
+			if got.GetWinnerId() != "p" || len(got.GetMoves()) != 1 || got.GetMoves()[0].GetPos().GetY() != 3 {
+				t.Errorf("unexpected game_ended %v", got)
+			}
+		}},
+		{"game analysed", GameAnalysedEvent{RoomID: "R", Analysis: &GameAnalysis{
+			OptimalMoves: 2,
+			Optimal:      []SolutionPath{{Moves: []MovePayload{{RobotId: 1}, {RobotId: 1, X: 2}}, Path: "board"}},
+			Players:      []SolutionAnalysis{{PlayerID: "p", MovesOverOptimal: 1, Divergence: 0}},
+		}}, func(t *testing.T, e BroadcastEvent) {
+			got := e.ToProto().GetGameAnalysed().GetAnalysis()
+			if got.GetOptimalMoves() != 2 || len(got.GetOptimal()) != 1 || got.GetOptimal()[0].GetPath() != "board" {
+				t.Errorf("unexpected analysis %v", got)
+			}
+			if p := got.GetPlayers(); len(p) != 1 || p[0].GetPlayerId() != "p" || p[0].GetMovesOverOptimal() != 1 {
+				t.Errorf("unexpected player analysis %v", p)
+			}
+		}},
+		{"spectator joined", SpectatorJoinedEvent{RoomID: "R", SpectatorID: "s", SpectatorName: "Screen"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetSpectatorJoined().GetSpectatorName() != "Screen" {
+				t.Error("expected spectator_joined for Screen")
+			}
+		}},
+		{"teams changed", TeamsChangedEvent{RoomID: "R", Teams: map[string]string{"p": "Red"}, TeamQuorum: true}, func(t *testing.T, e BroadcastEvent) {
+			got := e.ToProto().GetTeamsChanged()
+			if got.GetTeams()["p"] != "Red" || !got.GetTeamQuorum() {
+				t.Errorf("unexpected teams_changed %v", got)
+			}
+		}},
+		{"match started", MatchStartedEvent{RoomID: "R", Rounds: 5, FirstTo: 3, Seed: 42}, func(t *testing.T, e BroadcastEvent) {
+			got := e.ToProto().GetMatchStarted()
+			if got.GetRounds() != 5 || got.GetFirstTo() != 3 || got.GetSeed() != 42 {
+				t.Errorf("unexpected match_started %v", got)
+			}
+		}},
+		{"match over", MatchOverEvent{RoomID: "R", ChampionID: "p", ChampionName: "Pat", GamesPlayed: 3, Standings: []MatchStanding{{PlayerID: "p", PlayerName: "Pat", Wins: 2}}}, func(t *testing.T, e BroadcastEvent) {
+			got := e.ToProto().GetMatchOver()
+			if got.GetChampionId() != "p" || got.GetGamesPlayed() != 3 || len(got.GetStandings()) != 1 || got.GetStandings()[0].GetWins() != 2 {
+				t.Errorf("unexpected match_over %v", got)
+			}
+		}},
+		{"handicap changed", HandicapChangedEvent{RoomID: "R", PlayerID: "p", Handicap: Handicap{ExtraMoves: 2, Delay: time.Second}}, func(t *testing.T, e BroadcastEvent) {
+			got := e.ToProto().GetHandicapChanged()
+			if got.GetPlayerId() != "p" || got.GetHandicap().GetExtraMoves() != 2 || got.GetHandicap().GetDelay().AsDuration() != time.Second {
+				t.Errorf("unexpected handicap_changed %v", got)
+			}
+		}},
+		{"handicap removed", HandicapChangedEvent{RoomID: "R", PlayerID: "p"}, func(t *testing.T, e BroadcastEvent) {
+			if got := e.ToProto().GetHandicapChanged(); got.GetPlayerId() != "p" || got.Handicap != nil {
+				t.Errorf("expected handicap_changed without a handicap, got %v", got)
+			}
+		}},
+		{"spectator left", SpectatorLeftEvent{RoomID: "R", SpectatorID: "s"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetSpectatorLeft().GetSpectatorId() != "s" {
+				t.Error("expected spectator_left for s")
+			}
+		}},
+		{"room closed", RoomClosedEvent{RoomID: "R"}, func(t *testing.T, e BroadcastEvent) {
+			if e.ToProto().GetRoomClosed() == nil {
+				t.Error("expected room_closed")
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.ToProto().GetRoomId(); got != "R" {
+				t.Errorf("expected room ID 'R', got '%s'", got)
+			}
+			tt.check(t, tt.event)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // PlayerSolution represents a player's solution to the current game.
@@ -11,6 +13,8 @@ type PlayerSolution struct {
 	PlayerID string
 	SolvedAt time.Time
 	Moves    []model.BotPosition // The actual moves that solved the puzzle
+	Hints    HintTier            // Hint tier the player had reached when submitting
+	Handicap Handicap            // The player's handicap when submitting
 }
 
 // MoveCount returns the number of moves in the solution.
@@ -18,6 +22,30 @@ func (s *PlayerSolution) MoveCount() int {
 	return len(s.Moves)
 }
 
+// ToProto converts a PlayerSolution to its protobuf representation.
+func (s *PlayerSolution) ToProto() *pb.PlayerSolution {
+	moves := make([]*pb.BotPos, len(s.Moves))
+	for i, move := range s.Moves {
+		moves[i] = move.ToProto()
+	}
+	return &pb.PlayerSolution{
+		PlayerId: s.PlayerID,
+		SolvedAt: timestamppb.New(s.SolvedAt),
+		Moves:    moves,
+		Hints:    int32(s.Hints),
+		Handicap: s.Handicap.ToProto(),
+	}
+}
+
+// SolutionEvent is a solution submitted or retracted during a game, kept so the
+// game can be replayed.
+type SolutionEvent struct {
+	PlayerID  string
+	At        time.Time
+	Retracted bool
+	Moves     []model.BotPosition // The submitted or retracted moves
+}
+
 // PlayerSolutionHistory tracks all solutions a player has found (for restoring after retraction).
 type PlayerSolutionHistory struct {
 	PlayerID  string
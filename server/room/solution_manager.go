@@ -1,12 +1,17 @@
 package room
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/srsalisbury/bouncebot/model"
 )
 
+// ErrInvalidSolution is returned when submitted moves don't solve the game.
+var ErrInvalidSolution = errors.New("invalid solution")
+
 // SolutionManager handles solution submission and retraction.
 type SolutionManager interface {
 	// SubmitSolution validates and records a player's solution.
@@ -17,17 +22,29 @@ type SolutionManager interface {
 	// Returns signals or error.
 	RetractSolution(room *Room, playerID string) ([]Signal, error)
 
-	// GetWinningSolution returns the winning solution from a list.
+	// GetWinningSolution returns the winning solution from a list: the lowest
+	// move count, plus the hint penalty, less the player's handicap moves, then
+	// the earliest solve time plus the handicap delay.
 	// Public because GameLifecycle needs it.
 	GetWinningSolution(solutions []PlayerSolution) *PlayerSolution
+
+	// SetHintPenalty sets the moves GetWinningSolution adds to a solution for
+	// each hint tier its player took. Zero, the default, ignores hints.
+	SetHintPenalty(moves int)
+
+	// HintPenalty returns the moves GetWinningSolution adds per hint tier.
+	HintPenalty() int
 }
 
 // solutionManager is the concrete implementation of SolutionManager.
-type solutionManager struct{}
+type solutionManager struct {
+	now         func() time.Time // Clock, replaced when replaying the journal
+	hintPenalty int              // Moves added per hint tier when choosing the winner
+}
 
 // NewSolutionManager creates a new SolutionManager.
 func NewSolutionManager() SolutionManager {
-	return &solutionManager{}
+	return &solutionManager{now: time.Now}
 }
 
 func (sm *solutionManager) SubmitSolution(room *Room, playerID string, moves []model.BotPosition) (*PlayerSolution, []Signal, error) {
@@ -43,15 +60,18 @@ func (sm *solutionManager) SubmitSolution(room *Room, playerID string, moves []m
 	// Verify the solution
 	isValid, _ := room.CurrentGame.CheckSolution(moves)
 	if !isValid {
-		return nil, nil, fmt.Errorf("invalid solution")
+		return nil, nil, ErrInvalidSolution
 	}
 
 	moveCount := len(moves)
-	now := time.Now()
+	now := sm.now()
+	hints := room.Hints[playerID]
+	handicap := room.playerHandicap(playerID)
 	room.LastActivityAt = now
 
 	// Add to solution history
-	sm.addToHistory(room, playerID, moves, now)
+	sm.addToHistory(room, playerID, moves, now, hints, handicap)
+	room.SolutionLog = append(room.SolutionLog, SolutionEvent{PlayerID: playerID, At: now, Moves: moves})
 
 	// Check if player already submitted a solution for this game
 	for i := range room.Solutions {
@@ -60,6 +80,8 @@ func (sm *solutionManager) SubmitSolution(room *Room, playerID string, moves []m
 			if moveCount < room.Solutions[i].MoveCount() {
 				room.Solutions[i].SolvedAt = now
 				room.Solutions[i].Moves = moves
+				room.Solutions[i].Hints = hints
+				room.Solutions[i].Handicap = handicap
 
 				signals := []Signal{
 					BroadcastSignal{Event: PlayerSolvedEvent{
@@ -80,6 +102,8 @@ func (sm *solutionManager) SubmitSolution(room *Room, playerID string, moves []m
 		PlayerID: playerID,
 		SolvedAt: now,
 		Moves:    moves,
+		Hints:    hints,
+		Handicap: handicap,
 	}
 	room.Solutions = append(room.Solutions, solution)
 
@@ -95,7 +119,7 @@ func (sm *solutionManager) SubmitSolution(room *Room, playerID string, moves []m
 }
 
 // addToHistory adds a solution to the player's history (if not already present with same move count).
-func (sm *solutionManager) addToHistory(room *Room, playerID string, moves []model.BotPosition, solvedAt time.Time) {
+func (sm *solutionManager) addToHistory(room *Room, playerID string, moves []model.BotPosition, solvedAt time.Time, hints HintTier, handicap Handicap) {
 	// Find or create history entry for this player
 	var history *PlayerSolutionHistory
 	for i := range room.SolutionHistory {
@@ -124,6 +148,8 @@ func (sm *solutionManager) addToHistory(room *Room, playerID string, moves []mod
 		PlayerID: playerID,
 		SolvedAt: solvedAt,
 		Moves:    moves,
+		Hints:    hints,
+		Handicap: handicap,
 	})
 }
 
@@ -132,7 +158,8 @@ func (sm *solutionManager) RetractSolution(room *Room, playerID string) ([]Signa
 		return nil, fmt.Errorf("no game in progress")
 	}
 
-	room.LastActivityAt = time.Now()
+	now := sm.now()
+	room.LastActivityAt = now
 
 	// Find the player's current solution
 	var currentMoveCount int
@@ -148,6 +175,12 @@ func (sm *solutionManager) RetractSolution(room *Room, playerID string) ([]Signa
 	if solutionIndex == -1 {
 		return nil, fmt.Errorf("no solution found for player: %s", playerID)
 	}
+	room.SolutionLog = append(room.SolutionLog, SolutionEvent{
+		PlayerID:  playerID,
+		At:        now,
+		Retracted: true,
+		Moves:     room.Solutions[solutionIndex].Moves,
+	})
 
 	// Find the player's history and remove the current solution from it
 	var history *PlayerSolutionHistory
@@ -210,11 +243,38 @@ func (sm *solutionManager) GetWinningSolution(solutions []PlayerSolution) *Playe
 	best := &solutions[0]
 	for i := range solutions[1:] {
 		sol := &solutions[i+1]
-		if sol.MoveCount() < best.MoveCount() {
-			best = sol
-		} else if sol.MoveCount() == best.MoveCount() && sol.SolvedAt.Before(best.SolvedAt) {
+		if compareSolutions(sol, best, sm.hintPenalty) < 0 {
 			best = sol
 		}
 	}
 	return best
 }
+
+// compareSolutions orders solutions as GetWinningSolution ranks them: by score,
+// then by solve time plus the handicap delay. Equal solutions compare 0.
+func compareSolutions(a, b *PlayerSolution, hintPenalty int) int {
+	if c := cmp.Compare(score(a, hintPenalty), score(b, hintPenalty)); c != 0 {
+		return c
+	}
+	return handicappedTime(a).Compare(handicappedTime(b))
+}
+
+// score is a solution's move count plus hintPenalty for each hint tier its
+// player took, less its player's handicap moves.
+func score(sol *PlayerSolution, hintPenalty int) int {
+	return sol.MoveCount() + hintPenalty*int(sol.Hints) - sol.Handicap.ExtraMoves
+}
+
+// handicappedTime is when a solution counts as solved: its solve time plus its
+// player's handicap delay.
+func handicappedTime(sol *PlayerSolution) time.Time {
+	return sol.SolvedAt.Add(sol.Handicap.Delay)
+}
+
+func (sm *solutionManager) SetHintPenalty(moves int) {
+	sm.hintPenalty = moves
+}
+
+func (sm *solutionManager) HintPenalty() int {
+	return sm.hintPenalty
+}
@@ -1,6 +1,7 @@
 package room
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -57,8 +58,8 @@ func TestSolutionManager_SubmitSolution_InvalidSolution(t *testing.T) {
 	}
 
 	_, _, err := sm.SubmitSolution(room, "alice", invalidMoves)
-	if err == nil {
-		t.Error("expected error for invalid solution")
+	if !errors.Is(err, ErrInvalidSolution) {
+		t.Errorf("expected ErrInvalidSolution, got %v", err)
 	}
 }
 
@@ -238,6 +239,29 @@ func TestSolutionManager_RetractSolution_UpdatesLastActivityAt(t *testing.T) {
 	}
 }
 
+func TestSolutionManager_LogsSubmissionsAndRetractions(t *testing.T) {
+	sm := NewSolutionManager()
+	room := createTestRoom()
+
+	sm.SubmitSolution(room, "alice", validSolution())
+	sm.SubmitSolution(room, "alice", validSolution()) // Not an improvement, still logged
+	sm.RetractSolution(room, "alice")
+
+	if len(room.SolutionLog) != 3 {
+		t.Fatalf("expected 3 logged events, got %d", len(room.SolutionLog))
+	}
+	for i, e := range room.SolutionLog {
+		if e.PlayerID != "alice" || len(e.Moves) != len(validSolution()) || e.Retracted != (i == 2) {
+			t.Errorf("event %d: unexpected %+v", i, e)
+		}
+	}
+
+	room.ClearGameState()
+	if room.SolutionLog != nil {
+		t.Error("expected solution log to be cleared for a new game")
+	}
+}
+
 func TestSolutionManager_GetWinningSolution_Empty(t *testing.T) {
 	sm := NewSolutionManager()
 
@@ -313,3 +337,83 @@ func TestSolutionManager_GetWinningSolution_Multiple(t *testing.T) {
 		t.Errorf("expected bob (5 moves), got %s with %d moves", winner.PlayerID, winner.MoveCount())
 	}
 }
+
+func TestSolutionManager_GetWinningSolution_HintPenalty(t *testing.T) {
+	sm := NewSolutionManager()
+
+	now := time.Now()
+	solutions := []PlayerSolution{
+		{PlayerID: "alice", SolvedAt: now, Moves: make([]model.BotPosition, 5), Hints: HintFirstMove},
+		{PlayerID: "bob", SolvedAt: now.Add(time.Second), Moves: make([]model.BotPosition, 7)},
+	}
+
+	// Without a penalty hints don't matter
+	if winner := sm.GetWinningSolution(solutions); winner.PlayerID != "alice" {
+		t.Errorf("expected alice without a hint penalty, got %s", winner.PlayerID)
+	}
+
+	// Alice's 3 hint tiers cost her 3 moves: 8 against Bob's 7
+	sm.SetHintPenalty(1)
+	if winner := sm.GetWinningSolution(solutions); winner.PlayerID != "bob" {
+		t.Errorf("expected bob with a hint penalty, got %s", winner.PlayerID)
+	}
+}
+
+func TestSolutionManager_SubmitSolution_RecordsHints(t *testing.T) {
+	sm := NewSolutionManager()
+
+	room := &Room{
+		ID:          "TEST",
+		Players:     []Player{{ID: "alice", Name: "Alice"}},
+		CurrentGame: model.Game1(),
+		Hints:       map[string]HintTier{"alice": HintBots},
+	}
+
+	solution, _, err := sm.SubmitSolution(room, "alice", validSolution())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if solution.Hints != HintBots || room.SolutionHistory[0].Solutions[0].Hints != HintBots {
+		t.Errorf("expected solution and history to record hint tier %d, got %d", HintBots, solution.Hints)
+	}
+}
+
+func TestSolutionManager_GetWinningSolution_Handicap(t *testing.T) {
+	sm := NewSolutionManager()
+
+	now := time.Now()
+	// Bob's 2 extra moves bring his 7 down to 5, level with Alice, and her
+	// delay puts her solve after his
+	solutions := []PlayerSolution{
+		{PlayerID: "alice", SolvedAt: now, Moves: make([]model.BotPosition, 5), Handicap: Handicap{Delay: 10 * time.Second}},
+		{PlayerID: "bob", SolvedAt: now.Add(5 * time.Second), Moves: make([]model.BotPosition, 7), Handicap: Handicap{ExtraMoves: 2}},
+	}
+	if winner := sm.GetWinningSolution(solutions); winner.PlayerID != "bob" {
+		t.Errorf("expected bob with handicaps, got %s", winner.PlayerID)
+	}
+
+	// Without the delay Alice's earlier solve wins the tie
+	solutions[0].Handicap = Handicap{}
+	if winner := sm.GetWinningSolution(solutions); winner.PlayerID != "alice" {
+		t.Errorf("expected alice without a delay, got %s", winner.PlayerID)
+	}
+}
+
+func TestSolutionManager_SubmitSolution_RecordsHandicap(t *testing.T) {
+	sm := NewSolutionManager()
+
+	handicap := Handicap{ExtraMoves: 1, Delay: time.Second}
+	room := &Room{
+		ID:          "TEST",
+		Players:     []Player{{ID: "alice", Name: "Alice", Handicap: handicap}},
+		CurrentGame: model.Game1(),
+	}
+
+	solution, _, err := sm.SubmitSolution(room, "alice", validSolution())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if solution.Handicap != handicap || room.SolutionHistory[0].Solutions[0].Handicap != handicap {
+		t.Errorf("expected solution and history to record handicap %+v, got %+v", handicap, solution.Handicap)
+	}
+}
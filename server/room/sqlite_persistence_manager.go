@@ -0,0 +1,897 @@
+//go:build cgo
+
+package room
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // registers the "sqlite3" driver
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// sqliteSchemaVersion is the schema version stored in the database's user_version.
+// Databases from a newer version are refused rather than read with columns missing.
+//
+// Version history:
+//   - 1: original schema, from before user_version was set.
+//   - 2: added the history table.
+//   - 3: added games.seed and the solution_log table.
+//   - 4: added players.account_id.
+//   - 5: added players.bot.
+//   - 6: added solutions.hints and the hints table.
+//   - 7: added players.team, rooms.team_quorum and the team_wins table.
+//   - 8: added the matches table.
+//   - 9: added players.handicap_moves, players.handicap_delay and the same columns on solutions.
+//   - 10: matches count GamesStarted; see migrateV10ToV11 for how it is derived.
+const sqliteSchemaVersion = 10
+
+// sqliteSchema creates the tables for rooms and their players, wins, team wins, hints, games,
+// solutions, solution log, history and matches. Child rows are removed with their room.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS rooms (
+	id               TEXT PRIMARY KEY,
+	created_at       TEXT NOT NULL,
+	last_activity_at TEXT NOT NULL,
+	games_played     INTEGER NOT NULL,
+	finished_solving TEXT NOT NULL, -- JSON array of player IDs
+	ready_for_next   TEXT NOT NULL, -- JSON array of player IDs
+	team_quorum      INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS players (
+	room_id         TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	position        INTEGER NOT NULL,
+	id              TEXT NOT NULL,
+	name            TEXT NOT NULL,
+	status          TEXT NOT NULL,
+	disconnected_at TEXT NOT NULL,
+	account_id      TEXT NOT NULL DEFAULT '', -- empty for guests
+	bot             TEXT NOT NULL DEFAULT '', -- bot level, empty for people
+	team            TEXT NOT NULL DEFAULT '', -- empty if not on a team
+	handicap_moves  INTEGER NOT NULL DEFAULT 0,
+	handicap_delay  INTEGER NOT NULL DEFAULT 0, -- nanoseconds
+	PRIMARY KEY (room_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS wins (
+	room_id   TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	player_id TEXT NOT NULL,
+	count     INTEGER NOT NULL,
+	PRIMARY KEY (room_id, player_id)
+);
+
+CREATE TABLE IF NOT EXISTS team_wins (
+	room_id TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	team    TEXT NOT NULL,
+	count   INTEGER NOT NULL,
+	PRIMARY KEY (room_id, team)
+);
+
+CREATE TABLE IF NOT EXISTS hints (
+	room_id   TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	player_id TEXT NOT NULL,
+	tier      INTEGER NOT NULL,
+	PRIMARY KEY (room_id, player_id)
+);
+
+CREATE TABLE IF NOT EXISTS games (
+	room_id    TEXT PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE,
+	game       TEXT NOT NULL, -- JSON model.Game
+	started_at TEXT,          -- NULL if the start time is unknown
+	seed       INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS solutions (
+	room_id   TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	current   INTEGER NOT NULL, -- 1 for Room.Solutions, 0 for Room.SolutionHistory
+	position  INTEGER NOT NULL, -- index in Room.Solutions or Room.SolutionHistory
+	seq       INTEGER NOT NULL, -- index in the player's history, 0 for current solutions
+	player_id TEXT NOT NULL,
+	solved_at TEXT NOT NULL,
+	moves     TEXT NOT NULL,    -- JSON []model.BotPosition
+	hints     INTEGER NOT NULL DEFAULT 0,
+	handicap_moves INTEGER NOT NULL DEFAULT 0,
+	handicap_delay INTEGER NOT NULL DEFAULT 0, -- nanoseconds
+	PRIMARY KEY (room_id, current, position, seq)
+);
+
+CREATE TABLE IF NOT EXISTS solution_log (
+	room_id   TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	position  INTEGER NOT NULL, -- index in Room.SolutionLog
+	player_id TEXT NOT NULL,
+	at        TEXT NOT NULL,
+	retracted INTEGER NOT NULL,
+	moves     TEXT NOT NULL,    -- JSON []model.BotPosition
+	PRIMARY KEY (room_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS history (
+	room_id  TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL, -- index in Room.History
+	record   TEXT NOT NULL,    -- JSON GameRecord
+	PRIMARY KEY (room_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS matches (
+	room_id TEXT PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE,
+	match   TEXT NOT NULL -- JSON Match
+);
+`
+
+// sqliteMigrations[v] upgrades an existing database from schema version v to v+1.
+// New tables need no migration: sqliteSchema creates any that are missing.
+var sqliteMigrations = map[int]string{
+	2: `ALTER TABLE games ADD COLUMN seed INTEGER NOT NULL DEFAULT 0`,
+	3: `ALTER TABLE players ADD COLUMN account_id TEXT NOT NULL DEFAULT ''`,
+	4: `ALTER TABLE players ADD COLUMN bot TEXT NOT NULL DEFAULT ''`,
+	5: `ALTER TABLE solutions ADD COLUMN hints INTEGER NOT NULL DEFAULT 0`,
+	6: `ALTER TABLE players ADD COLUMN team TEXT NOT NULL DEFAULT '';
+	    ALTER TABLE rooms ADD COLUMN team_quorum INTEGER NOT NULL DEFAULT 0`,
+	8: `ALTER TABLE players ADD COLUMN handicap_moves INTEGER NOT NULL DEFAULT 0;
+	    ALTER TABLE players ADD COLUMN handicap_delay INTEGER NOT NULL DEFAULT 0;
+	    ALTER TABLE solutions ADD COLUMN handicap_moves INTEGER NOT NULL DEFAULT 0;
+	    ALTER TABLE solutions ADD COLUMN handicap_delay INTEGER NOT NULL DEFAULT 0`,
+	// Databases from before version 8 get the matches table here, empty, so it can be updated
+	9: `CREATE TABLE IF NOT EXISTS matches (room_id TEXT PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE, match TEXT NOT NULL);
+	    UPDATE matches SET match = json_set(match, '$.GamesStarted', COALESCE(
+	        (SELECT games.seed - json_extract(matches.match, '$.Seed') + 1 FROM games
+	         WHERE games.room_id = matches.room_id AND json_extract(matches.match, '$.Seed') != 0
+	           AND games.seed BETWEEN json_extract(matches.match, '$.Seed')
+	                              AND json_extract(matches.match, '$.Seed') + json_extract(matches.match, '$.GamesPlayed')),
+	        json_extract(match, '$.GamesPlayed')))`,
+}
+
+// sqlitePersistenceManager stores rooms in an embedded SQLite database.
+// Each room is written in its own transaction, so a change to one room
+// doesn't rewrite the others. Within a room, the solution log and history,
+// which can be long, are only written as far as they changed.
+type sqlitePersistenceManager struct {
+	mu    sync.Mutex
+	dbs   map[string]*sql.DB     // filename -> open database
+	saved map[savedKey]savedRoom // What SaveRoom last wrote for each room
+}
+
+// savedKey identifies a room in a database file.
+type savedKey struct {
+	filename string
+	roomID   string
+}
+
+// savedRoom records how much of a room's solution log and history SaveRoom wrote.
+type savedRoom struct {
+	gameVersion    uint64 // Room.gameVersion of the solution log rows
+	solutionLog    int    // Solution log rows written
+	historyVersion uint64 // Room.historyVersion of the history rows
+}
+
+// SQLiteAvailable reports whether this build has the SQLite backend, which needs
+// cgo. It returns nil here; builds without cgo return an error.
+func SQLiteAvailable() error {
+	return nil
+}
+
+// NewSQLitePersistenceManager creates a PersistenceManager backed by SQLite.
+// The database file is created on first use.
+func NewSQLitePersistenceManager() PersistenceManager {
+	return &sqlitePersistenceManager{
+		dbs:   make(map[string]*sql.DB),
+		saved: make(map[savedKey]savedRoom),
+	}
+}
+
+// forget drops what SaveRoom wrote for rooms in the file, or for one room if
+// roomID is set, so their next save writes them in full.
+func (pm *sqlitePersistenceManager) forget(filename, roomID string) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	for key := range pm.saved {
+		if key.filename == filename && (roomID == "" || key.roomID == roomID) {
+			delete(pm.saved, key)
+		}
+	}
+}
+
+// open returns the database for the file, creating the schema if needed.
+func (pm *sqlitePersistenceManager) open(filename string) (*sql.DB, error) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if db, ok := pm.dbs[filename]; ok {
+		return db, nil
+	}
+
+	dsn := "file:" + filename + "?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=5000"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer; one connection avoids busy errors between our own writes
+	db.SetMaxOpenConns(1)
+
+	if err := initSchema(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	pm.dbs[filename] = db
+	return db, nil
+}
+
+// initSchema creates the schema in a new database and upgrades an existing one.
+func initSchema(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		return err
+	}
+	if version > sqliteSchemaVersion {
+		return fmt.Errorf("room database version %d is newer than supported version %d", version, sqliteSchemaVersion)
+	}
+
+	var tables int
+	if err := db.QueryRow(`SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = 'rooms'`).Scan(&tables); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A new database gets the current schema directly; existing ones are migrated first
+	if tables > 0 {
+		if version == 0 {
+			version = 1
+		}
+		for v := version; v < sqliteSchemaVersion; v++ {
+			if stmt, ok := sqliteMigrations[v]; ok {
+				if _, err := tx.Exec(stmt); err != nil {
+					return fmt.Errorf("migrating room database from version %d: %w", v, err)
+				}
+			}
+		}
+	}
+	if _, err := tx.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("failed to create schema: %w", err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf(`PRAGMA user_version = %d`, sqliteSchemaVersion)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (pm *sqlitePersistenceManager) Load(filename string) (map[string]*Room, error) {
+	db, err := pm.open(filename)
+	if err != nil {
+		return nil, err
+	}
+	pm.forget(filename, "")
+
+	rooms, err := loadRooms(db)
+	if err != nil {
+		return nil, err
+	}
+	if err := loadPlayers(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadWins(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadTeamWins(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadHints(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadGames(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadSolutions(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadSolutionLog(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadHistory(db, rooms); err != nil {
+		return nil, err
+	}
+	if err := loadMatches(db, rooms); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Loaded rooms", "count", len(rooms), "file", filename)
+	return rooms, nil
+}
+
+func (pm *sqlitePersistenceManager) Save(filename string, rooms map[string]*Room) error {
+	db, err := pm.open(filename)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM rooms`); err != nil {
+		return err
+	}
+	for _, room := range rooms {
+		if err := insertRoom(tx, room); err != nil {
+			return fmt.Errorf("room %s: %w", room.ID, err)
+		}
+	}
+	pm.forget(filename, "")
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	slog.Debug("Saved rooms", "count", len(rooms), "file", filename)
+	return nil
+}
+
+func (pm *sqlitePersistenceManager) SaveRoom(filename string, room *Room) error {
+	db, err := pm.open(filename)
+	if err != nil {
+		return err
+	}
+
+	key := savedKey{filename, room.ID}
+	pm.mu.Lock()
+	prev, ok := pm.saved[key]
+	delete(pm.saved, key) // Until this save commits, nothing is known to be written
+	pm.mu.Unlock()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The room row is updated in place, since deleting it would delete every child row
+	if err := writeRoomRow(tx, room, true); err != nil {
+		return err
+	}
+	for _, table := range []string{"players", "wins", "team_wins", "hints", "games", "solutions", "matches"} {
+		if _, err := tx.Exec(`DELETE FROM `+table+` WHERE room_id = ?`, room.ID); err != nil {
+			return err
+		}
+	}
+	if err := insertChildRows(tx, room); err != nil {
+		return err
+	}
+
+	// The solution log only grows during a game, so only its new entries are added
+	from := 0
+	if ok && prev.gameVersion == room.gameVersion && prev.solutionLog <= len(room.SolutionLog) {
+		from = prev.solutionLog
+	} else if _, err := tx.Exec(`DELETE FROM solution_log WHERE room_id = ?`, room.ID); err != nil {
+		return err
+	}
+	if err := insertSolutionLog(tx, room, from); err != nil {
+		return err
+	}
+
+	// The history changes about once a game, so it is only rewritten when it has
+	if !ok || prev.historyVersion != room.historyVersion {
+		if _, err := tx.Exec(`DELETE FROM history WHERE room_id = ?`, room.ID); err != nil {
+			return err
+		}
+		if err := insertHistory(tx, room); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	pm.mu.Lock()
+	pm.saved[key] = savedRoom{gameVersion: room.gameVersion, solutionLog: len(room.SolutionLog), historyVersion: room.historyVersion}
+	pm.mu.Unlock()
+	return nil
+}
+
+func (pm *sqlitePersistenceManager) DeleteRoom(filename, roomID string) error {
+	db, err := pm.open(filename)
+	if err != nil {
+		return err
+	}
+
+	pm.forget(filename, roomID)
+	_, err = db.Exec(`DELETE FROM rooms WHERE id = ?`, roomID)
+	return err
+}
+
+func (pm *sqlitePersistenceManager) FindStaleRooms(rooms map[string]*Room, maxAge time.Duration) []string {
+	return findStaleRooms(rooms, maxAge)
+}
+
+// insertRoom writes a room and its child rows. The room must not already exist.
+func insertRoom(tx *sql.Tx, room *Room) error {
+	if err := writeRoomRow(tx, room, false); err != nil {
+		return err
+	}
+	if err := insertChildRows(tx, room); err != nil {
+		return err
+	}
+	if err := insertSolutionLog(tx, room, 0); err != nil {
+		return err
+	}
+	return insertHistory(tx, room)
+}
+
+// writeRoomRow writes the room's own row, replacing an existing one if update is set.
+func writeRoomRow(tx *sql.Tx, room *Room, update bool) error {
+	finished, err := json.Marshal(nonNilStrings(room.FinishedSolving))
+	if err != nil {
+		return err
+	}
+	ready, err := json.Marshal(nonNilStrings(room.ReadyForNext))
+	if err != nil {
+		return err
+	}
+	stmt := `INSERT INTO rooms (id, created_at, last_activity_at, games_played, finished_solving, ready_for_next, team_quorum)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`
+	if update {
+		stmt += ` ON CONFLICT (id) DO UPDATE SET created_at = excluded.created_at, last_activity_at = excluded.last_activity_at,
+		 games_played = excluded.games_played, finished_solving = excluded.finished_solving,
+		 ready_for_next = excluded.ready_for_next, team_quorum = excluded.team_quorum`
+	}
+	_, err = tx.Exec(stmt,
+		room.ID, formatTime(room.CreatedAt), formatTime(room.LastActivityAt), room.GamesPlayed, string(finished), string(ready), room.TeamQuorum,
+	)
+	return err
+}
+
+// insertChildRows writes the room's child rows other than the solution log and history.
+func insertChildRows(tx *sql.Tx, room *Room) error {
+	for i, p := range room.Players {
+		_, err := tx.Exec(
+			`INSERT INTO players (room_id, position, id, name, status, disconnected_at, account_id, bot, team, handicap_moves, handicap_delay) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			room.ID, i, p.ID, p.Name, string(p.Status), formatTime(p.DisconnectedAt), p.AccountID, string(p.Bot), p.Team, p.Handicap.ExtraMoves, int64(p.Handicap.Delay),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for playerID, count := range room.Wins {
+		if _, err := tx.Exec(`INSERT INTO wins (room_id, player_id, count) VALUES (?, ?, ?)`, room.ID, playerID, count); err != nil {
+			return err
+		}
+	}
+
+	for team, count := range room.TeamWins {
+		if _, err := tx.Exec(`INSERT INTO team_wins (room_id, team, count) VALUES (?, ?, ?)`, room.ID, team, count); err != nil {
+			return err
+		}
+	}
+
+	for playerID, tier := range room.Hints {
+		if _, err := tx.Exec(`INSERT INTO hints (room_id, player_id, tier) VALUES (?, ?, ?)`, room.ID, playerID, tier); err != nil {
+			return err
+		}
+	}
+
+	if room.CurrentGame != nil {
+		game, err := json.Marshal(room.CurrentGame)
+		if err != nil {
+			return err
+		}
+		var startedAt *string
+		if room.GameStartedAt != nil {
+			s := formatTime(*room.GameStartedAt)
+			startedAt = &s
+		}
+		if _, err := tx.Exec(`INSERT INTO games (room_id, game, started_at, seed) VALUES (?, ?, ?, ?)`, room.ID, string(game), startedAt, room.GameSeed); err != nil {
+			return err
+		}
+	}
+
+	for i, sol := range room.Solutions {
+		if err := insertSolution(tx, room.ID, true, i, 0, sol); err != nil {
+			return err
+		}
+	}
+	for i, history := range room.SolutionHistory {
+		for j, sol := range history.Solutions {
+			// History entries belong to the history's player
+			sol.PlayerID = history.PlayerID
+			if err := insertSolution(tx, room.ID, false, i, j, sol); err != nil {
+				return err
+			}
+		}
+	}
+
+	if room.Match != nil {
+		data, err := json.Marshal(room.Match)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO matches (room_id, match) VALUES (?, ?)`, room.ID, string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertSolutionLog writes the room's solution log from the entry at index from on.
+func insertSolutionLog(tx *sql.Tx, room *Room, from int) error {
+	for i := from; i < len(room.SolutionLog); i++ {
+		e := room.SolutionLog[i]
+		moves, err := json.Marshal(e.Moves)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(
+			`INSERT INTO solution_log (room_id, position, player_id, at, retracted, moves) VALUES (?, ?, ?, ?, ?, ?)`,
+			room.ID, i, e.PlayerID, formatTime(e.At), e.Retracted, string(moves),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertHistory writes the room's history.
+func insertHistory(tx *sql.Tx, room *Room) error {
+	for i, rec := range room.History {
+		data, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO history (room_id, position, record) VALUES (?, ?, ?)`, room.ID, i, string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertSolution writes one current or historical solution.
+func insertSolution(tx *sql.Tx, roomID string, current bool, position, seq int, sol PlayerSolution) error {
+	moves, err := json.Marshal(sol.Moves)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(
+		`INSERT INTO solutions (room_id, current, position, seq, player_id, solved_at, moves, hints, handicap_moves, handicap_delay) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		roomID, current, position, seq, sol.PlayerID, formatTime(sol.SolvedAt), string(moves), sol.Hints, sol.Handicap.ExtraMoves, int64(sol.Handicap.Delay),
+	)
+	return err
+}
+
+// loadRooms reads the rooms table.
+func loadRooms(db *sql.DB) (map[string]*Room, error) {
+	rows, err := db.Query(`SELECT id, created_at, last_activity_at, games_played, finished_solving, ready_for_next, team_quorum FROM rooms`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rooms := make(map[string]*Room)
+	for rows.Next() {
+		var (
+			room                          Room
+			createdAt, lastActivityAt     string
+			finishedSolving, readyForNext string
+		)
+		if err := rows.Scan(&room.ID, &createdAt, &lastActivityAt, &room.GamesPlayed, &finishedSolving, &readyForNext, &room.TeamQuorum); err != nil {
+			return nil, err
+		}
+		if room.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, err
+		}
+		if room.LastActivityAt, err = parseTime(lastActivityAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(finishedSolving), &room.FinishedSolving); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(readyForNext), &room.ReadyForNext); err != nil {
+			return nil, err
+		}
+		room.Wins = make(map[string]int)
+		// For backward compatibility: if LastActivityAt is zero, use CreatedAt
+		if room.LastActivityAt.IsZero() {
+			room.LastActivityAt = room.CreatedAt
+		}
+		rooms[room.ID] = &room
+	}
+	return rooms, rows.Err()
+}
+
+// loadPlayers reads the players table into the loaded rooms.
+func loadPlayers(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, id, name, status, disconnected_at, account_id, bot, team, handicap_moves, handicap_delay FROM players ORDER BY room_id, position`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, status, disconnectedAt, bot string
+			p                                   Player
+		)
+		if err := rows.Scan(&roomID, &p.ID, &p.Name, &status, &disconnectedAt, &p.AccountID, &bot, &p.Team, &p.Handicap.ExtraMoves, &p.Handicap.Delay); err != nil {
+			return err
+		}
+		p.Status = PlayerStatus(status)
+		p.Bot = BotLevel(bot)
+		if p.DisconnectedAt, err = parseTime(disconnectedAt); err != nil {
+			return err
+		}
+		if room := rooms[roomID]; room != nil {
+			room.Players = append(room.Players, p)
+		}
+	}
+	return rows.Err()
+}
+
+// loadWins reads the wins table into the loaded rooms.
+func loadWins(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, player_id, count FROM wins`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, playerID string
+			count            int
+		)
+		if err := rows.Scan(&roomID, &playerID, &count); err != nil {
+			return err
+		}
+		if room := rooms[roomID]; room != nil {
+			room.Wins[playerID] = count
+		}
+	}
+	return rows.Err()
+}
+
+// loadTeamWins reads the team_wins table into the loaded rooms.
+func loadTeamWins(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, team, count FROM team_wins`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, team string
+			count        int
+		)
+		if err := rows.Scan(&roomID, &team, &count); err != nil {
+			return err
+		}
+		if room := rooms[roomID]; room != nil {
+			if room.TeamWins == nil {
+				room.TeamWins = make(map[string]int)
+			}
+			room.TeamWins[team] = count
+		}
+	}
+	return rows.Err()
+}
+
+// loadHints reads the hints table into the loaded rooms.
+func loadHints(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, player_id, tier FROM hints`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, playerID string
+			tier             HintTier
+		)
+		if err := rows.Scan(&roomID, &playerID, &tier); err != nil {
+			return err
+		}
+		if room := rooms[roomID]; room != nil {
+			if room.Hints == nil {
+				room.Hints = make(map[string]HintTier)
+			}
+			room.Hints[playerID] = tier
+		}
+	}
+	return rows.Err()
+}
+
+// loadGames reads the games table into the loaded rooms.
+func loadGames(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, game, started_at, seed FROM games`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, data string
+			startedAt    sql.NullString
+			seed         int64
+		)
+		if err := rows.Scan(&roomID, &data, &startedAt, &seed); err != nil {
+			return err
+		}
+		room := rooms[roomID]
+		if room == nil {
+			continue
+		}
+		var game model.Game
+		if err := json.Unmarshal([]byte(data), &game); err != nil {
+			return fmt.Errorf("room %s: invalid game: %w", roomID, err)
+		}
+		room.CurrentGame = &game
+		room.GameSeed = seed
+		if startedAt.Valid {
+			t, err := parseTime(startedAt.String)
+			if err != nil {
+				return err
+			}
+			room.GameStartedAt = &t
+		}
+	}
+	return rows.Err()
+}
+
+// loadSolutions reads the solutions table into the loaded rooms.
+func loadSolutions(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, current, position, player_id, solved_at, moves, hints, handicap_moves, handicap_delay FROM solutions ORDER BY room_id, current, position, seq`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, solvedAt, moves string
+			current                 bool
+			position                int
+			sol                     PlayerSolution
+		)
+		if err := rows.Scan(&roomID, &current, &position, &sol.PlayerID, &solvedAt, &moves, &sol.Hints, &sol.Handicap.ExtraMoves, &sol.Handicap.Delay); err != nil {
+			return err
+		}
+		room := rooms[roomID]
+		if room == nil {
+			continue
+		}
+		if sol.SolvedAt, err = parseTime(solvedAt); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(moves), &sol.Moves); err != nil {
+			return fmt.Errorf("room %s: invalid solution moves: %w", roomID, err)
+		}
+
+		if current {
+			room.Solutions = append(room.Solutions, sol)
+			continue
+		}
+		// Rows are ordered by position, so a new position starts the next player's history
+		if len(room.SolutionHistory) <= position {
+			room.SolutionHistory = append(room.SolutionHistory, PlayerSolutionHistory{PlayerID: sol.PlayerID})
+		}
+		history := &room.SolutionHistory[len(room.SolutionHistory)-1]
+		history.Solutions = append(history.Solutions, sol)
+	}
+	return rows.Err()
+}
+
+// loadSolutionLog reads the solution_log table into the loaded rooms.
+func loadSolutionLog(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, player_id, at, retracted, moves FROM solution_log ORDER BY room_id, position`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			roomID, at, moves string
+			e                 SolutionEvent
+		)
+		if err := rows.Scan(&roomID, &e.PlayerID, &at, &e.Retracted, &moves); err != nil {
+			return err
+		}
+		room := rooms[roomID]
+		if room == nil {
+			continue
+		}
+		if e.At, err = parseTime(at); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(moves), &e.Moves); err != nil {
+			return fmt.Errorf("room %s: invalid solution log moves: %w", roomID, err)
+		}
+		room.SolutionLog = append(room.SolutionLog, e)
+	}
+	return rows.Err()
+}
+
+// loadHistory reads the history table into the loaded rooms.
+func loadHistory(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, record FROM history ORDER BY room_id, position`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var roomID, data string
+		if err := rows.Scan(&roomID, &data); err != nil {
+			return err
+		}
+		room := rooms[roomID]
+		if room == nil {
+			continue
+		}
+		var rec GameRecord
+		if err := json.Unmarshal([]byte(data), &rec); err != nil {
+			return fmt.Errorf("room %s: invalid game record: %w", roomID, err)
+		}
+		room.History = append(room.History, rec)
+	}
+	return rows.Err()
+}
+
+// loadMatches reads the matches table into the loaded rooms.
+func loadMatches(db *sql.DB, rooms map[string]*Room) error {
+	rows, err := db.Query(`SELECT room_id, match FROM matches`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var roomID, data string
+		if err := rows.Scan(&roomID, &data); err != nil {
+			return err
+		}
+		room := rooms[roomID]
+		if room == nil {
+			continue
+		}
+		var match Match
+		if err := json.Unmarshal([]byte(data), &match); err != nil {
+			return fmt.Errorf("room %s: invalid match: %w", roomID, err)
+		}
+		room.Match = &match
+	}
+	return rows.Err()
+}
+
+// formatTime encodes a time for storage, keeping the zero time round-trippable.
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseTime decodes a time written by formatTime.
+func parseTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// nonNilStrings returns s, or an empty slice if s is nil, so it encodes as a JSON array.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,47 @@
+//go:build !cgo
+
+package room
+
+import (
+	"errors"
+	"time"
+)
+
+// errNoSQLite is returned by the SQLite backend in builds without cgo, which
+// the SQLite driver needs.
+var errNoSQLite = errors.New("the SQLite backend needs a build with cgo enabled (CGO_ENABLED=1)")
+
+// SQLiteAvailable reports whether this build has the SQLite backend, which needs
+// cgo. This build doesn't.
+func SQLiteAvailable() error {
+	return errNoSQLite
+}
+
+// NewSQLitePersistenceManager returns a PersistenceManager that fails every
+// load and save, as this build has no SQLite backend; check SQLiteAvailable first.
+func NewSQLitePersistenceManager() PersistenceManager {
+	return noSQLitePersistenceManager{}
+}
+
+// noSQLitePersistenceManager stands in for the SQLite backend in builds without cgo.
+type noSQLitePersistenceManager struct{}
+
+func (noSQLitePersistenceManager) Load(filename string) (map[string]*Room, error) {
+	return nil, errNoSQLite
+}
+
+func (noSQLitePersistenceManager) Save(filename string, rooms map[string]*Room) error {
+	return errNoSQLite
+}
+
+func (noSQLitePersistenceManager) SaveRoom(filename string, room *Room) error {
+	return errNoSQLite
+}
+
+func (noSQLitePersistenceManager) DeleteRoom(filename, roomID string) error {
+	return errNoSQLite
+}
+
+func (noSQLitePersistenceManager) FindStaleRooms(rooms map[string]*Room, maxAge time.Duration) []string {
+	return findStaleRooms(rooms, maxAge)
+}
@@ -0,0 +1,347 @@
+//go:build cgo
+
+package room
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+func TestSQLitePersistenceManager_Load_NewDatabase(t *testing.T) {
+	pm := NewSQLitePersistenceManager()
+
+	rooms, err := pm.Load(filepath.Join(t.TempDir(), "rooms.db"))
+	if err != nil {
+		t.Fatalf("Load should not error on a new database, got: %v", err)
+	}
+	if len(rooms) != 0 {
+		t.Errorf("expected empty rooms map, got %d", len(rooms))
+	}
+}
+
+func TestSQLitePersistenceManager_Load_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+
+	// A database written by a newer server
+	db, err := sql.Open("sqlite3", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf(`PRAGMA user_version = %d`, sqliteSchemaVersion+1)); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	_, err = NewSQLitePersistenceManager().Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
+
+func TestSQLitePersistenceManager_Load_MigratesOlderVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+
+	// A version 2 database, from before games had a seed, players an account or bot level and solutions hints
+	db, err := sql.Open("sqlite3", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`
+		CREATE TABLE rooms (id TEXT PRIMARY KEY, created_at TEXT NOT NULL, last_activity_at TEXT NOT NULL,
+			games_played INTEGER NOT NULL, finished_solving TEXT NOT NULL, ready_for_next TEXT NOT NULL);
+		CREATE TABLE players (room_id TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE, position INTEGER NOT NULL,
+			id TEXT NOT NULL, name TEXT NOT NULL, status TEXT NOT NULL, disconnected_at TEXT NOT NULL, PRIMARY KEY (room_id, id));
+		CREATE TABLE games (room_id TEXT PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE, game TEXT NOT NULL, started_at TEXT);
+		CREATE TABLE solutions (room_id TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE, current INTEGER NOT NULL,
+			position INTEGER NOT NULL, seq INTEGER NOT NULL, player_id TEXT NOT NULL, solved_at TEXT NOT NULL, moves TEXT NOT NULL,
+			PRIMARY KEY (room_id, current, position, seq));
+		INSERT INTO rooms VALUES ('OLD1', '2025-03-01T18:00:00Z', '2025-03-01T18:00:00Z', 0, '[]', '[]');
+		INSERT INTO players VALUES ('OLD1', 0, 'p1', 'Alice', 'connected', '0001-01-01T00:00:00Z');
+		PRAGMA user_version = 2;`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	game, _ := json.Marshal(model.Game1())
+	if _, err := db.Exec(`INSERT INTO games VALUES ('OLD1', ?, NULL)`, string(game)); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	pm := NewSQLitePersistenceManager()
+	rooms, err := pm.Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if room := rooms["OLD1"]; room == nil || room.CurrentGame == nil || room.GameSeed != 0 || len(room.Players) != 1 || room.Players[0].AccountID != "" || room.Players[0].IsBot() {
+		t.Fatalf("expected room OLD1 with its game, no seed and a human guest player, got %+v", room)
+	}
+
+	// The migrated database stores the new columns and tables
+	room := fullRoom("OLD1")
+	if err := pm.SaveRoom(filename, room); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+	rooms, err = pm.Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	assertRoomsEqual(t, room, rooms["OLD1"])
+}
+
+func TestSQLitePersistenceManager_Load_MigratesMatchGamesStarted(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+	room := fullRoom("SEEDED")
+	room.Match = &Match{Rounds: 5, Seed: 500, StartedAt: time.Now().UTC(), GamesPlayed: 2}
+	room.GameSeed = 502
+	pm := NewSQLitePersistenceManager()
+	if err := pm.SaveRoom(filename, room); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+
+	// Roll the database back to version 9, before matches counted games started
+	db, err := sql.Open("sqlite3", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`UPDATE matches SET match = json_remove(match, '$.GamesStarted'); PRAGMA user_version = 9`); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	// The game seeded 502 is the match's third
+	rooms, err := NewSQLitePersistenceManager().Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if m := rooms["SEEDED"].Match; m == nil || m.GamesStarted != 3 {
+		t.Errorf("expected 3 games started, got %+v", m)
+	}
+}
+
+func TestSQLitePersistenceManager_SaveAndLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+	room := fullRoom("FULL")
+	empty := &Room{ID: "EMPTY", Players: []Player{{ID: "p3", Name: "Carol", Status: PlayerStatusConnected}}, CreatedAt: time.Now(), Wins: map[string]int{}}
+
+	if err := NewSQLitePersistenceManager().Save(filename, map[string]*Room{room.ID: room, empty.ID: empty}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	// A fresh manager reads what the first one wrote
+	rooms, err := NewSQLitePersistenceManager().Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(rooms) != 2 {
+		t.Fatalf("expected 2 rooms, got %d", len(rooms))
+	}
+	assertRoomsEqual(t, room, rooms["FULL"])
+
+	loaded := rooms["EMPTY"]
+	if loaded.CurrentGame != nil || loaded.GameStartedAt != nil || len(loaded.Solutions) != 0 {
+		t.Errorf("expected room without game or solutions, got %+v", loaded)
+	}
+	if loaded.Wins == nil {
+		t.Error("expected Wins map to be initialized")
+	}
+	if !loaded.LastActivityAt.Equal(empty.CreatedAt) {
+		t.Errorf("expected zero LastActivityAt to default to CreatedAt, got %v", loaded.LastActivityAt)
+	}
+}
+
+func TestSQLitePersistenceManager_SaveRoom(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+	pm := NewSQLitePersistenceManager()
+
+	first := fullRoom("ONE")
+	second := fullRoom("TWO")
+	if err := pm.SaveRoom(filename, first); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+	if err := pm.SaveRoom(filename, second); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+
+	// Rewriting one room replaces its rows and leaves the other alone
+	first.Players = first.Players[:1]
+	first.Solutions = nil
+	first.SolutionHistory = nil
+	first.SolutionLog = nil
+	first.CurrentGame = nil
+	first.GameSeed = 0
+	first.GameStartedAt = nil
+	first.Wins = map[string]int{"p1": 3}
+	if err := pm.SaveRoom(filename, first); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+
+	rooms, err := pm.Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	assertRoomsEqual(t, first, rooms["ONE"])
+	assertRoomsEqual(t, second, rooms["TWO"])
+}
+
+// rowIDs returns the rowids of a room's rows in a table, in position order.
+func rowIDs(t *testing.T, db *sql.DB, table, roomID string) []int64 {
+	t.Helper()
+	rows, err := db.Query(`SELECT rowid FROM `+table+` WHERE room_id = ? ORDER BY position`, roomID)
+	if err != nil {
+		t.Fatalf("query %s: %v", table, err)
+	}
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("scan %s: %v", table, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestSQLitePersistenceManager_SaveRoom_WritesOnlyChanges(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+	pm := NewSQLitePersistenceManager()
+	db, err := pm.(*sqlitePersistenceManager).open(filename)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+
+	room := fullRoom("ONE")
+	if err := pm.SaveRoom(filename, room); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+	history := rowIDs(t, db, "history", room.ID)
+	log := rowIDs(t, db, "solution_log", room.ID)
+
+	// A new submission adds its log entry and leaves the history alone
+	room.SolutionLog = append(room.SolutionLog, SolutionEvent{PlayerID: "p1", At: time.Now(), Moves: model.Game1Solution()})
+	if err := pm.SaveRoom(filename, room); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+	if got := rowIDs(t, db, "history", room.ID); !slices.Equal(got, history) {
+		t.Errorf("expected unchanged history rows %v, got %v", history, got)
+	}
+	if got := rowIDs(t, db, "solution_log", room.ID); len(got) != len(log)+1 || !slices.Equal(got[:len(log)], log) {
+		t.Errorf("expected log rows %v plus one, got %v", log, got)
+	}
+
+	// Ending the game rewrites the history, and the next game starts a new log
+	room.recordGame(GameRecord{Game: model.Game1(), EndedAt: time.Now(), PlayerNames: map[string]string{}})
+	room.ClearGameState()
+	room.FinishedSolving, room.ReadyForNext = []string{}, []string{} // As loaded
+	room.SolutionLog = append(room.SolutionLog, SolutionEvent{PlayerID: "p2", At: time.Now(), Moves: model.Game1Solution()})
+	if err := pm.SaveRoom(filename, room); err != nil {
+		t.Fatalf("SaveRoom failed: %v", err)
+	}
+	if got := rowIDs(t, db, "history", room.ID); len(got) != len(room.History) {
+		t.Errorf("expected %d history rows, got %d", len(room.History), len(got))
+	}
+
+	rooms, err := pm.Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	assertRoomsEqual(t, room, rooms["ONE"])
+}
+
+func TestSQLitePersistenceManager_DeleteRoom(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+	pm := NewSQLitePersistenceManager()
+
+	if err := pm.Save(filename, map[string]*Room{"ONE": fullRoom("ONE"), "TWO": fullRoom("TWO")}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := pm.DeleteRoom(filename, "ONE"); err != nil {
+		t.Fatalf("DeleteRoom failed: %v", err)
+	}
+
+	rooms, err := pm.Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(rooms) != 1 || rooms["TWO"] == nil {
+		t.Errorf("expected only room TWO to remain, got %d rooms", len(rooms))
+	}
+
+	// Child rows go with the room
+	db, _ := pm.(*sqlitePersistenceManager).open(filename)
+	for _, table := range []string{"players", "wins", "games", "solutions", "history"} {
+		var count int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM ` + table + ` WHERE room_id = 'ONE'`).Scan(&count); err != nil {
+			t.Fatalf("count %s failed: %v", table, err)
+		}
+		if count != 0 {
+			t.Errorf("expected no %s rows for deleted room, got %d", table, count)
+		}
+	}
+}
+
+func TestSQLitePersistenceManager_SaveReplacesAllRooms(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+	pm := NewSQLitePersistenceManager()
+
+	pm.SaveRoom(filename, fullRoom("OLD"))
+	if err := pm.Save(filename, map[string]*Room{"NEW": fullRoom("NEW")}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	rooms, err := pm.Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(rooms) != 1 || rooms["NEW"] == nil {
+		t.Errorf("expected only room NEW after Save, got %d rooms", len(rooms))
+	}
+}
+
+func TestService_SQLite_SavesEachChange(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rooms.db")
+
+	svc1 := NewRoomService()
+	svc1.SetPersistenceManager(NewSQLitePersistenceManager())
+	if err := svc1.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	room := svc1.Create("Alice")
+	svc1.Join(room.ID, "Bob")
+	svc1.StartGame(room.ID)
+
+	// No Save call: every change was already written
+	svc2 := NewRoomService()
+	svc2.SetPersistenceManager(NewSQLitePersistenceManager())
+	if err := svc2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	loaded, err := svc2.Get(room.ID)
+	if err != nil {
+		t.Fatalf("failed to get room after load: %v", err)
+	}
+	if len(loaded.Players) != 2 || loaded.CurrentGame == nil {
+		t.Errorf("expected 2 players and a game after load, got %d players, game %v", len(loaded.Players), loaded.CurrentGame)
+	}
+
+	// Cleaned up rooms are deleted from the database
+	svc1.setRoom(room.ID, &Room{ID: room.ID, LastActivityAt: time.Now().Add(-2 * time.Hour), Wins: map[string]int{}})
+	svc1.CleanupStaleRooms(time.Hour)
+
+	svc3 := NewRoomService()
+	svc3.SetPersistenceManager(NewSQLitePersistenceManager())
+	if err := svc3.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(svc3.rooms()) != 0 {
+		t.Errorf("expected stale room to be deleted, got %d rooms", len(svc3.rooms()))
+	}
+}
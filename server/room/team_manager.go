@@ -0,0 +1,139 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ErrTeamsLocked is returned when teams are changed while a game is in progress,
+// which would let a player move to the team with the best solution.
+var ErrTeamsLocked = errors.New("teams can only change between games")
+
+// DefaultTeams are the teams AssignTeams deals players onto when none are named.
+var DefaultTeams = []string{"Red", "Blue"}
+
+// TeamManager puts players on teams. A team's best solution counts for all its
+// members: the winner's team is credited in Room.TeamWins.
+type TeamManager interface {
+	// SetTeam puts a player on a team, or takes them off their team if team is empty.
+	// Returns signals or error.
+	SetTeam(room *Room, playerID, team string) ([]Signal, error)
+
+	// AssignTeams deals all players, bots included, onto the teams in random
+	// order, so team sizes differ by at most one. Returns signals or error.
+	AssignTeams(room *Room, teams []string) ([]Signal, error)
+
+	// SetTeamQuorum sets whether a team counts toward quorums once any of its
+	// members does. Returns signals or error.
+	SetTeamQuorum(room *Room, enabled bool) ([]Signal, error)
+
+	// GetTeamSolutions returns each team's best standing solution, by team name,
+	// as SolutionManager.GetWinningSolution decides winners.
+	GetTeamSolutions(room *Room) map[string]*PlayerSolution
+}
+
+// teamManager is the concrete implementation of TeamManager.
+type teamManager struct {
+	solutionMgr SolutionManager
+	now         func() time.Time // Clock, replaced when replaying the journal
+}
+
+// NewTeamManager creates a new TeamManager.
+// Requires SolutionManager for ranking team solutions.
+func NewTeamManager(solutionMgr SolutionManager) TeamManager {
+	return &teamManager{solutionMgr: solutionMgr, now: time.Now}
+}
+
+// checkTeamsUnlocked returns ErrTeamsLocked if a game is in progress.
+func checkTeamsUnlocked(room *Room) error {
+	if !room.betweenGames() {
+		return ErrTeamsLocked
+	}
+	return nil
+}
+
+func (tm *teamManager) SetTeam(room *Room, playerID, team string) ([]Signal, error) {
+	idx := room.FindPlayerIndex(playerID)
+	if idx == -1 {
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+	if err := checkTeamsUnlocked(room); err != nil {
+		return nil, err
+	}
+
+	ready := room.quorum(room.ReadyForNext)
+	room.Players[idx].Team = team
+	room.LastActivityAt = tm.now()
+
+	return teamSignals(room, ready), nil
+}
+
+func (tm *teamManager) AssignTeams(room *Room, teams []string) ([]Signal, error) {
+	if len(teams) == 0 {
+		return nil, fmt.Errorf("no teams given")
+	}
+	for _, team := range teams {
+		if team == "" {
+			return nil, fmt.Errorf("team name must not be empty")
+		}
+	}
+	if err := checkTeamsUnlocked(room); err != nil {
+		return nil, err
+	}
+
+	ready := room.quorum(room.ReadyForNext)
+	for i, j := range rand.Perm(len(room.Players)) {
+		room.Players[j].Team = teams[i%len(teams)]
+	}
+	room.LastActivityAt = tm.now()
+
+	return teamSignals(room, ready), nil
+}
+
+func (tm *teamManager) SetTeamQuorum(room *Room, enabled bool) ([]Signal, error) {
+	if err := checkTeamsUnlocked(room); err != nil {
+		return nil, err
+	}
+
+	ready := room.quorum(room.ReadyForNext)
+	room.TeamQuorum = enabled
+	room.LastActivityAt = tm.now()
+
+	return teamSignals(room, ready), nil
+}
+
+func (tm *teamManager) GetTeamSolutions(room *Room) map[string]*PlayerSolution {
+	byTeam := make(map[string][]PlayerSolution)
+	for _, sol := range room.Solutions {
+		if team := room.GetPlayerTeam(sol.PlayerID); team != "" {
+			byTeam[team] = append(byTeam[team], sol)
+		}
+	}
+
+	best := make(map[string]*PlayerSolution, len(byTeam))
+	for team, solutions := range byTeam {
+		best[team] = tm.solutionMgr.GetWinningSolution(solutions)
+	}
+	return best
+}
+
+// teamSignals broadcasts the room's current teams. With the team quorum, a
+// change between games can make everyone ready, so it also signals the next
+// game if the room wasn't ready before.
+func teamSignals(room *Room, wasReady bool) []Signal {
+	teams := make(map[string]string)
+	for _, p := range room.Players {
+		if p.Team != "" {
+			teams[p.ID] = p.Team
+		}
+	}
+	signals := []Signal{
+		BroadcastSignal{Event: TeamsChangedEvent{RoomID: room.ID, Teams: teams, TeamQuorum: room.TeamQuorum}},
+	}
+	if room.CurrentGame != nil && !wasReady && room.quorum(room.ReadyForNext) {
+		signals = append(signals, StartNextGameSignal{RoomID: room.ID})
+	}
+	return signals
+}
@@ -0,0 +1,169 @@
+package room
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+)
+
+// teamRoom returns a room of four people between games.
+func teamRoom() *Room {
+	return &Room{
+		ID: "TEST",
+		Players: []Player{
+			{ID: "alice", Name: "Alice"},
+			{ID: "bob", Name: "Bob"},
+			{ID: "carol", Name: "Carol"},
+			{ID: "dave", Name: "Dave"},
+		},
+		Wins: map[string]int{},
+	}
+}
+
+func TestTeamManager_SetTeam(t *testing.T) {
+	tm := NewTeamManager(NewSolutionManager())
+	room := teamRoom()
+
+	signals, err := tm.SetTeam(room, "alice", "Red")
+	if err != nil {
+		t.Fatalf("SetTeam failed: %v", err)
+	}
+	if room.Players[0].Team != "Red" {
+		t.Errorf("expected alice on Red, got %q", room.Players[0].Team)
+	}
+	if len(signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(signals))
+	}
+	event, ok := signals[0].(BroadcastSignal).Event.(TeamsChangedEvent)
+	if !ok || event.Teams["alice"] != "Red" || len(event.Teams) != 1 {
+		t.Errorf("expected TeamsChangedEvent with alice on Red, got %+v", signals[0])
+	}
+
+	// An empty team takes the player off theirs
+	tm.SetTeam(room, "alice", "")
+	if room.Players[0].Team != "" {
+		t.Errorf("expected alice off the team, got %q", room.Players[0].Team)
+	}
+
+	if _, err := tm.SetTeam(room, "nobody", "Red"); err == nil {
+		t.Error("expected error for unknown player")
+	}
+}
+
+func TestTeamManager_LockedDuringGame(t *testing.T) {
+	tm := NewTeamManager(NewSolutionManager())
+	room := teamRoom()
+	room.CurrentGame = model.Game1()
+
+	if _, err := tm.SetTeam(room, "alice", "Red"); !errors.Is(err, ErrTeamsLocked) {
+		t.Errorf("expected ErrTeamsLocked from SetTeam, got %v", err)
+	}
+	if _, err := tm.AssignTeams(room, DefaultTeams); !errors.Is(err, ErrTeamsLocked) {
+		t.Errorf("expected ErrTeamsLocked from AssignTeams, got %v", err)
+	}
+	if _, err := tm.SetTeamQuorum(room, true); !errors.Is(err, ErrTeamsLocked) {
+		t.Errorf("expected ErrTeamsLocked from SetTeamQuorum, got %v", err)
+	}
+
+	// Once everyone has finished, the game is over and teams can change
+	room.FinishedSolving = []string{"alice", "bob", "carol", "dave"}
+	if _, err := tm.SetTeam(room, "alice", "Red"); err != nil {
+		t.Errorf("expected SetTeam between games to succeed, got %v", err)
+	}
+}
+
+func TestTeamManager_AssignTeams(t *testing.T) {
+	tm := NewTeamManager(NewSolutionManager())
+	room := teamRoom()
+	room.Players = append(room.Players, Player{ID: "bot", Name: "Easy Bot", Bot: BotEasy})
+
+	if _, err := tm.AssignTeams(room, []string{"Red", "Blue"}); err != nil {
+		t.Fatalf("AssignTeams failed: %v", err)
+	}
+	sizes := map[string]int{}
+	for _, p := range room.Players {
+		sizes[p.Team]++
+	}
+	if len(sizes) != 2 || sizes["Red"]+sizes["Blue"] != 5 || sizes["Red"] < 2 || sizes["Blue"] < 2 {
+		t.Errorf("expected 5 players split 3/2 between Red and Blue, got %v", sizes)
+	}
+
+	if _, err := tm.AssignTeams(room, nil); err == nil {
+		t.Error("expected error for no teams")
+	}
+	if _, err := tm.AssignTeams(room, []string{"Red", ""}); err == nil {
+		t.Error("expected error for an empty team name")
+	}
+}
+
+func TestTeamManager_SetTeamQuorum_StartsNextGameIfReady(t *testing.T) {
+	tm := NewTeamManager(NewSolutionManager())
+	room := teamRoom()
+	room.CurrentGame = model.Game1()
+	room.FinishedSolving = []string{"alice", "bob", "carol", "dave"}
+	for i := range room.Players {
+		room.Players[i].Team = DefaultTeams[i%2]
+	}
+	room.ReadyForNext = []string{"alice", "bob"}
+
+	signals, err := tm.SetTeamQuorum(room, true)
+	if err != nil {
+		t.Fatalf("SetTeamQuorum failed: %v", err)
+	}
+	if !room.TeamQuorum {
+		t.Error("expected team quorum on")
+	}
+	if !hasSignal[StartNextGameSignal](signals) {
+		t.Error("expected one ready member per team to start the next game")
+	}
+}
+
+func TestTeamManager_GetTeamSolutions(t *testing.T) {
+	tm := NewTeamManager(NewSolutionManager())
+	room := teamRoom()
+	room.Players[0].Team = "Red"
+	room.Players[1].Team = "Red"
+	room.Players[2].Team = "Blue"
+	now := time.Now()
+	room.Solutions = []PlayerSolution{
+		{PlayerID: "alice", SolvedAt: now, Moves: make([]model.BotPosition, 6)},
+		{PlayerID: "bob", SolvedAt: now.Add(time.Second), Moves: make([]model.BotPosition, 4)},
+		{PlayerID: "carol", SolvedAt: now, Moves: make([]model.BotPosition, 5)},
+		{PlayerID: "dave", SolvedAt: now, Moves: make([]model.BotPosition, 3)},
+	}
+
+	best := tm.GetTeamSolutions(room)
+	if len(best) != 2 {
+		t.Fatalf("expected solutions for 2 teams, got %v", best)
+	}
+	if best["Red"].PlayerID != "bob" || best["Blue"].PlayerID != "carol" {
+		t.Errorf("expected bob's for Red and carol's for Blue, got %s and %s", best["Red"].PlayerID, best["Blue"].PlayerID)
+	}
+}
+
+func TestRoom_Quorum_TeamQuorum(t *testing.T) {
+	room := teamRoom()
+	for i := range room.Players {
+		room.Players[i].Team = DefaultTeams[i%2]
+	}
+	ids := []string{"alice", "bob"} // One from each team
+
+	if room.quorum(ids) {
+		t.Error("expected every player to be needed without the team quorum")
+	}
+	room.TeamQuorum = true
+	if !room.quorum(ids) {
+		t.Error("expected one member per team to reach the team quorum")
+	}
+	if room.quorum([]string{"alice"}) {
+		t.Error("expected Blue to be missing from the team quorum")
+	}
+
+	// Players off teams still count for themselves
+	room.Players[3].Team = ""
+	if room.quorum(ids) {
+		t.Error("expected dave, on no team, to be needed")
+	}
+}
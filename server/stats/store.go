@@ -0,0 +1,370 @@
+// Package stats aggregates every signed-in player's game results across rooms,
+// so leaderboards and player statistics survive rooms being cleaned up.
+package stats
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Windows over which statistics are aggregated. Recent results are kept for a
+// week, so windows longer than Week are not supported other than AllTime.
+const (
+	AllTime time.Duration = 0
+	Day                   = 24 * time.Hour
+	Week                  = 7 * Day
+)
+
+// currentVersion is the stats file format version written by the Store.
+const currentVersion = 1
+
+// Result is one signed-in player's outcome in a completed game.
+type Result struct {
+	AccountID    string
+	RoomID       string
+	EndedAt      time.Time
+	Won          bool
+	Solved       bool
+	Moves        int           // Moves in the player's best solution, 0 if unsolved
+	OptimalMoves int           // Fewest moves that solve the game, 0 if unknown
+	SolveTime    time.Duration // From the game's start to the player's first solution, 0 if unknown
+}
+
+// Stats summarizes an account's results over a window.
+type Stats struct {
+	AccountID     string
+	Rounds        int
+	Wins          int
+	Solved        int
+	MovesOver     int           // Total moves over optimal, across OptimalRounds
+	OptimalRounds int           // Solved rounds whose optimal move count is known
+	FastestSolve  time.Duration // 0 if no solve time is known
+	WinStreak     int           // Consecutive wins up to the latest round
+	BestWinStreak int
+	LastPlayedAt  time.Time
+}
+
+// add folds a result into the summary. Results must be added oldest first.
+func (s *Stats) add(r Result) {
+	s.Rounds++
+	s.LastPlayedAt = r.EndedAt
+	if r.Won {
+		s.Wins++
+		s.WinStreak++
+		s.BestWinStreak = max(s.BestWinStreak, s.WinStreak)
+	} else {
+		s.WinStreak = 0
+	}
+	if !r.Solved {
+		return
+	}
+	s.Solved++
+	if r.OptimalMoves > 0 {
+		s.MovesOver += r.Moves - r.OptimalMoves
+		s.OptimalRounds++
+	}
+	if r.SolveTime > 0 && (s.FastestSolve == 0 || r.SolveTime < s.FastestSolve) {
+		s.FastestSolve = r.SolveTime
+	}
+}
+
+// SolveRate returns the fraction of rounds the account solved.
+func (s *Stats) SolveRate() float64 {
+	if s.Rounds == 0 {
+		return 0
+	}
+	return float64(s.Solved) / float64(s.Rounds)
+}
+
+// AvgMovesOverOptimal returns the average number of moves over optimal in
+// solved rounds whose optimal move count is known.
+func (s *Stats) AvgMovesOverOptimal() float64 {
+	if s.OptimalRounds == 0 {
+		return 0
+	}
+	return float64(s.MovesOver) / float64(s.OptimalRounds)
+}
+
+// ToProto converts Stats to its protobuf representation, without the account name.
+func (s *Stats) ToProto() *pb.PlayerStats {
+	out := &pb.PlayerStats{
+		AccountId:           s.AccountID,
+		Wins:                int32(s.Wins),
+		RoundsPlayed:        int32(s.Rounds),
+		RoundsSolved:        int32(s.Solved),
+		SolveRate:           s.SolveRate(),
+		AvgMovesOverOptimal: s.AvgMovesOverOptimal(),
+		WinStreak:           int32(s.WinStreak),
+		BestWinStreak:       int32(s.BestWinStreak),
+	}
+	if s.FastestSolve > 0 {
+		out.FastestSolve = durationpb.New(s.FastestSolve)
+	}
+	if !s.LastPlayedAt.IsZero() {
+		out.LastPlayedAt = timestamppb.New(s.LastPlayedAt)
+	}
+	return out
+}
+
+// Order is how a leaderboard is sorted.
+type Order int
+
+const (
+	ByWins Order = iota
+	BySolveRate
+	ByFastestSolve
+	ByBestWinStreak
+)
+
+// compare orders a before b when a ranks higher. Ties fall back to wins, then
+// rounds played, then account ID so leaderboards are stable.
+func (o Order) compare(a, b *Stats) int {
+	var c int
+	switch o {
+	case BySolveRate:
+		c = cmp.Compare(b.SolveRate(), a.SolveRate())
+	case ByFastestSolve:
+		// Accounts without a solve time go last
+		switch {
+		case a.FastestSolve == 0 || b.FastestSolve == 0:
+			c = cmp.Compare(b.FastestSolve, a.FastestSolve)
+		default:
+			c = cmp.Compare(a.FastestSolve, b.FastestSolve)
+		}
+	case ByBestWinStreak:
+		c = cmp.Compare(b.BestWinStreak, a.BestWinStreak)
+	}
+	if c != 0 {
+		return c
+	}
+	if c := cmp.Compare(b.Wins, a.Wins); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(b.Rounds, a.Rounds); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.AccountID, b.AccountID)
+}
+
+// Store aggregates results for every account: running all-time totals, plus the
+// last week's results for daily and weekly windows. After Load, every change is
+// written to the stats file.
+type Store struct {
+	mu       sync.Mutex
+	filename string            // Set by Load; empty keeps stats in memory only
+	totals   map[string]*Stats // All-time stats by account ID
+	recent   []Result          // Results from the last Week, oldest first
+	now      func() time.Time
+}
+
+// NewStore creates an empty in-memory Store.
+func NewStore() *Store {
+	return &Store{totals: make(map[string]*Stats), now: time.Now}
+}
+
+// persistedStats is the JSON structure of the stats file.
+type persistedStats struct {
+	Totals  []*Stats  `json:"totals"`
+	Recent  []Result  `json:"recent"`
+	SavedAt time.Time `json:"saved_at"`
+	Version int       `json:"version"`
+}
+
+// Load reads stats from the file, if it exists, and saves changes there afterwards.
+// Files from a newer format version are refused.
+func (s *Store) Load(filename string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 {
+		var ps persistedStats
+		if err := json.Unmarshal(data, &ps); err != nil {
+			return err
+		}
+		if ps.Version > currentVersion {
+			return fmt.Errorf("stats file version %d is newer than supported version %d", ps.Version, currentVersion)
+		}
+		for _, st := range ps.Totals {
+			s.totals[st.AccountID] = st
+		}
+		s.recent = ps.Recent
+		slog.Info("Loaded stats", "accounts", len(ps.Totals), "file", filename)
+	}
+
+	s.filename = filename
+	return nil
+}
+
+// Player returns an account's stats over the window ending now. An account with
+// no results in the window has zero stats.
+func (s *Store) Player(accountID string, window time.Duration) Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if window == AllTime {
+		if st, ok := s.totals[accountID]; ok {
+			return *st
+		}
+		return Stats{AccountID: accountID}
+	}
+	st := Stats{AccountID: accountID}
+	for _, r := range s.since(window) {
+		if r.AccountID == accountID {
+			st.add(r)
+		}
+	}
+	return st
+}
+
+// Leaderboard returns up to limit accounts that played in the window, in order.
+func (s *Store) Leaderboard(window time.Duration, order Order, limit int) []Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var board []Stats
+	if window == AllTime {
+		for _, st := range s.totals {
+			board = append(board, *st)
+		}
+	} else {
+		byAccount := make(map[string]*Stats)
+		for _, r := range s.since(window) {
+			st, ok := byAccount[r.AccountID]
+			if !ok {
+				st = &Stats{AccountID: r.AccountID}
+				byAccount[r.AccountID] = st
+			}
+			st.add(r)
+		}
+		for _, st := range byAccount {
+			board = append(board, *st)
+		}
+	}
+	slices.SortFunc(board, func(a, b Stats) int { return order.compare(&a, &b) })
+	if len(board) > limit {
+		board = board[:limit]
+	}
+	return board
+}
+
+// since returns the recent results that ended within the window. Must be called
+// with mu held.
+func (s *Store) since(window time.Duration) []Result {
+	cutoff := s.now().Add(-window)
+	i, _ := slices.BinarySearchFunc(s.recent, cutoff, func(r Result, t time.Time) int {
+		return r.EndedAt.Compare(t)
+	})
+	return s.recent[i:]
+}
+
+// RecordGame adds the results of a completed game's signed-in players,
+// implementing room.GameRecorder. Guests have no stats.
+func (s *Store) RecordGame(roomID string, rec room.GameRecord) {
+	results := gameResults(roomID, rec)
+	if len(results) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range results {
+		st, ok := s.totals[r.AccountID]
+		if !ok {
+			st = &Stats{AccountID: r.AccountID}
+			s.totals[r.AccountID] = st
+		}
+		st.add(r)
+	}
+	s.recent = append(s.since(Week), results...)
+	// Games from different rooms can be recorded slightly out of order
+	slices.SortStableFunc(s.recent, func(a, b Result) int { return a.EndedAt.Compare(b.EndedAt) })
+
+	if err := s.save(); err != nil {
+		slog.Error("Failed to save stats after game", logging.RoomID(roomID), "error", err)
+	}
+}
+
+// gameResults returns the result of each signed-in player of a completed game,
+// ordered by account ID.
+func gameResults(roomID string, rec room.GameRecord) []Result {
+	best := rec.BestSolutions()
+	var results []Result
+	for playerID, accountID := range rec.AccountIDs {
+		r := Result{
+			AccountID:    accountID,
+			RoomID:       roomID,
+			EndedAt:      rec.EndedAt,
+			Won:          playerID == rec.WinnerID,
+			OptimalMoves: rec.OptimalMoves,
+		}
+		if b, ok := best[playerID]; ok {
+			r.Solved = true
+			r.Moves = b.MoveCount()
+			r.SolveTime = firstSolveTime(rec, playerID)
+		}
+		results = append(results, r)
+	}
+	slices.SortFunc(results, func(a, b Result) int { return cmp.Compare(a.AccountID, b.AccountID) })
+	return results
+}
+
+// firstSolveTime returns how long the player took to submit their first standing
+// solution, or 0 if the game's start time is unknown.
+func firstSolveTime(rec room.GameRecord, playerID string) time.Duration {
+	if rec.StartedAt == nil {
+		return 0
+	}
+	for _, sol := range rec.Solutions {
+		if sol.PlayerID == playerID {
+			return sol.SolvedAt.Sub(*rec.StartedAt)
+		}
+	}
+	return 0
+}
+
+// save writes every account's totals and the recent results to the stats file.
+// Must be called with mu held.
+func (s *Store) save() error {
+	if s.filename == "" {
+		return nil
+	}
+
+	ps := persistedStats{Recent: s.recent, SavedAt: s.now(), Version: currentVersion}
+	for _, st := range s.totals {
+		ps.Totals = append(ps.Totals, st)
+	}
+	slices.SortFunc(ps.Totals, func(a, b *Stats) int { return cmp.Compare(a.AccountID, b.AccountID) })
+
+	data, err := json.MarshalIndent(ps, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to temp file first, then rename for atomicity
+	tmpFile := s.filename + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, s.filename); err != nil {
+		os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+var start = time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+
+func solution(playerID string, moves int, at time.Duration) room.PlayerSolution {
+	return room.PlayerSolution{PlayerID: playerID, SolvedAt: start.Add(at), Moves: make([]model.BotPosition, moves)}
+}
+
+// game returns the record of a game that started at start and ended a minute later,
+// won by the first solution, between players p1 and p2 signed in as a1 and a2.
+func game(optimal int, solutions ...room.PlayerSolution) room.GameRecord {
+	startedAt := start
+	rec := room.GameRecord{
+		StartedAt:    &startedAt,
+		EndedAt:      start.Add(time.Minute),
+		Solutions:    solutions,
+		AccountIDs:   map[string]string{"p1": "a1", "p2": "a2"},
+		OptimalMoves: optimal,
+	}
+	if len(solutions) > 0 {
+		rec.WinnerID = solutions[0].PlayerID
+	}
+	return rec
+}
+
+// newTestStore returns a Store whose clock is fixed shortly after the test games.
+func newTestStore() *Store {
+	s := NewStore()
+	s.now = func() time.Time { return start.Add(time.Hour) }
+	return s
+}
+
+func TestStore_RecordGame(t *testing.T) {
+	s := newTestStore()
+	s.RecordGame("ROOM1", game(4, solution("p1", 6, 20*time.Second), solution("p1", 5, 40*time.Second)))
+	s.RecordGame("ROOM2", game(4, solution("p2", 4, 10*time.Second)))
+	s.RecordGame("ROOM1", game(0))
+
+	a1 := s.Player("a1", AllTime)
+	if a1.Rounds != 3 || a1.Wins != 1 || a1.Solved != 1 {
+		t.Errorf("expected a1 to play 3, win 1 and solve 1, got %+v", a1)
+	}
+	if got := a1.AvgMovesOverOptimal(); got != 1 {
+		t.Errorf("expected a1's best solution to be 1 move over optimal, got %v", got)
+	}
+	if a1.FastestSolve != 20*time.Second {
+		t.Errorf("expected a1's fastest solve to be the first solution, got %v", a1.FastestSolve)
+	}
+	if got := a1.SolveRate(); got != 1.0/3 {
+		t.Errorf("expected a1's solve rate to be 1/3, got %v", got)
+	}
+
+	a2 := s.Player("a2", AllTime)
+	if a2.Wins != 1 || a2.AvgMovesOverOptimal() != 0 || a2.FastestSolve != 10*time.Second {
+		t.Errorf("unexpected a2 stats: %+v", a2)
+	}
+}
+
+func TestStore_RecordGame_IgnoresGuests(t *testing.T) {
+	s := newTestStore()
+	rec := game(0, solution("guest", 3, time.Second))
+	rec.AccountIDs = nil
+	s.RecordGame("ROOM1", rec)
+
+	if board := s.Leaderboard(AllTime, ByWins, 10); len(board) != 0 {
+		t.Errorf("expected no stats for guests, got %+v", board)
+	}
+}
+
+func TestStore_WinStreaks(t *testing.T) {
+	s := newTestStore()
+	for _, winner := range []string{"p1", "p1", "p1", "p2", "p1"} {
+		s.RecordGame("ROOM1", game(0, solution(winner, 5, time.Second)))
+	}
+
+	a1 := s.Player("a1", AllTime)
+	if a1.WinStreak != 1 || a1.BestWinStreak != 3 {
+		t.Errorf("expected a1's streak to be 1 with a best of 3, got %d and %d", a1.WinStreak, a1.BestWinStreak)
+	}
+}
+
+func TestStore_Windows(t *testing.T) {
+	s := newTestStore()
+	now := start.Add(8 * Day)
+	s.now = func() time.Time { return now }
+
+	for _, ago := range []time.Duration{10 * Day, 3 * Day, time.Hour} {
+		rec := game(0, solution("p1", 5, time.Second))
+		rec.EndedAt = now.Add(-ago)
+		s.RecordGame("ROOM1", rec)
+	}
+
+	tests := []struct {
+		window time.Duration
+		want   int
+	}{
+		{Day, 1},
+		{Week, 2},
+		{AllTime, 3},
+	}
+	for _, tt := range tests {
+		if got := s.Player("a1", tt.window).Wins; got != tt.want {
+			t.Errorf("window %v: expected %d wins, got %d", tt.window, tt.want, got)
+		}
+	}
+	if len(s.recent) != 4 {
+		t.Errorf("expected results older than a week to be dropped, got %d recent results", len(s.recent))
+	}
+}
+
+func TestStore_Leaderboard(t *testing.T) {
+	s := newTestStore()
+	rec := game(0, solution("p1", 5, 30*time.Second), solution("p2", 6, 10*time.Second))
+	rec.AccountIDs["p3"] = "a3"
+	s.RecordGame("ROOM1", rec)
+
+	tests := []struct {
+		order Order
+		want  []string
+	}{
+		{ByWins, []string{"a1", "a2", "a3"}},
+		{BySolveRate, []string{"a1", "a2", "a3"}},
+		{ByFastestSolve, []string{"a2", "a1", "a3"}},
+	}
+	for _, tt := range tests {
+		board := s.Leaderboard(Day, tt.order, 10)
+		var got []string
+		for _, st := range board {
+			got = append(got, st.AccountID)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("order %d: expected %v, got %v", tt.order, tt.want, got)
+		}
+	}
+
+	if board := s.Leaderboard(AllTime, ByWins, 1); len(board) != 1 || board[0].AccountID != "a1" {
+		t.Errorf("expected the limit to keep only a1, got %+v", board)
+	}
+}
+
+func TestStore_PersistsAcrossLoads(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "stats.json")
+
+	s1 := newTestStore()
+	if err := s1.Load(filename); err != nil {
+		t.Fatalf("Load of missing file failed: %v", err)
+	}
+	s1.RecordGame("ROOM1", game(4, solution("p1", 5, 20*time.Second)))
+
+	s2 := newTestStore()
+	if err := s2.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	for _, window := range []time.Duration{Day, AllTime} {
+		if got, want := s2.Player("a1", window), s1.Player("a1", window); got != want {
+			t.Errorf("window %v: expected %+v after reload, got %+v", window, want, got)
+		}
+	}
+}
+
+func TestStore_Load_RefusesNewerVersion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "stats.json")
+	if err := os.WriteFile(filename, []byte(`{"totals":[],"version":99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := NewStore().Load(filename)
+	if err == nil || !strings.Contains(err.Error(), "newer than supported") {
+		t.Errorf("expected newer version to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,533 @@
+package tournament
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	mathrand "math/rand"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+const (
+	// currentVersion is the tournaments file format version written by the Manager.
+	currentVersion = 1
+
+	// subscriptionBufferSize is the number of events buffered per subscriber.
+	// A subscriber that falls further behind is dropped.
+	subscriptionBufferSize = 16
+)
+
+// ErrNotFound is returned for an unknown tournament.
+var ErrNotFound = errors.New("tournament not found")
+
+// ErrStarted is returned when a tournament is started twice.
+var ErrStarted = errors.New("tournament already started")
+
+// Options configure a new tournament.
+type Options struct {
+	Name    string
+	Format  Format
+	FirstTo int // Wins that take each table's match
+	Rounds  int // Swiss rounds, 0 for enough to find a sole winner; ignored for elimination
+}
+
+// Manager creates tournaments and runs them: it opens a room for each table
+// through RoomService, seats the table's accounts in it and starts a match. It
+// is passed every completed game, and advances a tournament once every table
+// of its round has been decided. After Load, every change is written to the
+// tournaments file.
+type Manager struct {
+	mu          sync.Mutex
+	rooms       *room.RoomService
+	filename    string                 // Set by Load; empty keeps tournaments in memory only
+	tournaments map[string]*Tournament // By ID
+	byRoom      map[string]string      // Tournament ID by room ID, for the latest round's tables
+	subs        map[string]map[*Subscription]bool
+	seqs        map[string]uint64
+	now         func() time.Time
+	newSeed     func() int64
+	open        func(t *Tournament, seed int64, table *Table) (string, error) // Opens a table's room, returning its ID
+}
+
+// NewManager creates a Manager that opens tables in rooms.
+func NewManager(rooms *room.RoomService) *Manager {
+	m := &Manager{
+		rooms:       rooms,
+		tournaments: make(map[string]*Tournament),
+		byRoom:      make(map[string]string),
+		subs:        make(map[string]map[*Subscription]bool),
+		seqs:        make(map[string]uint64),
+		now:         time.Now,
+		newSeed:     func() int64 { return mathrand.Int63n(1<<62) + 1 },
+	}
+	m.open = m.openTable
+	return m
+}
+
+// persistedTournaments is the JSON structure of the tournaments file.
+type persistedTournaments struct {
+	Tournaments []*Tournament `json:"tournaments"`
+	SavedAt     time.Time     `json:"saved_at"`
+	Version     int           `json:"version"`
+}
+
+// Load reads tournaments from the file, if it exists, and saves changes there afterwards.
+// Files from a newer format version are refused.
+func (m *Manager) Load(filename string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 {
+		var pt persistedTournaments
+		if err := json.Unmarshal(data, &pt); err != nil {
+			return err
+		}
+		if pt.Version > currentVersion {
+			return fmt.Errorf("tournaments file version %d is newer than supported version %d", pt.Version, currentVersion)
+		}
+		for _, t := range pt.Tournaments {
+			m.tournaments[t.ID] = t
+			m.indexLocked(t)
+		}
+		slog.Info("Loaded tournaments", "count", len(pt.Tournaments), "file", filename)
+	}
+
+	m.filename = filename
+	return nil
+}
+
+// Create registers a tournament for the entrants, in seed order. It starts
+// with Start.
+func (m *Manager) Create(opts Options, entrants []Entrant) (*pb.Tournament, error) {
+	if !ValidFormat(opts.Format) {
+		return nil, fmt.Errorf("unknown tournament format: %s", opts.Format)
+	}
+	if opts.FirstTo < 1 {
+		return nil, fmt.Errorf("tables must be played to at least one win")
+	}
+	if opts.Rounds < 0 {
+		return nil, fmt.Errorf("rounds must not be negative")
+	}
+	if len(entrants) < 2 {
+		return nil, fmt.Errorf("a tournament needs at least 2 entrants")
+	}
+	seen := make(map[string]bool)
+	for _, e := range entrants {
+		if seen[e.AccountID] {
+			return nil, fmt.Errorf("account entered twice: %s", e.AccountID)
+		}
+		seen[e.AccountID] = true
+	}
+
+	t := &Tournament{
+		ID:        generateID(),
+		Name:      opts.Name,
+		Format:    opts.Format,
+		FirstTo:   opts.FirstTo,
+		Entrants:  slices.Clone(entrants),
+		CreatedAt: m.now(),
+	}
+	if t.Format == FormatSwiss {
+		t.Rounds = opts.Rounds
+		if t.Rounds == 0 {
+			t.Rounds = swissRounds(len(entrants))
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tournaments[t.ID] = t
+	m.saveLocked()
+	return t.ToProto(), nil
+}
+
+// Start opens the first round's tables. Starting a tournament again retries
+// the tables of its current round whose rooms couldn't be opened. It returns an
+// error if some tables are still without a room.
+func (m *Manager) Start(id string) (*pb.Tournament, error) {
+	m.mu.Lock()
+	t, ok := m.tournaments[id]
+	if !ok {
+		m.mu.Unlock()
+		return nil, ErrNotFound
+	}
+	var pending []*Table
+	if t.StartedAt == nil {
+		now := m.now()
+		t.StartedAt = &now
+		pending = m.advanceLocked(t)
+		m.saveLocked()
+	} else if pending = t.unopenedTables(); len(pending) == 0 {
+		m.mu.Unlock()
+		return nil, ErrStarted
+	}
+	markOpening(pending)
+	m.mu.Unlock()
+
+	if err := m.openTables(t, pending); err != nil {
+		return nil, err
+	}
+	return m.Get(id)
+}
+
+// Get returns a tournament's bracket.
+func (m *Manager) Get(id string) (*pb.Tournament, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tournaments[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return t.ToProto(), nil
+}
+
+// ReportResult decides a table of the current round by hand, e.g. when an
+// entrant doesn't show up or a match was stopped without a champion. A table
+// whose room couldn't be opened is reported with an empty room ID.
+func (m *Manager) ReportResult(id, roomID, winnerID string) (*pb.Tournament, error) {
+	m.mu.Lock()
+	t, ok := m.tournaments[id]
+	if !ok {
+		m.mu.Unlock()
+		return nil, ErrNotFound
+	}
+	table := t.table(roomID)
+	if roomID == "" {
+		table = nil
+		for _, unopened := range t.unopenedTables() {
+			if slices.Contains(unopened.AccountIDs, winnerID) {
+				table = unopened
+			}
+		}
+	}
+	if table == nil || table.bye() {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("table not found in the current round: %s", roomID)
+	}
+	if !slices.Contains(table.AccountIDs, winnerID) {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("account %s is not at table %s", winnerID, roomID)
+	}
+	pending := m.decideLocked(t, table, winnerID)
+	m.mu.Unlock()
+
+	// The result stands even if the next round's tables can't all be opened
+	m.openTables(t, pending)
+	return m.Get(id)
+}
+
+// RecordGame decides a table once the match in its room has a champion.
+// It is called outside the room lock, so it can read the room.
+func (m *Manager) RecordGame(roomID string, rec room.GameRecord) {
+	m.mu.Lock()
+	_, ok := m.tournaments[m.byRoom[roomID]]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	snapshot, err := m.rooms.Snapshot(roomID)
+	if err != nil {
+		return
+	}
+	match := snapshot.GetMatch()
+	if match.GetEndedAt() == nil || match.GetChampionId() == "" {
+		return
+	}
+
+	m.mu.Lock()
+	t, ok := m.tournaments[m.byRoom[roomID]]
+	if !ok {
+		m.mu.Unlock()
+		return
+	}
+	table := t.table(roomID)
+	if table == nil || table.WinnerID != "" {
+		m.mu.Unlock()
+		return
+	}
+	var pending []*Table
+	for _, p := range snapshot.Players {
+		if p.Id == match.ChampionId && slices.Contains(table.AccountIDs, p.AccountId) {
+			pending = m.decideLocked(t, table, p.AccountId)
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	m.openTables(t, pending)
+}
+
+// decideLocked records a table's winner and moves on to the next round once
+// the round is decided. It returns the next round's tables, already marked as
+// opening, for the caller to open after unlocking. Caller must hold m.mu.
+func (m *Manager) decideLocked(t *Tournament, table *Table, winnerID string) []*Table {
+	table.WinnerID = winnerID
+	round := t.Bracket[len(t.Bracket)-1]
+	m.publishLocked(t, &pb.TournamentEvent{Event: &pb.TournamentEvent_TableDecided{TableDecided: &pb.TableDecidedEvent{
+		Round:    int32(round.Number),
+		RoomId:   table.RoomID,
+		WinnerId: winnerID,
+	}}})
+	var pending []*Table
+	if round.decided() {
+		pending = m.advanceLocked(t)
+		markOpening(pending)
+	}
+	m.saveLocked()
+	return pending
+}
+
+// advanceLocked starts the next round, or crowns the champion if the tournament
+// is over. It returns the round's tables that need rooms; the round is announced
+// once they are open. Caller must hold m.mu.
+func (m *Manager) advanceLocked(t *Tournament) []*Table {
+	pairings := t.nextPairings()
+	if pairings == nil {
+		now := m.now()
+		t.EndedAt = &now
+		t.ChampionID = t.standings()[0]
+		m.publishLocked(t, &pb.TournamentEvent{Event: &pb.TournamentEvent_Finished{Finished: &pb.TournamentFinishedEvent{
+			ChampionId: t.ChampionID,
+		}}})
+		return nil
+	}
+
+	round := &Round{Number: len(t.Bracket) + 1, Seed: m.newSeed()}
+	t.Bracket = append(t.Bracket, round)
+	var pending []*Table
+	for _, ids := range pairings {
+		table := &Table{AccountIDs: ids}
+		round.Tables = append(round.Tables, table)
+		if table.bye() {
+			table.WinnerID = ids[0]
+			continue
+		}
+		pending = append(pending, table)
+	}
+	m.indexLocked(t)
+	if len(pending) > 0 {
+		return pending
+	}
+
+	// A round of byes alone is already decided
+	m.publishRoundStartedLocked(t)
+	return m.advanceLocked(t)
+}
+
+// publishRoundStartedLocked announces the tournament's latest round. Caller must hold m.mu.
+func (m *Manager) publishRoundStartedLocked(t *Tournament) {
+	m.publishLocked(t, &pb.TournamentEvent{Event: &pb.TournamentEvent_RoundStarted{RoundStarted: &pb.RoundStartedEvent{
+		Round: int32(t.Bracket[len(t.Bracket)-1].Number),
+	}}})
+}
+
+// markOpening marks tables as having their rooms opened, so they aren't opened twice.
+// Caller must hold m.mu.
+func markOpening(tables []*Table) {
+	for _, table := range tables {
+		table.opening = true
+	}
+}
+
+// openTables opens the rooms of the latest round's tables, then announces the
+// round. Rooms are opened without holding m.mu, since RoomService calls back
+// into the Manager as games are recorded. A table that fails to open is left
+// without a room, for Start to retry or ReportResult to decide; the errors are
+// logged and returned.
+func (m *Manager) openTables(t *Tournament, tables []*Table) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	m.mu.Lock()
+	seed := t.Bracket[len(t.Bracket)-1].Seed
+	m.mu.Unlock()
+
+	var errs []error
+	roomIDs := make([]string, len(tables))
+	for i, table := range tables {
+		roomID, err := m.open(t, seed, table)
+		if err != nil {
+			slog.Error("Failed to open table", "tournament_id", t.ID, "account_ids", table.AccountIDs, "error", err)
+			errs = append(errs, fmt.Errorf("opening table for %s: %w", strings.Join(table.AccountIDs, ", "), err))
+			continue
+		}
+		roomIDs[i] = roomID
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, table := range tables {
+		table.opening = false
+		table.RoomID = roomIDs[i]
+	}
+	m.indexLocked(t)
+	m.publishRoundStartedLocked(t)
+	m.saveLocked()
+	return errors.Join(errs...)
+}
+
+// openTable creates a room for a table, seats its accounts and starts the match
+// with the round's seed. It returns the room's ID.
+func (m *Manager) openTable(t *Tournament, seed int64, table *Table) (string, error) {
+	var r *room.Room
+	for i, accountID := range table.AccountIDs {
+		name := t.Entrants[t.seed(accountID)].Name
+		if i == 0 {
+			r = m.rooms.CreateWithAccount(name, accountID)
+			continue
+		}
+		if _, err := m.rooms.JoinWithAccount(r.ID, name, accountID); err != nil {
+			return "", err
+		}
+	}
+	if _, err := m.rooms.StartMatch(r.ID, 0, t.FirstTo, seed); err != nil {
+		return "", err
+	}
+	return r.ID, nil
+}
+
+// indexLocked maps the rooms of a tournament's latest round to it, and forgets
+// its earlier rooms. Caller must hold m.mu.
+func (m *Manager) indexLocked(t *Tournament) {
+	for roomID, id := range m.byRoom {
+		if id == t.ID {
+			delete(m.byRoom, roomID)
+		}
+	}
+	if len(t.Bracket) == 0 || t.EndedAt != nil {
+		return
+	}
+	for _, table := range t.Bracket[len(t.Bracket)-1].Tables {
+		if table.RoomID != "" {
+			m.byRoom[table.RoomID] = t.ID
+		}
+	}
+}
+
+// Subscription receives the events of one tournament.
+type Subscription struct {
+	id     string
+	events chan *pb.TournamentEvent
+}
+
+// Events returns the channel of tournament events. The channel is closed when
+// the subscription is dropped for falling behind or removed with Unsubscribe.
+func (s *Subscription) Events() <-chan *pb.TournamentEvent {
+	return s.events
+}
+
+// Subscribe starts receiving events for the tournament.
+// Caller must call Unsubscribe when done.
+func (m *Manager) Subscribe(id string) (*Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.tournaments[id]; !ok {
+		return nil, ErrNotFound
+	}
+	sub := &Subscription{id: id, events: make(chan *pb.TournamentEvent, subscriptionBufferSize)}
+	if m.subs[id] == nil {
+		m.subs[id] = make(map[*Subscription]bool)
+	}
+	m.subs[id][sub] = true
+	return sub, nil
+}
+
+// Unsubscribe stops a subscription and closes its channel.
+// Safe to call more than once.
+func (m *Manager) Unsubscribe(sub *Subscription) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.removeLocked(sub)
+}
+
+// removeLocked removes a subscription. Caller must hold m.mu.
+func (m *Manager) removeLocked(sub *Subscription) {
+	subs := m.subs[sub.id]
+	if !subs[sub] {
+		return
+	}
+	delete(subs, sub)
+	close(sub.events)
+	if len(subs) == 0 {
+		delete(m.subs, sub.id)
+	}
+}
+
+// publishLocked numbers an event, attaches the bracket after it and sends it
+// to the tournament's subscribers. Caller must hold m.mu.
+func (m *Manager) publishLocked(t *Tournament, event *pb.TournamentEvent) {
+	m.seqs[t.ID]++
+	event.TournamentId = t.ID
+	event.Seq = m.seqs[t.ID]
+	event.Tournament = t.ToProto()
+
+	for sub := range m.subs[t.ID] {
+		select {
+		case sub.events <- event:
+		default:
+			// Subscriber is too far behind; drop it so it can resubscribe
+			m.removeLocked(sub)
+		}
+	}
+}
+
+// saveLocked writes every tournament to the tournaments file, logging failures.
+// Caller must hold m.mu.
+func (m *Manager) saveLocked() {
+	if m.filename == "" {
+		return
+	}
+
+	pt := persistedTournaments{SavedAt: m.now(), Version: currentVersion}
+	for _, t := range m.tournaments {
+		pt.Tournaments = append(pt.Tournaments, t)
+	}
+	slices.SortFunc(pt.Tournaments, func(a, b *Tournament) int { return strings.Compare(a.ID, b.ID) })
+
+	if err := writeFile(m.filename, pt); err != nil {
+		slog.Error("Failed to save tournaments", "error", err)
+	}
+}
+
+// writeFile writes the tournaments as JSON, to a temp file first and then
+// renamed for atomicity.
+func writeFile(filename string, pt persistedTournaments) error {
+	data, err := json.MarshalIndent(pt, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile := filename + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, filename); err != nil {
+		os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
+
+// generateID returns a random tournament ID.
+func generateID() string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return fmt.Sprintf("T%X", b)
+}
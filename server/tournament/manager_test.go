@@ -0,0 +1,338 @@
+package tournament
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+// newTestManager returns a Manager recording the games of a fresh RoomService.
+func newTestManager() (*Manager, *room.RoomService) {
+	rooms := room.NewRoomService()
+	m := NewManager(rooms)
+	rooms.AddGameRecorder(m)
+	return m, rooms
+}
+
+// playTable plays a game at the table in which winnerID solves the puzzle and
+// everyone else gives up, and waits for the game to be recorded.
+func playTable(t *testing.T, rooms *room.RoomService, table *pb.TournamentTable, winnerID string) {
+	t.Helper()
+	r, err := rooms.StartGameWith(table.RoomId, model.Game1(), 0)
+	if err != nil {
+		t.Fatalf("StartGameWith failed: %v", err)
+	}
+	for _, p := range r.Players {
+		if p.AccountID == winnerID {
+			if _, err := rooms.SubmitSolution(r.ID, p.ID, model.Game1Solution()); err != nil {
+				t.Fatalf("SubmitSolution failed: %v", err)
+			}
+		}
+	}
+	for _, p := range r.Players {
+		rooms.MarkFinishedSolving(r.ID, p.ID)
+	}
+	rooms.FlushRecords()
+}
+
+// tableOf returns the table of the latest round that seats the account.
+func tableOf(t *testing.T, tr *pb.Tournament, accountID string) *pb.TournamentTable {
+	t.Helper()
+	round := tr.Bracket[len(tr.Bracket)-1]
+	for _, table := range round.Tables {
+		for _, id := range table.AccountIds {
+			if id == accountID {
+				return table
+			}
+		}
+	}
+	t.Fatalf("no table for %s in round %d", accountID, round.Number)
+	return nil
+}
+
+func TestManager_SingleElimination(t *testing.T) {
+	m, rooms := newTestManager()
+	created, err := m.Create(Options{Name: "Quarterly", Format: FormatSingleElimination, FirstTo: 1}, entrants("a", "b", "c"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	tr, err := m.Start(created.Id)
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if len(tr.Bracket) != 1 || len(tr.Bracket[0].Tables) != 2 {
+		t.Fatalf("expected one round of two tables, got %v", tr.Bracket)
+	}
+	bye, table := tr.Bracket[0].Tables[0], tr.Bracket[0].Tables[1]
+	if bye.RoomId != "" || bye.WinnerId != "a" {
+		t.Errorf("expected the top seed to win a bye, got %v", bye)
+	}
+
+	// The table's room seats both accounts in a match seeded by the round
+	snapshot, err := rooms.Snapshot(table.RoomId)
+	if err != nil {
+		t.Fatalf("expected a room for the table: %v", err)
+	}
+	if len(snapshot.Players) != 2 || snapshot.Players[0].AccountId != "b" || snapshot.Players[1].AccountId != "c" {
+		t.Errorf("expected b and c seated, got %v", snapshot.Players)
+	}
+	if snapshot.Match.GetFirstTo() != 1 || snapshot.Match.GetSeed() != tr.Bracket[0].Seed {
+		t.Errorf("expected a first to 1 match with the round seed, got %v", snapshot.Match)
+	}
+
+	playTable(t, rooms, table, "c")
+	tr, _ = m.Get(created.Id)
+	if len(tr.Bracket) != 2 {
+		t.Fatalf("expected the final to start, got %v", tr.Bracket)
+	}
+	final := tr.Bracket[1].Tables[0]
+	if len(final.AccountIds) != 2 || final.AccountIds[0] != "a" || final.AccountIds[1] != "c" {
+		t.Errorf("expected a final between a and c, got %v", final)
+	}
+
+	playTable(t, rooms, final, "a")
+	tr, _ = m.Get(created.Id)
+	if tr.ChampionId != "a" || tr.EndedAt == nil {
+		t.Errorf("expected a crowned champion, got %v", tr)
+	}
+}
+
+func TestManager_Swiss(t *testing.T) {
+	m, rooms := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSwiss, FirstTo: 1}, entrants("a", "b", "c", "d"))
+	if created.Rounds != 2 {
+		t.Errorf("expected 2 rounds by default, got %d", created.Rounds)
+	}
+
+	tr, _ := m.Start(created.Id)
+	playTable(t, rooms, tableOf(t, tr, "a"), "b")
+	playTable(t, rooms, tableOf(t, tr, "c"), "c")
+
+	tr, _ = m.Get(created.Id)
+	if len(tr.Bracket) != 2 {
+		t.Fatalf("expected round 2, got %v", tr.Bracket)
+	}
+	playTable(t, rooms, tableOf(t, tr, "b"), "b")
+	playTable(t, rooms, tableOf(t, tr, "a"), "a")
+
+	tr, _ = m.Get(created.Id)
+	if tr.ChampionId != "b" || tr.EndedAt == nil {
+		t.Errorf("expected b, unbeaten, as champion, got %q", tr.ChampionId)
+	}
+	if len(tr.Bracket) != 2 {
+		t.Errorf("expected no third round, got %d rounds", len(tr.Bracket))
+	}
+}
+
+func TestManager_IgnoresOtherRooms(t *testing.T) {
+	m, rooms := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSingleElimination, FirstTo: 2}, entrants("a", "b"))
+	tr, _ := m.Start(created.Id)
+	table := tr.Bracket[0].Tables[0]
+
+	// A game the match hasn't been decided by leaves the table open
+	playTable(t, rooms, table, "a")
+	if tr, _ = m.Get(created.Id); tr.Bracket[0].Tables[0].WinnerId != "" {
+		t.Errorf("expected the table undecided after one win, got %v", tr.Bracket[0].Tables[0])
+	}
+
+	// Games in rooms outside the tournament are ignored
+	other := rooms.CreateWithAccount("a", "a")
+	m.RecordGame(other.ID, room.GameRecord{WinnerID: other.Players[0].ID})
+	if tr, _ = m.Get(created.Id); tr.EndedAt != nil {
+		t.Error("expected the tournament still running")
+	}
+}
+
+func TestManager_ReportResult(t *testing.T) {
+	m, _ := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSingleElimination, FirstTo: 3}, entrants("a", "b"))
+	tr, _ := m.Start(created.Id)
+	table := tr.Bracket[0].Tables[0]
+
+	if _, err := m.ReportResult(created.Id, table.RoomId, "z"); err == nil {
+		t.Error("expected error for an account not at the table")
+	}
+	if _, err := m.ReportResult(created.Id, "nope", "a"); err == nil {
+		t.Error("expected error for an unknown table")
+	}
+	if _, err := m.ReportResult("nope", table.RoomId, "a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	tr, err := m.ReportResult(created.Id, table.RoomId, "b")
+	if err != nil {
+		t.Fatalf("ReportResult failed: %v", err)
+	}
+	if tr.ChampionId != "b" {
+		t.Errorf("expected b crowned, got %q", tr.ChampionId)
+	}
+}
+
+func TestManager_Create_Invalid(t *testing.T) {
+	m, _ := newTestManager()
+	tests := []struct {
+		name     string
+		opts     Options
+		entrants []Entrant
+	}{
+		{"unknown format", Options{Format: "league", FirstTo: 1}, entrants("a", "b")},
+		{"no wins", Options{Format: FormatSwiss}, entrants("a", "b")},
+		{"negative rounds", Options{Format: FormatSwiss, FirstTo: 1, Rounds: -1}, entrants("a", "b")},
+		{"one entrant", Options{Format: FormatSwiss, FirstTo: 1}, entrants("a")},
+		{"entered twice", Options{Format: FormatSwiss, FirstTo: 1}, entrants("a", "a")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := m.Create(tt.opts, tt.entrants); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestManager_Start_Twice(t *testing.T) {
+	m, _ := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSwiss, FirstTo: 1}, entrants("a", "b"))
+	m.Start(created.Id)
+
+	if _, err := m.Start(created.Id); !errors.Is(err, ErrStarted) {
+		t.Errorf("expected ErrStarted, got %v", err)
+	}
+	if _, err := m.Start("nope"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestManager_OpenTableFails(t *testing.T) {
+	m, rooms := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSingleElimination, FirstTo: 1}, entrants("a", "b", "c", "d"))
+
+	open := m.open
+	m.open = func(t *Tournament, seed int64, table *Table) (string, error) {
+		if table.AccountIDs[0] == "a" {
+			return "", errors.New("no rooms left")
+		}
+		return open(t, seed, table)
+	}
+	if _, err := m.Start(created.Id); err == nil {
+		t.Fatal("expected error when a table can't be opened")
+	}
+	tr, _ := m.Get(created.Id)
+	if failed := tableOf(t, tr, "a"); failed.RoomId != "" {
+		t.Errorf("expected the failed table to have no room, got %s", failed.RoomId)
+	}
+	if tableOf(t, tr, "b").RoomId == "" {
+		t.Error("expected the other table to be opened")
+	}
+
+	// Starting again retries only the failed table
+	m.open = open
+	tr, err := m.Start(created.Id)
+	if err != nil {
+		t.Fatalf("retrying Start failed: %v", err)
+	}
+	if tableOf(t, tr, "a").RoomId == "" {
+		t.Fatal("expected the retried table to be opened")
+	}
+	if _, err := m.Start(created.Id); !errors.Is(err, ErrStarted) {
+		t.Errorf("expected ErrStarted once every table is open, got %v", err)
+	}
+
+	// Games at the opened tables still decide them
+	playTable(t, rooms, tableOf(t, tr, "a"), "a")
+	playTable(t, rooms, tableOf(t, tr, "b"), "b")
+	if tr, _ := m.Get(created.Id); len(tr.Bracket) != 2 {
+		t.Errorf("expected the final round to open, got %d rounds", len(tr.Bracket))
+	}
+}
+
+func TestManager_ReportResult_UnopenedTable(t *testing.T) {
+	m, _ := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSingleElimination, FirstTo: 1}, entrants("a", "b"))
+	m.open = func(*Tournament, int64, *Table) (string, error) {
+		return "", errors.New("no rooms left")
+	}
+	m.Start(created.Id)
+
+	// A table that never got a room is decided by the winner's account
+	if _, err := m.ReportResult(created.Id, "", "c"); err == nil {
+		t.Error("expected error for an account at no unopened table")
+	}
+	tr, err := m.ReportResult(created.Id, "", "b")
+	if err != nil {
+		t.Fatalf("ReportResult failed: %v", err)
+	}
+	if tr.ChampionId != "b" {
+		t.Errorf("expected b to win, got %q", tr.ChampionId)
+	}
+}
+
+func TestManager_Subscribe(t *testing.T) {
+	m, _ := newTestManager()
+	created, _ := m.Create(Options{Format: FormatSingleElimination, FirstTo: 1}, entrants("a", "b"))
+
+	sub, err := m.Subscribe(created.Id)
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	tr, _ := m.Start(created.Id)
+	m.ReportResult(created.Id, tr.Bracket[0].Tables[0].RoomId, "a")
+
+	var events []*pb.TournamentEvent
+	for range 3 {
+		events = append(events, <-sub.Events())
+	}
+	if events[0].GetRoundStarted().GetRound() != 1 || events[1].GetTableDecided().GetWinnerId() != "a" || events[2].GetFinished().GetChampionId() != "a" {
+		t.Errorf("expected round started, table decided and finished, got %v", events)
+	}
+	for i, e := range events {
+		if e.Seq != uint64(i+1) || e.TournamentId != created.Id || e.Tournament == nil {
+			t.Errorf("event %d: expected seq %d with the bracket, got %v", i, i+1, e)
+		}
+	}
+
+	m.Unsubscribe(sub)
+	m.Unsubscribe(sub)
+	if _, ok := <-sub.Events(); ok {
+		t.Error("expected the channel closed")
+	}
+	if _, err := m.Subscribe("nope"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestManager_SaveAndLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "tournaments.json")
+
+	m, rooms := newTestManager()
+	if err := m.Load(filename); err != nil {
+		t.Fatalf("Load of a missing file failed: %v", err)
+	}
+	created, _ := m.Create(Options{Name: "Quarterly", Format: FormatSingleElimination, FirstTo: 1}, entrants("a", "b"))
+	tr, _ := m.Start(created.Id)
+
+	// A restarted server keeps the bracket and still decides its tables
+	loaded := NewManager(rooms)
+	if err := loaded.Load(filename); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	rooms.AddGameRecorder(loaded)
+	got, err := loaded.Get(created.Id)
+	if err != nil {
+		t.Fatalf("expected the tournament loaded: %v", err)
+	}
+	if got.Name != "Quarterly" || got.Bracket[0].Tables[0].RoomId != tr.Bracket[0].Tables[0].RoomId {
+		t.Errorf("expected the saved bracket, got %v", got)
+	}
+
+	playTable(t, rooms, got.Bracket[0].Tables[0], "b")
+	if got, _ = loaded.Get(created.Id); got.ChampionId != "b" {
+		t.Errorf("expected b crowned after loading, got %q", got.ChampionId)
+	}
+}
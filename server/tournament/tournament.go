@@ -0,0 +1,317 @@
+// Package tournament runs bracket tournaments across many rooms.
+package tournament
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+	"time"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Format is how a tournament pairs its entrants each round.
+type Format string
+
+const (
+	// FormatSingleElimination knocks out each table's loser until one entrant is left.
+	FormatSingleElimination Format = "single_elimination"
+	// FormatSwiss plays a fixed number of rounds, pairing entrants with equal
+	// scores, and crowns the entrant with the most table wins.
+	FormatSwiss Format = "swiss"
+)
+
+// ValidFormat reports whether f is a known format.
+func ValidFormat(f Format) bool {
+	return f == FormatSingleElimination || f == FormatSwiss
+}
+
+// Entrant is an account playing in a tournament.
+type Entrant struct {
+	AccountID string
+	Name      string
+}
+
+// Table is one pairing in a round, played as a match in its own room. A table
+// with a single entrant is a bye, won without playing.
+type Table struct {
+	RoomID     string   // Empty for a bye, or until the table's room is opened
+	AccountIDs []string // Entrants at the table, in seed order
+	WinnerID   string   // Account that won the table's match, empty until decided
+
+	opening bool // The table's room is being opened
+}
+
+// bye reports whether the table is a bye.
+func (t *Table) bye() bool {
+	return len(t.AccountIDs) == 1
+}
+
+// unopened reports whether the table is waiting for a room, e.g. because opening
+// one failed.
+func (t *Table) unopened() bool {
+	return !t.bye() && t.RoomID == "" && t.WinnerID == "" && !t.opening
+}
+
+// Round is one round of tables, whose matches all play games from the same seed.
+type Round struct {
+	Number int   // From 1
+	Seed   int64 // Match seed shared by every table
+	Tables []*Table
+}
+
+// decided reports whether every table in the round has a winner.
+func (r *Round) decided() bool {
+	for _, t := range r.Tables {
+		if t.WinnerID == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// winners returns the round's table winners, in table order.
+func (r *Round) winners() []string {
+	ids := make([]string, len(r.Tables))
+	for i, t := range r.Tables {
+		ids[i] = t.WinnerID
+	}
+	return ids
+}
+
+// Tournament is a bracket of rounds played across rooms.
+type Tournament struct {
+	ID         string
+	Name       string
+	Format     Format
+	FirstTo    int       // Wins that take each table's match
+	Rounds     int       // Rounds a Swiss tournament plays; elimination plays until one is left
+	Entrants   []Entrant // In seed order, best first
+	Bracket    []*Round  // Rounds started so far, oldest first
+	ChampionID string    // Account that won the tournament, empty until it ends
+	CreatedAt  time.Time
+	StartedAt  *time.Time // Nil until started
+	EndedAt    *time.Time // Nil until a champion is crowned
+}
+
+// swissRounds returns the default number of Swiss rounds for n entrants: enough
+// for a single entrant to win every round.
+func swissRounds(n int) int {
+	if n < 2 {
+		return 1
+	}
+	return bits.Len(uint(n - 1))
+}
+
+// seed returns an entrant's seed, from 0, or -1 if not entered.
+func (t *Tournament) seed(accountID string) int {
+	return slices.IndexFunc(t.Entrants, func(e Entrant) bool { return e.AccountID == accountID })
+}
+
+// unopenedTables returns the tables of the latest round that are waiting for a room.
+func (t *Tournament) unopenedTables() []*Table {
+	if len(t.Bracket) == 0 {
+		return nil
+	}
+	var tables []*Table
+	for _, table := range t.Bracket[len(t.Bracket)-1].Tables {
+		if table.unopened() {
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
+// table returns the table played in a room, in the latest round, or nil.
+func (t *Tournament) table(roomID string) *Table {
+	if len(t.Bracket) == 0 {
+		return nil
+	}
+	for _, table := range t.Bracket[len(t.Bracket)-1].Tables {
+		if table.RoomID == roomID {
+			return table
+		}
+	}
+	return nil
+}
+
+// points returns each entrant's table wins, byes included, by account ID.
+func (t *Tournament) points() map[string]int {
+	points := make(map[string]int, len(t.Entrants))
+	for _, r := range t.Bracket {
+		for _, table := range r.Tables {
+			if table.WinnerID != "" {
+				points[table.WinnerID]++
+			}
+		}
+	}
+	return points
+}
+
+// standings returns the entrants' account IDs by points, then seed.
+func (t *Tournament) standings() []string {
+	points := t.points()
+	ids := make([]string, len(t.Entrants))
+	for i, e := range t.Entrants {
+		ids[i] = e.AccountID
+	}
+	slices.SortStableFunc(ids, func(a, b string) int { return cmp.Compare(points[b], points[a]) })
+	return ids
+}
+
+// nextPairings returns the account IDs at each table of the next round, or nil
+// if the tournament is over.
+func (t *Tournament) nextPairings() [][]string {
+	if len(t.Bracket) == 0 {
+		if t.Format == FormatSwiss {
+			return t.swissPairings()
+		}
+		return firstEliminationPairings(len(t.Entrants), t.accountIDs())
+	}
+
+	last := t.Bracket[len(t.Bracket)-1]
+	if t.Format == FormatSwiss {
+		if len(t.Bracket) >= t.Rounds {
+			return nil
+		}
+		return t.swissPairings()
+	}
+
+	winners := last.winners()
+	if len(winners) < 2 {
+		return nil
+	}
+	var pairings [][]string
+	for i := 0; i < len(winners); i += 2 {
+		pairings = append(pairings, winners[i:i+2])
+	}
+	return pairings
+}
+
+// accountIDs returns the entrants' account IDs in seed order.
+func (t *Tournament) accountIDs() []string {
+	ids := make([]string, len(t.Entrants))
+	for i, e := range t.Entrants {
+		ids[i] = e.AccountID
+	}
+	return ids
+}
+
+// firstEliminationPairings pairs n seeded entrants in a standard bracket, padded
+// to a power of two with byes for the top seeds, so the top two seeds can only
+// meet in the final.
+func firstEliminationPairings(n int, ids []string) [][]string {
+	size := 1 << bits.Len(uint(n-1))
+	order := []int{0}
+	for len(order) < size {
+		next := make([]int, 0, 2*len(order))
+		for _, s := range order {
+			next = append(next, s, 2*len(order)-1-s)
+		}
+		order = next
+	}
+
+	var pairings [][]string
+	for i := 0; i < size; i += 2 {
+		var table []string
+		for _, s := range order[i : i+2] {
+			if s < n {
+				table = append(table, ids[s])
+			}
+		}
+		pairings = append(pairings, table)
+	}
+	return pairings
+}
+
+// swissPairings pairs entrants by standing, each with the next entrant below
+// they haven't played yet. With an odd number, the lowest entrant without a
+// bye so far sits out with one.
+func (t *Tournament) swissPairings() [][]string {
+	played := make(map[[2]string]bool)
+	byes := make(map[string]bool)
+	for _, r := range t.Bracket {
+		for _, table := range r.Tables {
+			if table.bye() {
+				byes[table.AccountIDs[0]] = true
+				continue
+			}
+			a, b := table.AccountIDs[0], table.AccountIDs[1]
+			played[[2]string{a, b}], played[[2]string{b, a}] = true, true
+		}
+	}
+
+	ids := t.standings()
+	var bye []string
+	if len(ids)%2 == 1 {
+		i := len(ids) - 1
+		for i > 0 && byes[ids[i]] {
+			i--
+		}
+		bye = []string{ids[i]}
+		ids = slices.Delete(ids, i, i+1)
+	}
+
+	var pairings [][]string
+	for len(ids) > 0 {
+		j := 1
+		for j < len(ids)-1 && played[[2]string{ids[0], ids[j]}] {
+			j++
+		}
+		pair := []string{ids[0], ids[j]}
+		slices.SortFunc(pair, func(a, b string) int { return cmp.Compare(t.seed(a), t.seed(b)) })
+		pairings = append(pairings, pair)
+		ids = slices.Delete(ids, j, j+1)[1:]
+	}
+	if bye != nil {
+		pairings = append(pairings, bye)
+	}
+	return pairings
+}
+
+// ToProto converts a Tournament to its protobuf representation.
+func (t *Tournament) ToProto() *pb.Tournament {
+	points := t.points()
+	out := &pb.Tournament{
+		Id:         t.ID,
+		Name:       t.Name,
+		Format:     string(t.Format),
+		FirstTo:    int32(t.FirstTo),
+		Rounds:     int32(t.Rounds),
+		ChampionId: t.ChampionID,
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+	if t.StartedAt != nil {
+		out.StartedAt = timestamppb.New(*t.StartedAt)
+	}
+	if t.EndedAt != nil {
+		out.EndedAt = timestamppb.New(*t.EndedAt)
+	}
+	for i, e := range t.Entrants {
+		out.Entrants = append(out.Entrants, &pb.TournamentEntrant{
+			AccountId: e.AccountID,
+			Name:      e.Name,
+			Seed:      int32(i + 1),
+			Points:    int32(points[e.AccountID]),
+		})
+	}
+	for _, r := range t.Bracket {
+		out.Bracket = append(out.Bracket, r.ToProto())
+	}
+	return out
+}
+
+// ToProto converts a Round to its protobuf representation.
+func (r *Round) ToProto() *pb.TournamentRound {
+	out := &pb.TournamentRound{Number: int32(r.Number), Seed: r.Seed}
+	for _, table := range r.Tables {
+		out.Tables = append(out.Tables, table.ToProto())
+	}
+	return out
+}
+
+// ToProto converts a Table to its protobuf representation.
+func (t *Table) ToProto() *pb.TournamentTable {
+	return &pb.TournamentTable{RoomId: t.RoomID, AccountIds: t.AccountIDs, WinnerId: t.WinnerID}
+}
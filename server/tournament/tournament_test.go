@@ -0,0 +1,129 @@
+package tournament
+
+import (
+	"slices"
+	"testing"
+)
+
+func entrants(ids ...string) []Entrant {
+	out := make([]Entrant, len(ids))
+	for i, id := range ids {
+		out[i] = Entrant{AccountID: id, Name: id}
+	}
+	return out
+}
+
+func TestFirstEliminationPairings(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		want [][]string
+	}{
+		{"two", []string{"a", "b"}, [][]string{{"a", "b"}}},
+		{"four", []string{"a", "b", "c", "d"}, [][]string{{"a", "d"}, {"b", "c"}}},
+		{"five with byes", []string{"a", "b", "c", "d", "e"}, [][]string{{"a"}, {"d", "e"}, {"b"}, {"c"}}},
+		{"eight", []string{"1", "2", "3", "4", "5", "6", "7", "8"}, [][]string{{"1", "8"}, {"4", "5"}, {"2", "7"}, {"3", "6"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := firstEliminationPairings(len(tt.ids), tt.ids)
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNextPairings_Elimination(t *testing.T) {
+	tr := &Tournament{Format: FormatSingleElimination, Entrants: entrants("a", "b", "c")}
+
+	first := tr.nextPairings()
+	if !slices.EqualFunc(first, [][]string{{"a"}, {"b", "c"}}, slices.Equal) {
+		t.Fatalf("expected a bye for the top seed, got %v", first)
+	}
+	tr.Bracket = []*Round{{Number: 1, Tables: []*Table{
+		{AccountIDs: []string{"a"}, WinnerID: "a"},
+		{RoomID: "R1", AccountIDs: []string{"b", "c"}, WinnerID: "c"},
+	}}}
+
+	final := tr.nextPairings()
+	if !slices.EqualFunc(final, [][]string{{"a", "c"}}, slices.Equal) {
+		t.Fatalf("expected a final between the winners, got %v", final)
+	}
+	tr.Bracket = append(tr.Bracket, &Round{Number: 2, Tables: []*Table{
+		{RoomID: "R2", AccountIDs: []string{"a", "c"}, WinnerID: "c"},
+	}})
+
+	if p := tr.nextPairings(); p != nil {
+		t.Errorf("expected the tournament over, got %v", p)
+	}
+}
+
+func TestNextPairings_Swiss(t *testing.T) {
+	tr := &Tournament{Format: FormatSwiss, Rounds: 2, Entrants: entrants("a", "b", "c", "d", "e")}
+
+	first := tr.nextPairings()
+	if !slices.EqualFunc(first, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, slices.Equal) {
+		t.Fatalf("expected pairings by seed and a bye for the lowest, got %v", first)
+	}
+	tr.Bracket = []*Round{{Number: 1, Tables: []*Table{
+		{RoomID: "R1", AccountIDs: []string{"a", "b"}, WinnerID: "b"},
+		{RoomID: "R2", AccountIDs: []string{"c", "d"}, WinnerID: "c"},
+		{AccountIDs: []string{"e"}, WinnerID: "e"},
+	}}}
+
+	// Standings are b, c, e on one point, then a and d; e already had a bye
+	second := tr.nextPairings()
+	want := [][]string{{"b", "c"}, {"a", "e"}, {"d"}}
+	if !slices.EqualFunc(second, want, slices.Equal) {
+		t.Fatalf("expected %v, got %v", want, second)
+	}
+	tr.Bracket = append(tr.Bracket, &Round{Number: 2})
+
+	if p := tr.nextPairings(); p != nil {
+		t.Errorf("expected no pairings after the last round, got %v", p)
+	}
+}
+
+func TestSwissPairings_AvoidsRematches(t *testing.T) {
+	tr := &Tournament{Format: FormatSwiss, Rounds: 3, Entrants: entrants("a", "b", "c", "d")}
+	tr.Bracket = []*Round{{Number: 1, Tables: []*Table{
+		{RoomID: "R1", AccountIDs: []string{"a", "b"}, WinnerID: "a"},
+		{RoomID: "R2", AccountIDs: []string{"c", "d"}, WinnerID: "c"},
+	}}}
+
+	// a and c lead; a plays c rather than a rematch with b
+	got := tr.swissPairings()
+	want := [][]string{{"a", "c"}, {"b", "d"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSwissRounds(t *testing.T) {
+	tests := []struct{ n, want int }{{2, 1}, {3, 2}, {4, 2}, {5, 3}, {8, 3}, {9, 4}}
+	for _, tt := range tests {
+		if got := swissRounds(tt.n); got != tt.want {
+			t.Errorf("swissRounds(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestTournament_ToProto(t *testing.T) {
+	tr := &Tournament{ID: "T1", Format: FormatSwiss, FirstTo: 2, Rounds: 2, Entrants: entrants("a", "b")}
+	tr.Bracket = []*Round{{Number: 1, Seed: 7, Tables: []*Table{
+		{RoomID: "R1", AccountIDs: []string{"a", "b"}, WinnerID: "b"},
+	}}}
+
+	p := tr.ToProto()
+	if p.Format != "swiss" || p.FirstTo != 2 || p.StartedAt != nil {
+		t.Errorf("unexpected tournament %v", p)
+	}
+	if p.Entrants[1].Seed != 2 || p.Entrants[1].Points != 1 || p.Entrants[0].Points != 0 {
+		t.Errorf("expected seeds from 1 and table wins as points, got %v", p.Entrants)
+	}
+	if p.Bracket[0].Seed != 7 || p.Bracket[0].Tables[0].WinnerId != "b" {
+		t.Errorf("unexpected bracket %v", p.Bracket)
+	}
+}
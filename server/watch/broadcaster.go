@@ -0,0 +1,195 @@
+// Package watch streams room events to WatchRoom RPC subscribers.
+package watch
+
+import (
+	"sync"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+// subscriptionBufferSize is the number of events buffered per subscriber.
+// A subscriber that falls further behind is dropped.
+const subscriptionBufferSize = 64
+
+// Subscription receives the events of one room.
+type Subscription struct {
+	roomID string
+	events chan *pb.RoomEvent
+}
+
+// Events returns the channel of room events.
+// The channel is closed when the subscription is dropped for falling behind
+// or removed with Unsubscribe.
+func (s *Subscription) Events() <-chan *pb.RoomEvent {
+	return s.events
+}
+
+// Broadcaster fans room events out to subscriptions as protobuf messages.
+// It implements room.EventBroadcaster.
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[string]map[*Subscription]bool // roomID -> subscriptions
+	seqs map[string]uint64                 // roomID -> last sequence number
+}
+
+// NewBroadcaster creates a new Broadcaster.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		subs: make(map[string]map[*Subscription]bool),
+		seqs: make(map[string]uint64),
+	}
+}
+
+// Subscribe starts receiving events for the room.
+// Caller must call Unsubscribe when done.
+func (b *Broadcaster) Subscribe(roomID string) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{
+		roomID: roomID,
+		events: make(chan *pb.RoomEvent, subscriptionBufferSize),
+	}
+	if b.subs[roomID] == nil {
+		b.subs[roomID] = make(map[*Subscription]bool)
+	}
+	b.subs[roomID][sub] = true
+	return sub
+}
+
+// Unsubscribe stops a subscription and closes its channel.
+// Safe to call more than once.
+func (b *Broadcaster) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.removeLocked(sub)
+}
+
+// removeLocked removes a subscription. Caller must hold b.mu.
+func (b *Broadcaster) removeLocked(sub *Subscription) {
+	subs := b.subs[sub.roomID]
+	if !subs[sub] {
+		return
+	}
+	delete(subs, sub)
+	close(sub.events)
+	if len(subs) == 0 {
+		delete(b.subs, sub.roomID)
+	}
+}
+
+// publish assigns the next sequence number for the room and sends the event to its subscribers.
+func (b *Broadcaster) publish(event room.BroadcastEvent) {
+	msg := event.ToProto()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seqs[msg.RoomId]++
+	msg.Seq = b.seqs[msg.RoomId]
+
+	for sub := range b.subs[msg.RoomId] {
+		select {
+		case sub.events <- msg:
+		default:
+			// Subscriber is too far behind; drop it so it can resubscribe
+			b.removeLocked(sub)
+		}
+	}
+
+	if _, closed := event.(room.RoomClosedEvent); closed {
+		for sub := range b.subs[msg.RoomId] {
+			b.removeLocked(sub)
+		}
+		delete(b.seqs, msg.RoomId)
+	}
+}
+
+// BroadcastPlayerJoined publishes a player joined event.
+func (b *Broadcaster) BroadcastPlayerJoined(roomID, playerID, playerName string) {
+	b.publish(room.PlayerJoinedEvent{RoomID: roomID, PlayerID: playerID, PlayerName: playerName})
+}
+
+// BroadcastPlayerLeft publishes a player left event.
+func (b *Broadcaster) BroadcastPlayerLeft(roomID, playerID string) {
+	b.publish(room.PlayerLeftEvent{RoomID: roomID, PlayerID: playerID})
+}
+
+// BroadcastGameStarted publishes a game started event.
+func (b *Broadcaster) BroadcastGameStarted(roomID string, game *model.Game) {
+	b.publish(room.GameStartedEvent{RoomID: roomID, Game: game})
+}
+
+// BroadcastPlayerFinishedSolving publishes a player finished solving event.
+func (b *Broadcaster) BroadcastPlayerFinishedSolving(roomID, playerID string) {
+	b.publish(room.PlayerFinishedSolvingEvent{RoomID: roomID, PlayerID: playerID})
+}
+
+// BroadcastPlayerReadyForNext publishes a player ready for next event.
+func (b *Broadcaster) BroadcastPlayerReadyForNext(roomID, playerID string) {
+	b.publish(room.PlayerReadyForNextEvent{RoomID: roomID, PlayerID: playerID})
+}
+
+// BroadcastPlayerSolved publishes a player solved event.
+func (b *Broadcaster) BroadcastPlayerSolved(roomID, playerID string, moveCount int) {
+	b.publish(room.PlayerSolvedEvent{RoomID: roomID, PlayerID: playerID, MoveCount: moveCount})
+}
+
+// BroadcastSolutionRetracted publishes a solution retracted event.
+func (b *Broadcaster) BroadcastSolutionRetracted(roomID, playerID string) {
+	b.publish(room.SolutionRetractedEvent{RoomID: roomID, PlayerID: playerID})
+}
+
+// BroadcastGameEnded publishes a game ended event.
+func (b *Broadcaster) BroadcastGameEnded(roomID, winnerID, winnerName string, moves []room.MovePayload) {
+	b.publish(room.GameEndedEvent{RoomID: roomID, WinnerID: winnerID, WinnerName: winnerName, Moves: moves})
+}
+
+// BroadcastGameAnalysed publishes a game analysed event.
+func (b *Broadcaster) BroadcastGameAnalysed(roomID string, analysis *room.GameAnalysis) {
+	b.publish(room.GameAnalysedEvent{RoomID: roomID, Analysis: analysis})
+}
+
+// BroadcastSpectatorJoined publishes a spectator joined event.
+func (b *Broadcaster) BroadcastSpectatorJoined(roomID, spectatorID, spectatorName string) {
+	b.publish(room.SpectatorJoinedEvent{RoomID: roomID, SpectatorID: spectatorID, SpectatorName: spectatorName})
+}
+
+// BroadcastSpectatorLeft publishes a spectator left event.
+func (b *Broadcaster) BroadcastSpectatorLeft(roomID, spectatorID string) {
+	b.publish(room.SpectatorLeftEvent{RoomID: roomID, SpectatorID: spectatorID})
+}
+
+// BroadcastTeamsChanged publishes a teams changed event.
+func (b *Broadcaster) BroadcastTeamsChanged(roomID string, teams map[string]string, teamQuorum bool) {
+	b.publish(room.TeamsChangedEvent{RoomID: roomID, Teams: teams, TeamQuorum: teamQuorum})
+}
+
+// BroadcastHandicapChanged publishes a handicap changed event.
+func (b *Broadcaster) BroadcastHandicapChanged(roomID, playerID string, handicap room.Handicap) {
+	b.publish(room.HandicapChangedEvent{RoomID: roomID, PlayerID: playerID, Handicap: handicap})
+}
+
+// BroadcastMatchStarted publishes a match started event.
+func (b *Broadcaster) BroadcastMatchStarted(roomID string, rounds, firstTo int, seed int64) {
+	b.publish(room.MatchStartedEvent{RoomID: roomID, Rounds: rounds, FirstTo: firstTo, Seed: seed})
+}
+
+// BroadcastMatchOver publishes a match over event.
+func (b *Broadcaster) BroadcastMatchOver(roomID, championID, championName string, gamesPlayed int, standings []room.MatchStanding) {
+	b.publish(room.MatchOverEvent{
+		RoomID:       roomID,
+		ChampionID:   championID,
+		ChampionName: championName,
+		GamesPlayed:  gamesPlayed,
+		Standings:    standings,
+	})
+}
+
+// BroadcastRoomClosed publishes a room closed event and ends the room's subscriptions.
+func (b *Broadcaster) BroadcastRoomClosed(roomID string) {
+	b.publish(room.RoomClosedEvent{RoomID: roomID})
+}
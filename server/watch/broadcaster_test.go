@@ -0,0 +1,140 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/room"
+)
+
+// Compile-time check that Broadcaster implements room.EventBroadcaster.
+var _ room.EventBroadcaster = (*Broadcaster)(nil)
+
+// receive reads the next event from a subscription.
+func receive(t *testing.T, sub *Subscription) *pb.RoomEvent {
+	t.Helper()
+	select {
+	case ev, ok := <-sub.Events():
+		if !ok {
+			t.Fatal("subscription closed unexpectedly")
+		}
+		return ev
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("subscriber did not receive event")
+	}
+	return nil
+}
+
+func TestBroadcaster_DeliversTypedEvents(t *testing.T) {
+	b := NewBroadcaster()
+	sub := b.Subscribe("ROOM1")
+	defer b.Unsubscribe(sub)
+
+	b.BroadcastPlayerJoined("ROOM1", "p1", "Alice")
+	b.BroadcastPlayerSolved("ROOM1", "p1", 5)
+	b.BroadcastGameEnded("ROOM1", "p1", "Alice", []room.MovePayload{{RobotId: 2, X: 3, Y: 4}})
+	b.BroadcastGameAnalysed("ROOM1", &room.GameAnalysis{OptimalMoves: 1})
+
+	ev := receive(t, sub)
+	if ev.GetPlayerJoined().GetPlayerName() != "Alice" {
+		t.Errorf("expected player_joined for Alice, got %v", ev)
+	}
+	if ev.RoomId != "ROOM1" || ev.Seq != 1 {
+		t.Errorf("expected ROOM1 seq 1, got %s seq %d", ev.RoomId, ev.Seq)
+	}
+
+	ev = receive(t, sub)
+	if ev.GetPlayerSolved().GetMoveCount() != 5 || ev.Seq != 2 {
+		t.Errorf("expected player_solved with 5 moves at seq 2, got %v", ev)
+	}
+
+	ev = receive(t, sub)
+	ended := ev.GetGameEnded()
+	if ended == nil || ended.WinnerId != "p1" || len(ended.Moves) != 1 {
+		t.Fatalf("expected game_ended won by p1 with 1 move, got %v", ev)
+	}
+	if ended.Moves[0].Id != 2 || ended.Moves[0].Pos.X != 3 || ended.Moves[0].Pos.Y != 4 {
+		t.Errorf("unexpected winning move %v", ended.Moves[0])
+	}
+
+	ev = receive(t, sub)
+	if ev.GetGameAnalysed().GetAnalysis().GetOptimalMoves() != 1 {
+		t.Errorf("expected game_analysed with 1 optimal move, got %v", ev)
+	}
+}
+
+func TestBroadcaster_RoomIsolation(t *testing.T) {
+	b := NewBroadcaster()
+	sub1 := b.Subscribe("ROOM1")
+	sub2 := b.Subscribe("ROOM2")
+	defer b.Unsubscribe(sub1)
+	defer b.Unsubscribe(sub2)
+
+	b.BroadcastGameStarted("ROOM1", model.Game1())
+
+	if ev := receive(t, sub1); ev.GetGameStarted() == nil {
+		t.Errorf("expected game_started, got %v", ev)
+	}
+	select {
+	case ev := <-sub2.Events():
+		t.Errorf("ROOM2 subscriber should not receive ROOM1 event, got %v", ev)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestBroadcaster_DropsSlowSubscriber(t *testing.T) {
+	b := NewBroadcaster()
+	sub := b.Subscribe("ROOM1")
+
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		b.BroadcastPlayerSolved("ROOM1", "p1", i)
+	}
+
+	// Buffered events are still readable, then the channel is closed
+	count := 0
+	for range sub.Events() {
+		count++
+	}
+	if count != subscriptionBufferSize {
+		t.Errorf("expected %d buffered events, got %d", subscriptionBufferSize, count)
+	}
+
+	// Unsubscribing a dropped subscription is a no-op
+	b.Unsubscribe(sub)
+}
+
+func TestBroadcaster_RoomClosedEndsSubscriptions(t *testing.T) {
+	b := NewBroadcaster()
+	sub := b.Subscribe("ROOM1")
+
+	b.BroadcastRoomClosed("ROOM1")
+
+	if ev := receive(t, sub); ev.GetRoomClosed() == nil {
+		t.Errorf("expected room_closed, got %v", ev)
+	}
+	if _, ok := <-sub.Events(); ok {
+		t.Error("expected subscription to be closed after room_closed")
+	}
+}
+
+func TestBroadcaster_ServiceIntegration(t *testing.T) {
+	svc := room.NewRoomService()
+	b := NewBroadcaster()
+	svc.AddBroadcaster(b)
+
+	rm := svc.Create("Alice")
+	sub := b.Subscribe(rm.ID)
+	defer b.Unsubscribe(sub)
+
+	svc.Join(rm.ID, "Bob")
+	svc.StartGame(rm.ID)
+
+	if ev := receive(t, sub); ev.GetPlayerJoined().GetPlayerName() != "Bob" {
+		t.Errorf("expected player_joined for Bob, got %v", ev)
+	}
+	if ev := receive(t, sub); ev.GetGameStarted() == nil {
+		t.Errorf("expected game_started, got %v", ev)
+	}
+}
@@ -0,0 +1,105 @@
+package ws
+
+import (
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventLogSize is the number of recent events kept per room for replay.
+// It must not exceed the client send buffer so a full replay can be queued at once.
+const eventLogSize = 256
+
+// loggedEvent is an event with its sequence number. Each form is serialized the
+// first time a client needs it, so rooms without binary clients never marshal
+// protobuf and rooms with only binary clients never marshal JSON.
+type loggedEvent struct {
+	seq    uint64
+	roomID string
+	event  Event         // JSON form, without its room snapshot
+	msg    *pb.RoomEvent // protobuf form with seq and room set, nil if the event has none
+	room   *pb.Room      // room snapshot, nil if the room is gone
+	data   []byte        // serialized JSON Event, once marshalled
+	binary []byte        // serialized pb.RoomEvent, once marshalled
+}
+
+// frame returns the event encoded for a JSON or binary client, marshalling it on
+// first use. It returns nil if the event has no form for the client or it can't be
+// marshalled. Once the event is in a log, callers must hold the hub lock.
+func (e *loggedEvent) frame(binary bool) []byte {
+	if binary {
+		if e.binary == nil && e.msg != nil {
+			var err error
+			if e.binary, err = proto.Marshal(e.msg); err != nil {
+				slog.Error("WebSocket: failed to marshal binary event", logging.RoomID(e.roomID), "type", e.event.Type, "error", err)
+			}
+		}
+		return e.binary
+	}
+	if e.data == nil {
+		event := e.event
+		if e.room != nil {
+			var err error
+			if event.Room, err = protojson.Marshal(e.room); err != nil {
+				slog.Error("WebSocket: failed to marshal room snapshot", logging.RoomID(e.roomID), "error", err)
+			}
+		}
+		var err error
+		if e.data, err = json.Marshal(event); err != nil {
+			slog.Error("WebSocket: failed to marshal event", logging.RoomID(e.roomID), "type", e.event.Type, "error", err)
+		}
+	}
+	return e.data
+}
+
+// eventLog is a bounded, in-order log of the most recent events for one room.
+// Not thread-safe; the Hub guards it with its own lock.
+type eventLog struct {
+	lastSeq uint64
+	entries []*loggedEvent // oldest first, at most eventLogSize entries
+
+	// publishMu serializes publishing to the room, so events are snapshotted and
+	// marshalled outside the hub lock but still logged in order. lastSeq and entries
+	// only change with both locks held, so either lock is enough to read them.
+	publishMu sync.Mutex
+}
+
+// next returns the sequence number for the next event.
+func (l *eventLog) next() uint64 {
+	l.lastSeq++
+	return l.lastSeq
+}
+
+// append records a serialized event, evicting the oldest if the log is full.
+func (l *eventLog) append(e *loggedEvent) {
+	if len(l.entries) == eventLogSize {
+		l.entries = append(l.entries[:0], l.entries[1:]...)
+	}
+	l.entries = append(l.entries, e)
+}
+
+// since returns the events after seq, in order.
+// ok is false if some of those events are no longer in the log (or seq is in
+// the future), in which case the client must resync from a full room fetch.
+func (l *eventLog) since(seq uint64) (events []*loggedEvent, ok bool) {
+	if seq > l.lastSeq {
+		return nil, false
+	}
+	if seq == l.lastSeq {
+		return nil, true
+	}
+	if len(l.entries) == 0 || l.entries[0].seq > seq+1 {
+		return nil, false
+	}
+	for _, e := range l.entries {
+		if e.seq > seq {
+			events = append(events, e)
+		}
+	}
+	return events, true
+}
@@ -0,0 +1,115 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventLog_SequenceIsMonotonic(t *testing.T) {
+	var l eventLog
+	for want := uint64(1); want <= 5; want++ {
+		if got := l.next(); got != want {
+			t.Errorf("expected seq %d, got %d", want, got)
+		}
+	}
+}
+
+func TestEventLog_Since(t *testing.T) {
+	var l eventLog
+	for i := 0; i < 5; i++ {
+		seq := l.next()
+		l.append(&loggedEvent{seq: seq, data: []byte(fmt.Sprintf("event%d", seq))})
+	}
+
+	tests := []struct {
+		name      string
+		since     uint64
+		wantOK    bool
+		wantCount int
+	}{
+		{"from start", 0, true, 5},
+		{"partial", 3, true, 2},
+		{"up to date", 5, true, 0},
+		{"future seq", 9, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, ok := l.since(tt.since)
+			if ok != tt.wantOK {
+				t.Errorf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if len(events) != tt.wantCount {
+				t.Errorf("expected %d events, got %d", tt.wantCount, len(events))
+			}
+		})
+	}
+
+	events, _ := l.since(3)
+	if string(events[0].data) != "event4" || string(events[1].data) != "event5" {
+		t.Errorf("expected events 4 and 5 in order, got %q and %q", events[0].data, events[1].data)
+	}
+}
+
+func TestEventLog_EvictsOldest(t *testing.T) {
+	var l eventLog
+	total := eventLogSize + 10
+	for i := 0; i < total; i++ {
+		seq := l.next()
+		l.append(&loggedEvent{seq: seq, data: []byte{byte(seq)}})
+	}
+
+	if len(l.entries) != eventLogSize {
+		t.Fatalf("expected %d entries, got %d", eventLogSize, len(l.entries))
+	}
+	if l.entries[0].seq != 11 {
+		t.Errorf("expected oldest seq 11, got %d", l.entries[0].seq)
+	}
+
+	// Events 1-10 were evicted, so resuming from before them requires a resync
+	if _, ok := l.since(5); ok {
+		t.Error("expected resync when missed events were evicted")
+	}
+	// Resuming right before the oldest retained event still works
+	events, ok := l.since(10)
+	if !ok || len(events) != eventLogSize {
+		t.Errorf("expected %d events replayed, got %d (ok=%v)", eventLogSize, len(events), ok)
+	}
+}
+
+func TestLoggedEvent_MarshalsOnFirstUse(t *testing.T) {
+	e := &loggedEvent{
+		seq:   1,
+		event: Event{Type: "player_left", Seq: 1},
+		msg:   &pb.RoomEvent{RoomId: "ROOM1", Seq: 1},
+		room:  &pb.Room{Id: "ROOM1"},
+	}
+	if e.data != nil || e.binary != nil {
+		t.Fatal("expected nothing marshalled before first use")
+	}
+
+	var event Event
+	if err := json.Unmarshal(e.frame(false), &event); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+	if event.Type != "player_left" || len(event.Room) == 0 {
+		t.Errorf("expected player_left with its room snapshot, got %+v", event)
+	}
+	if e.binary != nil {
+		t.Error("expected binary form not to be marshalled for a JSON client")
+	}
+
+	msg := &pb.RoomEvent{}
+	if err := proto.Unmarshal(e.frame(true), msg); err != nil || msg.RoomId != "ROOM1" {
+		t.Errorf("expected binary form for ROOM1, got %v (err %v)", msg, err)
+	}
+
+	// Events without a protobuf form have no binary frame
+	if (&loggedEvent{event: Event{Type: "tick"}}).frame(true) != nil {
+		t.Error("expected no binary frame for an event without a protobuf form")
+	}
+}
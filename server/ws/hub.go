@@ -3,13 +3,21 @@ package ws
 
 import (
 	"encoding/json"
-	"log"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
 	"github.com/srsalisbury/bouncebot/server/config"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/metrics"
 	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // OriginChecker is an interface for checking if origins are allowed.
@@ -17,10 +25,34 @@ type OriginChecker interface {
 	IsOriginAllowedForRequest(origin, requestHost string) bool
 }
 
+const (
+	// sendBufferSize is the number of messages queued per client.
+	// A client whose queue fills up is evicted as too slow.
+	sendBufferSize = 256
+
+	// maxMessageSize is the largest message accepted from a client.
+	maxMessageSize = 64 * 1024
+)
+
+// Frame formats a client can request with /ws?format=<format>.
+const (
+	// FormatJSON sends JSON Event text frames (the default).
+	FormatJSON = "json"
+	// FormatProto sends binary frames holding a serialized pb.RoomEvent.
+	FormatProto = "proto"
+)
+
 // Event represents a WebSocket event sent to clients.
+// Seq is a per-room sequence number that increases by one with every broadcast,
+// so clients can detect gaps and resume with /ws?since=<seq>.
+// Room is the protojson-encoded pb.Room after the event, so clients don't need
+// to refetch the room; it is omitted once the room is gone.
+// Events sent to a single client (acks, resync) carry no sequence number or room.
 type Event struct {
-	Type    string      `json:"type"`
-	Payload interface{} `json:"payload"`
+	Type    string          `json:"type"`
+	Seq     uint64          `json:"seq,omitempty"`
+	Payload interface{}     `json:"payload"`
+	Room    json.RawMessage `json:"room,omitempty"`
 }
 
 // PlayerJoinedPayload is the payload for player_joined events.
@@ -36,7 +68,7 @@ type PlayerLeftPayload struct {
 
 // GameStartedPayload is the payload for game_started events.
 type GameStartedPayload struct {
-	// Game data is sent via room refresh
+	Game json.RawMessage `json:"game,omitempty"` // protojson-encoded pb.Game
 }
 
 // PlayerSolvedPayload is the payload for player_solved events.
@@ -67,30 +99,129 @@ type GameEndedPayload struct {
 	Moves      []room.MovePayload `json:"moves"`
 }
 
+// GameAnalysedPayload is the payload for game_analysed events.
+type GameAnalysedPayload struct {
+	Analysis *room.GameAnalysis `json:"analysis"`
+}
+
+// SpectatorJoinedPayload is the payload for spectator_joined events.
+type SpectatorJoinedPayload struct {
+	SpectatorID   string `json:"spectatorId"`
+	SpectatorName string `json:"spectatorName"`
+}
+
+// SpectatorLeftPayload is the payload for spectator_left events.
+type SpectatorLeftPayload struct {
+	SpectatorID string `json:"spectatorId"`
+}
+
+// TeamsChangedPayload is the payload for teams_changed events.
+type TeamsChangedPayload struct {
+	Teams      map[string]string `json:"teams"` // Team by player ID
+	TeamQuorum bool              `json:"teamQuorum"`
+}
+
+// MatchStartedPayload is the payload for match_started events.
+type MatchStartedPayload struct {
+	Rounds  int   `json:"rounds"`  // 0 for no limit
+	FirstTo int   `json:"firstTo"` // 0 for no limit
+	Seed    int64 `json:"seed"`    // 0 if unseeded
+}
+
+// MatchOverPayload is the payload for match_over events.
+type MatchOverPayload struct {
+	ChampionID   string               `json:"championId"` // Empty if tied or stopped early
+	ChampionName string               `json:"championName"`
+	GamesPlayed  int                  `json:"gamesPlayed"`
+	Standings    []room.MatchStanding `json:"standings"`
+}
+
+// HandicapChangedPayload is the payload for handicap_changed events.
+type HandicapChangedPayload struct {
+	PlayerID   string `json:"playerId"`
+	ExtraMoves int    `json:"extraMoves"`
+	DelayMs    int64  `json:"delayMs"`
+}
+
+// RoomClosedPayload is the payload for room_closed events.
+type RoomClosedPayload struct{}
+
+// ResyncPayload is the payload for resync events, sent on resume when missed
+// events are no longer available. Clients should refetch the room with GetRoom.
+type ResyncPayload struct {
+	Seq uint64 `json:"seq"` // Latest sequence number; resume after this
+}
+
+// encodeResync encodes a resync event in the client's format.
+func (c *Client) encodeResync(seq uint64) ([]byte, error) {
+	if c.binary {
+		return proto.Marshal(&pb.RoomEvent{
+			RoomId: c.roomID,
+			Event:  &pb.RoomEvent_Resync{Resync: &pb.ResyncEvent{Seq: seq}},
+		})
+	}
+	return json.Marshal(Event{Type: "resync", Payload: ResyncPayload{Seq: seq}})
+}
+
 // Client represents a WebSocket client connection.
+// A client is either a player (playerID set) or a spectator (spectatorID set).
 type Client struct {
-	hub      *Hub
-	conn     *websocket.Conn
-	roomID   string
-	playerID string
-	send     chan []byte
+	hub         *Hub
+	conn        *websocket.Conn
+	roomID      string
+	playerID    string
+	spectatorID string
+	binary      bool // Client requested FormatProto frames
+	send        chan []byte
+
+	// Set by the hub before it closes send, for writePump's close frame
+	closeCode   int
+	closeReason string
+}
+
+// logger returns a logger whose entries carry the client's room and player or
+// spectator ID.
+func (c *Client) logger() *slog.Logger {
+	if c.spectatorID != "" {
+		return slog.With(logging.RoomID(c.roomID), slog.String("spectator_id", c.spectatorID))
+	}
+	return slog.With(logging.RoomID(c.roomID), logging.PlayerID(c.playerID))
 }
 
 // Hub manages WebSocket connections for all rooms.
 type Hub struct {
 	mu       sync.RWMutex
 	rooms    map[string]map[*Client]bool // roomID -> clients
+	logs     map[string]*eventLog        // roomID -> recent events for replay
 	store    *room.RoomService
 	config   *config.Config
 	upgrader websocket.Upgrader
+
+	// presenceMu serializes marking players connected and disconnected, so a
+	// closing connection can't mark a player disconnected after they reconnected.
+	presenceMu sync.Mutex
+
+	pingInterval time.Duration
+	writeTimeout time.Duration
 }
 
 // NewHub creates a new WebSocket hub.
 func NewHub(store *room.RoomService, cfg *config.Config) *Hub {
 	h := &Hub{
 		rooms:  make(map[string]map[*Client]bool),
+		logs:   make(map[string]*eventLog),
 		store:  store,
 		config: cfg,
+
+		pingInterval: cfg.WebSocketPingInterval,
+		writeTimeout: cfg.WebSocketWriteTimeout,
+	}
+	defaults := config.DefaultConfig()
+	if h.pingInterval <= 0 {
+		h.pingInterval = defaults.WebSocketPingInterval
+	}
+	if h.writeTimeout <= 0 {
+		h.writeTimeout = defaults.WebSocketWriteTimeout
 	}
 	h.upgrader = websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool {
@@ -106,37 +237,137 @@ func (h *Hub) register(client *Client) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	h.addClientLocked(client)
+}
+
+// registerSince adds a client to a room and queues the events it missed after seq.
+// Both happen under the hub lock, so no broadcast can slip in between the replay
+// and live events. If the missed events are gone, a resync event is queued instead.
+func (h *Hub) registerSince(client *Client, seq uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.addClientLocked(client)
+
+	l := h.logs[client.roomID]
+	if l == nil {
+		l = &eventLog{}
+	}
+	events, ok := l.since(seq)
+	if !ok {
+		data, err := client.encodeResync(l.lastSeq)
+		if err != nil {
+			client.logger().Error("WebSocket: failed to marshal resync", "error", err)
+			return
+		}
+		client.send <- data
+		return
+	}
+	for _, e := range events {
+		if data := e.frame(client.binary); data != nil {
+			client.send <- data
+		}
+	}
+}
+
+// addClientLocked adds a client to its room. Caller must hold h.mu.
+func (h *Hub) addClientLocked(client *Client) {
 	if h.rooms[client.roomID] == nil {
 		h.rooms[client.roomID] = make(map[*Client]bool)
 	}
 	h.rooms[client.roomID][client] = true
-	log.Printf("WebSocket: client connected to room %s (total: %d)", client.roomID, len(h.rooms[client.roomID]))
+	metrics.WebSocketConnections.Inc()
+	client.logger().Info("WebSocket: client connected", "clients", len(h.rooms[client.roomID]))
 }
 
-// unregister removes a client from a room.
+// unregister removes a client whose connection has ended.
 func (h *Hub) unregister(client *Client) {
-	if client.playerID != "" {
-		h.store.DisconnectPlayer(client.roomID, client.playerID)
-	}
+	h.evict(client, websocket.CloseNormalClosure, "")
+}
 
+// evict removes a client and has its writePump close the connection with the given close code.
+// Safe to call more than once; only the first call has any effect.
+func (h *Hub) evict(client *Client, code int, reason string) {
 	h.mu.Lock()
-	defer h.mu.Unlock()
+	removed := h.removeLocked(client, code, reason)
+	h.mu.Unlock()
 
-	if clients, ok := h.rooms[client.roomID]; ok {
-		if _, ok := clients[client]; ok {
-			delete(clients, client)
-			close(client.send)
-			log.Printf("WebSocket: client disconnected from room %s (remaining: %d)", client.roomID, len(clients))
-			if len(clients) == 0 {
-				delete(h.rooms, client.roomID)
-			}
+	if removed {
+		h.release(client)
+	}
+}
+
+// removeLocked removes a client from its room and closes its send channel.
+// Returns false if the client was already removed. Caller must hold h.mu.
+func (h *Hub) removeLocked(client *Client, code int, reason string) bool {
+	clients := h.rooms[client.roomID]
+	if !clients[client] {
+		return false
+	}
+
+	delete(clients, client)
+	client.closeCode = code
+	client.closeReason = reason
+	close(client.send)
+	metrics.WebSocketConnections.Dec()
+	client.logger().Info("WebSocket: client disconnected", "clients", len(clients))
+	if len(clients) == 0 {
+		delete(h.rooms, client.roomID)
+	}
+	return true
+}
+
+// release tells the room service a removed client is gone. Players and spectators
+// are marked disconnected, and so given the grace period to reconnect, unless they
+// still have another connection.
+// Must be called without h.mu held, since the room service broadcasts back into the hub.
+func (h *Hub) release(client *Client) {
+	h.presenceMu.Lock()
+	defer h.presenceMu.Unlock()
+
+	if client.spectatorID != "" {
+		if h.hasSpectator(client.roomID, client.spectatorID) {
+			return
+		}
+		h.store.DisconnectSpectator(client.roomID, client.spectatorID)
+		return
+	}
+
+	if h.hasPlayer(client.roomID, client.playerID) {
+		return
+	}
+	h.store.DisconnectPlayer(client.roomID, client.playerID)
+}
+
+// hasPlayer reports whether a player has a registered client in the room.
+func (h *Hub) hasPlayer(roomID, playerID string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.rooms[roomID] {
+		if client.playerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSpectator reports whether a spectator has a registered client in the room.
+func (h *Hub) hasSpectator(roomID, spectatorID string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.rooms[roomID] {
+		if client.spectatorID == spectatorID {
+			return true
 		}
 	}
+	return false
 }
 
 // BroadcastPlayerJoined broadcasts a player_joined event to all clients in a room.
 func (h *Hub) BroadcastPlayerJoined(roomID, playerID, playerName string) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.PlayerJoinedEvent{RoomID: roomID, PlayerID: playerID, PlayerName: playerName}, Event{
 		Type: "player_joined",
 		Payload: PlayerJoinedPayload{
 			PlayerID:   playerID,
@@ -147,7 +378,7 @@ func (h *Hub) BroadcastPlayerJoined(roomID, playerID, playerName string) {
 
 // BroadcastPlayerLeft broadcasts a player_left event to all clients in a room.
 func (h *Hub) BroadcastPlayerLeft(roomID, playerID string) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.PlayerLeftEvent{RoomID: roomID, PlayerID: playerID}, Event{
 		Type: "player_left",
 		Payload: PlayerLeftPayload{
 			PlayerID: playerID,
@@ -155,17 +386,25 @@ func (h *Hub) BroadcastPlayerLeft(roomID, playerID string) {
 	})
 }
 
-// BroadcastGameStarted broadcasts a game_started event to all clients in a room.
-func (h *Hub) BroadcastGameStarted(roomID string) {
-	h.Broadcast(roomID, Event{
+// BroadcastGameStarted broadcasts a game_started event, including the new game, to all clients in a room.
+func (h *Hub) BroadcastGameStarted(roomID string, game *model.Game) {
+	payload := GameStartedPayload{}
+	if game != nil {
+		if data, err := protojson.Marshal(game.ToProto()); err != nil {
+			slog.Error("WebSocket: failed to marshal game", logging.RoomID(roomID), "error", err)
+		} else {
+			payload.Game = data
+		}
+	}
+	h.broadcast(room.GameStartedEvent{RoomID: roomID, Game: game}, Event{
 		Type:    "game_started",
-		Payload: GameStartedPayload{},
+		Payload: payload,
 	})
 }
 
 // BroadcastPlayerSolved broadcasts a player_solved event to all clients in a room.
 func (h *Hub) BroadcastPlayerSolved(roomID, playerID string, moveCount int) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.PlayerSolvedEvent{RoomID: roomID, PlayerID: playerID, MoveCount: moveCount}, Event{
 		Type: "player_solved",
 		Payload: PlayerSolvedPayload{
 			PlayerID:  playerID,
@@ -176,7 +415,7 @@ func (h *Hub) BroadcastPlayerSolved(roomID, playerID string, moveCount int) {
 
 // BroadcastSolutionRetracted broadcasts a solution_retracted event to all clients in a room.
 func (h *Hub) BroadcastSolutionRetracted(roomID, playerID string) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.SolutionRetractedEvent{RoomID: roomID, PlayerID: playerID}, Event{
 		Type: "solution_retracted",
 		Payload: SolutionRetractedPayload{
 			PlayerID: playerID,
@@ -186,7 +425,7 @@ func (h *Hub) BroadcastSolutionRetracted(roomID, playerID string) {
 
 // BroadcastPlayerFinishedSolving broadcasts a player_finished_solving event to all clients in a room.
 func (h *Hub) BroadcastPlayerFinishedSolving(roomID, playerID string) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.PlayerFinishedSolvingEvent{RoomID: roomID, PlayerID: playerID}, Event{
 		Type: "player_finished_solving",
 		Payload: PlayerFinishedSolvingPayload{
 			PlayerID: playerID,
@@ -196,7 +435,7 @@ func (h *Hub) BroadcastPlayerFinishedSolving(roomID, playerID string) {
 
 // BroadcastPlayerReadyForNext broadcasts a player_ready_for_next event to all clients in a room.
 func (h *Hub) BroadcastPlayerReadyForNext(roomID, playerID string) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.PlayerReadyForNextEvent{RoomID: roomID, PlayerID: playerID}, Event{
 		Type: "player_ready_for_next",
 		Payload: PlayerReadyForNextPayload{
 			PlayerID: playerID,
@@ -206,7 +445,7 @@ func (h *Hub) BroadcastPlayerReadyForNext(roomID, playerID string) {
 
 // BroadcastGameEnded broadcasts a game_ended event to all clients in a room.
 func (h *Hub) BroadcastGameEnded(roomID, winnerID, winnerName string, moves []room.MovePayload) {
-	h.Broadcast(roomID, Event{
+	h.broadcast(room.GameEndedEvent{RoomID: roomID, WinnerID: winnerID, WinnerName: winnerName, Moves: moves}, Event{
 		Type: "game_ended",
 		Payload: GameEndedPayload{
 			WinnerID:   winnerID,
@@ -216,29 +455,232 @@ func (h *Hub) BroadcastGameEnded(roomID, winnerID, winnerName string, moves []ro
 	})
 }
 
-// Broadcast sends an event to all clients in a room.
+// BroadcastGameAnalysed broadcasts a game_analysed event to all clients in a room.
+func (h *Hub) BroadcastGameAnalysed(roomID string, analysis *room.GameAnalysis) {
+	h.broadcast(room.GameAnalysedEvent{RoomID: roomID, Analysis: analysis}, Event{
+		Type:    "game_analysed",
+		Payload: GameAnalysedPayload{Analysis: analysis},
+	})
+}
+
+// BroadcastSpectatorJoined broadcasts a spectator_joined event to all clients in a room.
+func (h *Hub) BroadcastSpectatorJoined(roomID, spectatorID, spectatorName string) {
+	h.broadcast(room.SpectatorJoinedEvent{RoomID: roomID, SpectatorID: spectatorID, SpectatorName: spectatorName}, Event{
+		Type: "spectator_joined",
+		Payload: SpectatorJoinedPayload{
+			SpectatorID:   spectatorID,
+			SpectatorName: spectatorName,
+		},
+	})
+}
+
+// BroadcastSpectatorLeft broadcasts a spectator_left event to all clients in a room.
+func (h *Hub) BroadcastSpectatorLeft(roomID, spectatorID string) {
+	h.broadcast(room.SpectatorLeftEvent{RoomID: roomID, SpectatorID: spectatorID}, Event{
+		Type: "spectator_left",
+		Payload: SpectatorLeftPayload{
+			SpectatorID: spectatorID,
+		},
+	})
+}
+
+// BroadcastTeamsChanged broadcasts a teams_changed event to all clients in a room.
+func (h *Hub) BroadcastTeamsChanged(roomID string, teams map[string]string, teamQuorum bool) {
+	h.broadcast(room.TeamsChangedEvent{RoomID: roomID, Teams: teams, TeamQuorum: teamQuorum}, Event{
+		Type: "teams_changed",
+		Payload: TeamsChangedPayload{
+			Teams:      teams,
+			TeamQuorum: teamQuorum,
+		},
+	})
+}
+
+// BroadcastMatchStarted broadcasts a match_started event to all clients in a room.
+func (h *Hub) BroadcastMatchStarted(roomID string, rounds, firstTo int, seed int64) {
+	h.broadcast(room.MatchStartedEvent{RoomID: roomID, Rounds: rounds, FirstTo: firstTo, Seed: seed}, Event{
+		Type: "match_started",
+		Payload: MatchStartedPayload{
+			Rounds:  rounds,
+			FirstTo: firstTo,
+			Seed:    seed,
+		},
+	})
+}
+
+// BroadcastMatchOver broadcasts a match_over event to all clients in a room.
+func (h *Hub) BroadcastMatchOver(roomID, championID, championName string, gamesPlayed int, standings []room.MatchStanding) {
+	event := room.MatchOverEvent{
+		RoomID:       roomID,
+		ChampionID:   championID,
+		ChampionName: championName,
+		GamesPlayed:  gamesPlayed,
+		Standings:    standings,
+	}
+	h.broadcast(event, Event{
+		Type: "match_over",
+		Payload: MatchOverPayload{
+			ChampionID:   championID,
+			ChampionName: championName,
+			GamesPlayed:  gamesPlayed,
+			Standings:    standings,
+		},
+	})
+}
+
+// BroadcastHandicapChanged broadcasts a handicap_changed event to all clients in a room.
+func (h *Hub) BroadcastHandicapChanged(roomID, playerID string, handicap room.Handicap) {
+	h.broadcast(room.HandicapChangedEvent{RoomID: roomID, PlayerID: playerID, Handicap: handicap}, Event{
+		Type: "handicap_changed",
+		Payload: HandicapChangedPayload{
+			PlayerID:   playerID,
+			ExtraMoves: handicap.ExtraMoves,
+			DelayMs:    handicap.Delay.Milliseconds(),
+		},
+	})
+}
+
+// BroadcastRoomClosed broadcasts a room_closed event, closes the room's connections,
+// and forgets its event log.
+func (h *Hub) BroadcastRoomClosed(roomID string) {
+	h.broadcast(room.RoomClosedEvent{RoomID: roomID}, Event{
+		Type:    "room_closed",
+		Payload: RoomClosedPayload{},
+	})
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The room is gone, so there is nothing to release in the room service
+	for client := range h.rooms[roomID] {
+		h.removeLocked(client, websocket.CloseNormalClosure, "room closed")
+	}
+	delete(h.logs, roomID)
+}
+
+// Broadcast sends an event to all JSON clients in a room.
+// Binary clients only receive events that have a protobuf form; see broadcast.
 func (h *Hub) Broadcast(roomID string, event Event) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		log.Printf("WebSocket: failed to marshal event: %v", err)
-		return
+	h.publish(roomID, event, nil)
+}
+
+// broadcast sends a room event to all clients in its room, in each client's format.
+func (h *Hub) broadcast(source room.BroadcastEvent, event Event) {
+	msg := source.ToProto()
+	h.publish(msg.RoomId, event, msg)
+}
+
+// publish assigns the next sequence number for the room, attaches a room snapshot,
+// records the event for replay, and sends it to all clients in the room.
+// The snapshot is taken and the event marshalled, in only the formats the room's
+// clients use, under the room's publish lock rather than the hub lock, so snapshots
+// never go backwards in sequence order without holding up other rooms.
+// msg may be nil, in which case binary clients don't receive the event.
+func (h *Hub) publish(roomID string, event Event, msg *pb.RoomEvent) {
+	h.mu.Lock()
+	l := h.logs[roomID]
+	if l == nil {
+		l = &eventLog{}
+		h.logs[roomID] = l
 	}
+	h.mu.Unlock()
 
-	h.mu.RLock()
-	clients := h.rooms[roomID]
-	h.mu.RUnlock()
+	l.publishMu.Lock()
+	event.Seq = l.lastSeq + 1
+	entry := &loggedEvent{seq: event.Seq, roomID: roomID, event: event}
+	if snapshot, err := h.store.Snapshot(roomID); err == nil {
+		entry.room = snapshot
+	}
+	if msg != nil {
+		msg.Seq = event.Seq
+		msg.Room = entry.room
+		entry.msg = msg
+	}
+	anyJSON, anyBinary := h.formats(roomID)
+	if anyJSON && entry.frame(false) == nil {
+		l.publishMu.Unlock()
+		return
+	}
+	if anyBinary {
+		entry.frame(true)
+	}
 
-	for client := range clients {
+	h.mu.Lock()
+	l.next()
+	l.append(entry)
+
+	var slow []*Client
+	for client := range h.rooms[roomID] {
+		data := entry.frame(client.binary)
+		if data == nil {
+			continue
+		}
 		select {
 		case client.send <- data:
 		default:
-			// Client's send buffer is full, close connection
-			h.unregister(client)
+			slow = append(slow, client)
+		}
+	}
+	// Evict slow clients after the loop rather than while ranging over the room
+	for _, client := range slow {
+		h.evictSlowLocked(client)
+	}
+	h.mu.Unlock()
+	// Releasing may publish to the room again
+	l.publishMu.Unlock()
+
+	for _, client := range slow {
+		h.release(client)
+	}
+}
+
+// formats reports whether any client in the room takes JSON frames and whether
+// any takes binary ones.
+func (h *Hub) formats(roomID string) (anyJSON, anyBinary bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.rooms[roomID] {
+		if client.binary {
+			anyBinary = true
+		} else {
+			anyJSON = true
 		}
 	}
+	return anyJSON, anyBinary
+}
+
+// evictSlowLocked removes a client whose send buffer is full. The close code tells
+// it to reconnect and resume with since. Caller must hold h.mu and release the client after unlocking.
+func (h *Hub) evictSlowLocked(client *Client) {
+	client.logger().Warn("WebSocket: evicting slow client")
+	metrics.WebSocketSlowClientsDropped.Inc()
+	h.removeLocked(client, websocket.CloseTryAgainLater, "client too slow")
+}
+
+// sendTo queues a message for a single client.
+// The message is dropped if the client has been unregistered, and the client is
+// evicted if its buffer is full.
+func (h *Hub) sendTo(client *Client, data []byte) {
+	h.mu.Lock()
+	if !h.rooms[client.roomID][client] {
+		h.mu.Unlock()
+		return
+	}
+	select {
+	case client.send <- data:
+		h.mu.Unlock()
+		return
+	default:
+	}
+	h.evictSlowLocked(client)
+	h.mu.Unlock()
+
+	h.release(client)
 }
 
 // HandleWebSocket handles WebSocket connections.
+// Players connect with a playerId; spectators connect with a spectatorId
+// obtained from the SpectateRoom RPC.
 func (h *Hub) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	roomID := r.URL.Query().Get("roomId")
 	if roomID == "" {
@@ -246,45 +688,52 @@ func (h *Hub) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	playerID := r.URL.Query().Get("playerId")
-	if playerID == "" {
-		http.Error(w, "playerId required", http.StatusBadRequest)
+	spectatorID := r.URL.Query().Get("spectatorId")
+	if playerID == "" && spectatorID == "" {
+		http.Error(w, "playerId or spectatorId required", http.StatusBadRequest)
 		return
 	}
 
-	// Check if player can reconnect
-	rm, err := h.store.Get(roomID)
-	if err != nil {
-		http.Error(w, "room not found", http.StatusNotFound)
+	binary := false
+	switch r.URL.Query().Get("format") {
+	case "", FormatJSON:
+	case FormatProto:
+		binary = true
+	default:
+		http.Error(w, "unsupported format", http.StatusBadRequest)
 		return
 	}
 
-	var player room.Player
-	found := false
-	for _, p := range rm.Players {
-		if p.ID == playerID {
-			player = p
-			found = true
-			break
+	var since uint64
+	resume := false
+	if v := r.URL.Query().Get("since"); v != "" {
+		seq, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid since", http.StatusBadRequest)
+			return
 		}
+		since, resume = seq, true
 	}
 
-	if !found {
-		http.Error(w, "player not found", http.StatusForbidden)
+	rm, err := h.store.Get(roomID)
+	if err != nil {
+		http.Error(w, "room not found", http.StatusNotFound)
 		return
 	}
 
-	if player.Status == room.PlayerStatusDisconnected {
-		if err := h.store.ReconnectPlayer(roomID, playerID); err != nil {
-			log.Printf("WebSocket: failed to reconnect player %s in room %s: %v", playerID, roomID, err)
-			http.Error(w, "failed to reconnect", http.StatusInternalServerError)
+	if playerID != "" {
+		if rm.FindPlayerIndex(playerID) == -1 {
+			http.Error(w, "player not found", http.StatusForbidden)
 			return
 		}
-		log.Printf("WebSocket: player %s reconnected to room %s", playerID, roomID)
+	} else if rm.FindSpectatorIndex(spectatorID) == -1 {
+		http.Error(w, "spectator not found", http.StatusForbidden)
+		return
 	}
 
 	conn, err := h.upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		log.Printf("WebSocket: upgrade failed: %v", err)
+		slog.Warn("WebSocket: upgrade failed", "error", err)
 		return
 	}
 
@@ -293,44 +742,126 @@ func (h *Hub) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 		conn:     conn,
 		roomID:   roomID,
 		playerID: playerID,
-		send:     make(chan []byte, 256),
+		binary:   binary,
+		send:     make(chan []byte, sendBufferSize),
+	}
+	if playerID == "" {
+		client.spectatorID = spectatorID
 	}
 
-	h.register(client)
+	if playerID != "" {
+		h.admitPlayer(client, since, resume)
+	} else {
+		h.admitSpectator(client, since, resume)
+	}
 
 	// Start goroutines for reading and writing
 	go client.writePump()
 	go client.readPump()
 }
 
-// readPump reads messages from the WebSocket connection.
+// attach registers a client, replaying the events missed since the given seq
+// when resuming after a reconnect.
+func (h *Hub) attach(client *Client, since uint64, resume bool) {
+	if resume {
+		h.registerSince(client, since)
+	} else {
+		h.register(client)
+	}
+}
+
+// admitPlayer registers a player's client and marks the player connected.
+// Registering first means a concurrently closing connection for the same player
+// sees this one and leaves the player connected.
+func (h *Hub) admitPlayer(client *Client, since uint64, resume bool) {
+	h.presenceMu.Lock()
+	defer h.presenceMu.Unlock()
+
+	h.attach(client, since, resume)
+	if err := h.store.ReconnectPlayer(client.roomID, client.playerID); err != nil {
+		// The player was removed after the handshake
+		client.logger().Warn("WebSocket: failed to reconnect player", "error", err)
+		h.mu.Lock()
+		h.removeLocked(client, websocket.ClosePolicyViolation, "player not found")
+		h.mu.Unlock()
+	}
+}
+
+// admitSpectator registers a spectator's client and ends their grace period,
+// in the same order as admitPlayer.
+func (h *Hub) admitSpectator(client *Client, since uint64, resume bool) {
+	h.presenceMu.Lock()
+	defer h.presenceMu.Unlock()
+
+	h.attach(client, since, resume)
+	if err := h.store.ReconnectSpectator(client.roomID, client.spectatorID); err != nil {
+		// The spectator was removed after the handshake
+		client.logger().Warn("WebSocket: failed to reconnect spectator", "error", err)
+		h.mu.Lock()
+		h.removeLocked(client, websocket.ClosePolicyViolation, "spectator not found")
+		h.mu.Unlock()
+	}
+}
+
+// readPump reads client messages from the WebSocket connection and handles them in order.
+// Any message or pong extends the read deadline; a connection that stays silent for
+// two ping intervals is treated as dead.
 func (c *Client) readPump() {
-	defer func() {
-		c.hub.unregister(c)
-		c.conn.Close()
-	}()
+	// Unregistering closes send, so writePump sends the close frame and closes the connection
+	defer c.hub.unregister(c)
+
+	pongWait := 2 * c.hub.pingInterval
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
 
 	for {
-		_, _, err := c.conn.ReadMessage()
+		messageType, message, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				log.Printf("WebSocket: read error: %v", err)
+				c.logger().Warn("WebSocket: read error", "error", err)
 			}
 			break
 		}
-		// Currently we don't expect any client messages, just keep connection alive
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		c.handleFrame(messageType, message)
 	}
 }
 
-// writePump writes messages to the WebSocket connection.
+// writePump writes messages to the WebSocket connection and pings the client.
+// When the hub closes the send channel, it sends a close frame with the hub's close code.
 func (c *Client) writePump() {
-	defer c.conn.Close()
+	ticker := time.NewTicker(c.hub.pingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
 
-	for message := range c.send {
-		err := c.conn.WriteMessage(websocket.TextMessage, message)
-		if err != nil {
-			log.Printf("WebSocket: write error: %v", err)
-			return
+	messageType := websocket.TextMessage
+	if c.binary {
+		messageType = websocket.BinaryMessage
+	}
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(c.hub.writeTimeout))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(c.closeCode, c.closeReason))
+				return
+			}
+			if err := c.conn.WriteMessage(messageType, message); err != nil {
+				c.logger().Warn("WebSocket: write error", "error", err)
+				return
+			}
+
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(c.hub.writeTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
 		}
 	}
 }
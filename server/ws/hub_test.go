@@ -2,13 +2,28 @@ package ws
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
 	"github.com/srsalisbury/bouncebot/server/config"
+	"github.com/srsalisbury/bouncebot/server/metrics"
 	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
+// Compile-time check that Hub implements room.EventBroadcaster.
+var _ room.EventBroadcaster = (*Hub)(nil)
+
 // mockClient creates a test client with a buffered send channel.
 func mockClient(hub *Hub, roomID, playerID string) *Client {
 	return &Client{
@@ -255,6 +270,122 @@ func TestBroadcastGameEnded(t *testing.T) {
 	hub.unregister(client)
 }
 
+func TestBroadcastGameAnalysed(t *testing.T) {
+	store := room.NewRoomService()
+	cfg := &config.Config{}
+	hub := NewHub(store, cfg)
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+
+	moves := []room.MovePayload{
+		{RobotId: 0, X: 5, Y: 3},
+		{RobotId: 1, X: 7, Y: 2},
+	}
+	hub.BroadcastGameAnalysed("ROOM1", &room.GameAnalysis{
+		OptimalMoves: 2,
+		Players:      []room.SolutionAnalysis{{PlayerID: "winner123", Solution: room.SolutionPath{Moves: moves}, Divergence: -1}},
+	})
+
+	select {
+	case msg := <-client.send:
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if event.Type != "game_analysed" {
+			t.Errorf("expected event type 'game_analysed', got '%s'", event.Type)
+		}
+		payload, ok := event.Payload.(map[string]interface{})
+		if !ok {
+			t.Fatalf("payload is not a map")
+		}
+		analysisPayload, ok := payload["analysis"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("analysis is not a map")
+		}
+		if analysisPayload["optimalMoves"] != float64(2) {
+			t.Errorf("expected optimalMoves 2, got %v", analysisPayload["optimalMoves"])
+		}
+		players, ok := analysisPayload["players"].([]interface{})
+		if !ok || len(players) != 1 || players[0].(map[string]interface{})["divergence"] != float64(-1) {
+			t.Errorf("expected one optimal player analysis, got %v", analysisPayload["players"])
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive broadcast message")
+	}
+
+	hub.unregister(client)
+}
+
+func TestBroadcastTeamsChanged(t *testing.T) {
+	store := room.NewRoomService()
+	cfg := &config.Config{}
+	hub := NewHub(store, cfg)
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+
+	hub.BroadcastTeamsChanged("ROOM1", map[string]string{"player1": "Red"}, true)
+
+	select {
+	case msg := <-client.send:
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if event.Type != "teams_changed" {
+			t.Errorf("expected event type 'teams_changed', got '%s'", event.Type)
+		}
+		payload, ok := event.Payload.(map[string]interface{})
+		if !ok {
+			t.Fatalf("payload is not a map")
+		}
+		teams, ok := payload["teams"].(map[string]interface{})
+		if !ok || teams["player1"] != "Red" || payload["teamQuorum"] != true {
+			t.Errorf("expected player1 on Red with the team quorum, got %v", payload)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive broadcast message")
+	}
+
+	hub.unregister(client)
+}
+
+func TestBroadcastMatchOver(t *testing.T) {
+	store := room.NewRoomService()
+	cfg := &config.Config{}
+	hub := NewHub(store, cfg)
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+
+	hub.BroadcastMatchOver("ROOM1", "player1", "Alice", 3, []room.MatchStanding{{PlayerID: "player1", PlayerName: "Alice", Wins: 2}})
+
+	select {
+	case msg := <-client.send:
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if event.Type != "match_over" {
+			t.Errorf("expected event type 'match_over', got '%s'", event.Type)
+		}
+		payload, ok := event.Payload.(map[string]interface{})
+		if !ok {
+			t.Fatalf("payload is not a map")
+		}
+		standings, ok := payload["standings"].([]interface{})
+		if !ok || payload["championId"] != "player1" || payload["gamesPlayed"] != float64(3) || len(standings) != 1 {
+			t.Errorf("expected player1 champion after 3 games with standings, got %v", payload)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive broadcast message")
+	}
+
+	hub.unregister(client)
+}
+
 func TestBroadcastToEmptyRoom(t *testing.T) {
 	store := room.NewRoomService()
 	cfg := &config.Config{}
@@ -277,7 +408,7 @@ func TestMultipleClientsInRoom(t *testing.T) {
 	hub.register(client2)
 	hub.register(client3)
 
-	hub.BroadcastGameStarted("ROOM1")
+	hub.BroadcastGameStarted("ROOM1", model.Game1())
 
 	// All three clients should receive the message
 	clients := []*Client{client1, client2, client3}
@@ -300,3 +431,666 @@ func TestMultipleClientsInRoom(t *testing.T) {
 	hub.unregister(client2)
 	hub.unregister(client3)
 }
+
+func TestHubSpectatorReceivesBroadcasts(t *testing.T) {
+	store := room.NewRoomService()
+	cfg := &config.Config{}
+	hub := NewHub(store, cfg)
+	store.SetBroadcaster(hub)
+
+	rm := store.Create("Alice")
+	_, spectator, err := store.Spectate(rm.ID, "Big Screen")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client := mockClient(hub, rm.ID, "")
+	client.spectatorID = spectator.ID
+	hub.register(client)
+
+	store.StartGame(rm.ID)
+
+	select {
+	case msg := <-client.send:
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if event.Type != "game_started" {
+			t.Errorf("expected event type 'game_started', got '%s'", event.Type)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("spectator did not receive broadcast message")
+	}
+
+	// Unregistering a spectator keeps them for the grace period, then removes them
+	store.SetDisconnectGracePeriod(20 * time.Millisecond)
+	hub.unregister(client)
+	if rm.FindSpectatorIndex(spectator.ID) == -1 {
+		t.Error("expected spectator to be kept during the grace period")
+	}
+	if !waitFor(t, time.Second, func() bool { return spectatorCount(store, rm.ID) == 0 }) {
+		t.Error("expected spectator to be removed after the grace period")
+	}
+	if len(rm.Players) != 1 || rm.Players[0].Status != room.PlayerStatusConnected {
+		t.Error("expected players to be unaffected by spectator disconnect")
+	}
+}
+
+// spectatorCount returns the number of spectators in a room, read under the room lock.
+func spectatorCount(store *room.RoomService, roomID string) int {
+	snapshot, err := store.Snapshot(roomID)
+	if err != nil {
+		return 0
+	}
+	return len(snapshot.Spectators)
+}
+
+// receiveEvent reads the next event queued for a client.
+func receiveEvent(t *testing.T, client *Client) Event {
+	t.Helper()
+	select {
+	case msg := <-client.send:
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		return event
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("client did not receive message")
+	}
+	return Event{}
+}
+
+func TestBroadcastAssignsSequence(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+
+	client1 := mockClient(hub, "ROOM1", "player1")
+	client2 := mockClient(hub, "ROOM2", "player2")
+	hub.register(client1)
+	hub.register(client2)
+
+	hub.Broadcast("ROOM1", Event{Type: "a"})
+	hub.Broadcast("ROOM1", Event{Type: "b"})
+	hub.Broadcast("ROOM2", Event{Type: "c"})
+
+	if seq := receiveEvent(t, client1).Seq; seq != 1 {
+		t.Errorf("expected seq 1, got %d", seq)
+	}
+	if seq := receiveEvent(t, client1).Seq; seq != 2 {
+		t.Errorf("expected seq 2, got %d", seq)
+	}
+	// Sequences are per room
+	if seq := receiveEvent(t, client2).Seq; seq != 1 {
+		t.Errorf("expected seq 1 in ROOM2, got %d", seq)
+	}
+}
+
+func TestRegisterSinceReplaysMissedEvents(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+	hub.Broadcast("ROOM1", Event{Type: "first"})
+	receiveEvent(t, client)
+	hub.unregister(client)
+
+	// Events broadcast while the client is away
+	hub.Broadcast("ROOM1", Event{Type: "second"})
+	hub.Broadcast("ROOM1", Event{Type: "third"})
+
+	resumed := mockClient(hub, "ROOM1", "player1")
+	hub.registerSince(resumed, 1)
+
+	for _, want := range []struct {
+		typ string
+		seq uint64
+	}{{"second", 2}, {"third", 3}} {
+		event := receiveEvent(t, resumed)
+		if event.Type != want.typ || event.Seq != want.seq {
+			t.Errorf("expected %s (seq %d), got %s (seq %d)", want.typ, want.seq, event.Type, event.Seq)
+		}
+	}
+
+	// Live events continue the sequence
+	hub.Broadcast("ROOM1", Event{Type: "fourth"})
+	if event := receiveEvent(t, resumed); event.Seq != 4 {
+		t.Errorf("expected seq 4, got %d", event.Seq)
+	}
+}
+
+func TestRegisterSinceResyncsWhenEventsMissing(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+
+	for i := 0; i < eventLogSize+5; i++ {
+		hub.Broadcast("ROOM1", Event{Type: "filler"})
+	}
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.registerSince(client, 2)
+
+	event := receiveEvent(t, client)
+	if event.Type != "resync" {
+		t.Fatalf("expected resync event, got %s", event.Type)
+	}
+	payload := event.Payload.(map[string]interface{})
+	if payload["seq"].(float64) != float64(eventLogSize+5) {
+		t.Errorf("expected resync seq %d, got %v", eventLogSize+5, payload["seq"])
+	}
+}
+
+func TestBroadcastRoomClosedForgetsLog(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+	hub.Broadcast("ROOM1", Event{Type: "first"})
+	receiveEvent(t, client)
+
+	hub.BroadcastRoomClosed("ROOM1")
+	if event := receiveEvent(t, client); event.Type != "room_closed" {
+		t.Errorf("expected room_closed event, got %s", event.Type)
+	}
+
+	hub.mu.RLock()
+	_, exists := hub.logs["ROOM1"]
+	hub.mu.RUnlock()
+	if exists {
+		t.Error("expected event log to be removed when room closed")
+	}
+}
+
+func TestBroadcastIncludesRoomSnapshot(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+	store.SetBroadcaster(hub)
+
+	rm := store.Create("Alice")
+	client := mockClient(hub, rm.ID, rm.Players[0].ID)
+	hub.register(client)
+
+	store.Join(rm.ID, "Bob")
+
+	event := receiveEvent(t, client)
+	if event.Type != "player_joined" {
+		t.Fatalf("expected player_joined event, got %s", event.Type)
+	}
+	var snapshot pb.Room
+	if err := protojson.Unmarshal(event.Room, &snapshot); err != nil {
+		t.Fatalf("failed to unmarshal room snapshot: %v", err)
+	}
+	if snapshot.Id != rm.ID || len(snapshot.Players) != 2 {
+		t.Errorf("expected snapshot of %s with 2 players, got %s with %d", rm.ID, snapshot.Id, len(snapshot.Players))
+	}
+
+	// Events for rooms the store doesn't know carry no snapshot
+	orphan := mockClient(hub, "GONE", "player1")
+	hub.register(orphan)
+	hub.BroadcastRoomClosed("GONE")
+	if event := receiveEvent(t, orphan); event.Room != nil {
+		t.Errorf("expected no snapshot for unknown room, got %s", event.Room)
+	}
+}
+
+func TestBroadcastGameStartedIncludesGame(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+
+	hub.BroadcastGameStarted("ROOM1", model.Game1())
+
+	var event struct {
+		Payload GameStartedPayload `json:"payload"`
+		Room    json.RawMessage    `json:"room"`
+	}
+	if err := json.Unmarshal(<-client.send, &event); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+	var game pb.Game
+	if err := protojson.Unmarshal(event.Payload.Game, &game); err != nil {
+		t.Fatalf("failed to unmarshal game: %v", err)
+	}
+	if game.Target == nil || len(game.Bots) == 0 {
+		t.Errorf("expected game with target and bots, got %v", &game)
+	}
+	if event.Room != nil {
+		t.Error("expected no snapshot for unknown room")
+	}
+}
+
+// receiveProto reads the next binary event queued for a client.
+func receiveProto(t *testing.T, client *Client) *pb.RoomEvent {
+	t.Helper()
+	select {
+	case msg := <-client.send:
+		event := &pb.RoomEvent{}
+		if err := proto.Unmarshal(msg, event); err != nil {
+			t.Fatalf("failed to unmarshal binary event: %v", err)
+		}
+		return event
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("client did not receive message")
+	}
+	return nil
+}
+
+func TestPublishMarshalsOnlyFormatsInUse(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+	store.SetBroadcaster(hub)
+
+	rm := store.Create("Alice")
+	client := mockClient(hub, rm.ID, rm.Players[0].ID)
+	hub.register(client)
+	store.Join(rm.ID, "Bob")
+	receiveEvent(t, client)
+
+	entry := hub.logs[rm.ID].entries[0]
+	if entry.data == nil || entry.binary != nil {
+		t.Errorf("expected only the JSON form to be marshalled, got data=%v binary=%v", entry.data != nil, entry.binary != nil)
+	}
+
+	// A binary client resuming later still gets the event
+	resumed := mockClient(hub, rm.ID, rm.Players[0].ID)
+	resumed.binary = true
+	hub.registerSince(resumed, 0)
+	if event := receiveProto(t, resumed); event.GetPlayerJoined().GetPlayerName() != "Bob" {
+		t.Errorf("expected replayed player_joined for Bob, got %v", event)
+	}
+}
+
+func TestBinaryClientReceivesProtoEvents(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+	store.SetBroadcaster(hub)
+
+	rm := store.Create("Alice")
+	client := mockClient(hub, rm.ID, rm.Players[0].ID)
+	client.binary = true
+	hub.register(client)
+
+	// Events without a protobuf form are only sent to JSON clients
+	hub.Broadcast(rm.ID, Event{Type: "custom"})
+	store.Join(rm.ID, "Bob")
+
+	event := receiveProto(t, client)
+	if event.GetPlayerJoined().GetPlayerName() != "Bob" {
+		t.Fatalf("expected player_joined for Bob, got %v", event)
+	}
+	if event.Seq != 2 {
+		t.Errorf("expected seq 2, got %d", event.Seq)
+	}
+	if len(event.GetRoom().GetPlayers()) != 2 {
+		t.Errorf("expected snapshot with 2 players, got %v", event.GetRoom())
+	}
+
+	// Replay uses the binary encoding too
+	resumed := mockClient(hub, rm.ID, rm.Players[0].ID)
+	resumed.binary = true
+	hub.registerSince(resumed, 0)
+	if event := receiveProto(t, resumed); event.GetPlayerJoined() == nil || event.Seq != 2 {
+		t.Errorf("expected replayed player_joined at seq 2, got %v", event)
+	}
+
+	late := mockClient(hub, rm.ID, "")
+	late.binary = true
+	hub.logs[rm.ID].entries = nil
+	hub.registerSince(late, 0)
+	if event := receiveProto(t, late); event.GetResync().GetSeq() != 2 {
+		t.Errorf("expected resync to seq 2, got %v", event)
+	}
+}
+
+func TestSlowClientEvicted(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+	rm := store.Create("Alice")
+
+	slow := mockClient(hub, rm.ID, rm.Players[0].ID)
+	hub.register(slow)
+	dropped := testutil.ToFloat64(metrics.WebSocketSlowClientsDropped)
+
+	// Nobody drains the client, so the broadcast after a full buffer evicts it
+	for i := 0; i <= sendBufferSize; i++ {
+		hub.Broadcast(rm.ID, Event{Type: "tick"})
+	}
+
+	count := 0
+	for range slow.send {
+		count++
+	}
+	if count != sendBufferSize {
+		t.Errorf("expected %d queued messages before eviction, got %d", sendBufferSize, count)
+	}
+	if slow.closeCode != websocket.CloseTryAgainLater {
+		t.Errorf("expected close code %d, got %d", websocket.CloseTryAgainLater, slow.closeCode)
+	}
+	if hub.hasPlayer(rm.ID, slow.playerID) {
+		t.Error("expected slow client to be removed from hub")
+	}
+	if rm.Players[0].Status != room.PlayerStatusDisconnected {
+		t.Error("expected evicted player to be marked disconnected")
+	}
+	if got := testutil.ToFloat64(metrics.WebSocketSlowClientsDropped) - dropped; got != 1 {
+		t.Errorf("expected 1 dropped client counted, got %v", got)
+	}
+
+	// The readPump's later unregister is a no-op
+	hub.unregister(slow)
+}
+
+func TestEvictedSpectatorReconnects(t *testing.T) {
+	store, hub, srv := newTestServer(t, &config.Config{})
+	store.SetDisconnectGracePeriod(200 * time.Millisecond)
+	rm := store.Create("Alice")
+	_, spectator, _ := store.Spectate(rm.ID, "Big Screen")
+
+	slow := mockClient(hub, rm.ID, "")
+	slow.spectatorID = spectator.ID
+	hub.admitSpectator(slow, 0, false)
+
+	// Nobody drains the client, so the broadcast after a full buffer evicts it
+	for i := 0; i <= sendBufferSize; i++ {
+		hub.Broadcast(rm.ID, Event{Type: "tick"})
+	}
+	var last Event
+	for msg := range slow.send {
+		if err := json.Unmarshal(msg, &last); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+	}
+	if slow.closeCode != websocket.CloseTryAgainLater {
+		t.Errorf("expected close code %d, got %d", websocket.CloseTryAgainLater, slow.closeCode)
+	}
+	if rm.FindSpectatorIndex(spectator.ID) == -1 {
+		t.Fatal("expected evicted spectator to be kept during the grace period")
+	}
+
+	// Reconnecting replays the event the eviction dropped and ends the grace period
+	conn := dial(t, srv, fmt.Sprintf("roomId=%s&spectatorId=%s&since=%d", rm.ID, spectator.ID, last.Seq))
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	var event Event
+	if err := conn.ReadJSON(&event); err != nil {
+		t.Fatalf("failed to read replayed event: %v", err)
+	}
+	if event.Type != "tick" || event.Seq != last.Seq+1 {
+		t.Errorf("expected replayed tick at seq %d, got %s at %d", last.Seq+1, event.Type, event.Seq)
+	}
+
+	time.Sleep(300 * time.Millisecond)
+	if spectatorCount(store, rm.ID) != 1 {
+		t.Error("expected reconnected spectator to outlast the grace period")
+	}
+}
+
+func TestUnregisterKeepsReconnectedPlayerConnected(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+	rm := store.Create("Alice")
+	playerID := rm.Players[0].ID
+
+	old := mockClient(hub, rm.ID, playerID)
+	hub.register(old)
+	// The player reconnects before the old connection notices it is dead
+	hub.admitPlayer(mockClient(hub, rm.ID, playerID), 0, false)
+
+	hub.unregister(old)
+
+	if rm.Players[0].Status != room.PlayerStatusConnected {
+		t.Error("expected player with a live connection to stay connected")
+	}
+	if !hub.hasPlayer(rm.ID, playerID) {
+		t.Error("expected new client to remain registered")
+	}
+}
+
+func TestBroadcastRoomClosedClosesClients(t *testing.T) {
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+	hub.BroadcastRoomClosed("ROOM1")
+
+	if event := receiveEvent(t, client); event.Type != "room_closed" {
+		t.Errorf("expected room_closed event, got %s", event.Type)
+	}
+	if _, ok := <-client.send; ok {
+		t.Error("expected send channel to be closed after room_closed")
+	}
+	if client.closeCode != websocket.CloseNormalClosure {
+		t.Errorf("expected normal closure, got %d", client.closeCode)
+	}
+}
+
+// newTestServer starts an HTTP server for the hub's WebSocket handler.
+func newTestServer(t *testing.T, cfg *config.Config) (*room.RoomService, *Hub, *httptest.Server) {
+	t.Helper()
+	cfg.AllowSameHost = true
+	store := room.NewRoomService()
+	hub := NewHub(store, cfg)
+	store.SetBroadcaster(hub)
+	srv := httptest.NewServer(http.HandlerFunc(hub.HandleWebSocket))
+	t.Cleanup(srv.Close)
+	return store, hub, srv
+}
+
+// dial opens a WebSocket connection to the test server with the given query.
+func dial(t *testing.T, srv *httptest.Server, query string) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws?" + query
+	header := http.Header{"Origin": []string{srv.URL}}
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// waitFor polls cond until it is true or the timeout expires.
+func waitFor(t *testing.T, timeout time.Duration, cond func() bool) bool {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return cond()
+}
+
+func TestHeartbeatPingsClient(t *testing.T) {
+	store, _, srv := newTestServer(t, &config.Config{WebSocketPingInterval: 20 * time.Millisecond})
+	rm := store.Create("Alice")
+
+	conn := dial(t, srv, "roomId="+rm.ID+"&playerId="+rm.Players[0].ID)
+	pinged := make(chan struct{}, 1)
+	conn.SetPingHandler(func(data string) error {
+		select {
+		case pinged <- struct{}{}:
+		default:
+		}
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-pinged:
+	case <-time.After(time.Second):
+		t.Fatal("expected server ping")
+	}
+}
+
+func TestHeartbeatClosesUnresponsiveClient(t *testing.T) {
+	store, hub, srv := newTestServer(t, &config.Config{WebSocketPingInterval: 20 * time.Millisecond})
+	rm := store.Create("Alice")
+	playerID := rm.Players[0].ID
+
+	conn := dial(t, srv, "roomId="+rm.ID+"&playerId="+playerID)
+	// Simulate a half-open connection by never answering pings
+	conn.SetPingHandler(func(string) error { return nil })
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				t.Errorf("expected normal close from server, got %v", err)
+			}
+			break
+		}
+	}
+	if !waitFor(t, time.Second, func() bool { return !hub.hasPlayer(rm.ID, playerID) }) {
+		t.Error("expected unresponsive client to be removed from hub")
+	}
+}
+
+func TestRejectsOversizedMessage(t *testing.T) {
+	store, _, srv := newTestServer(t, &config.Config{})
+	rm := store.Create("Alice")
+
+	conn := dial(t, srv, "roomId="+rm.ID+"&playerId="+rm.Players[0].ID)
+	conn.WriteMessage(websocket.TextMessage, make([]byte, maxMessageSize+1))
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseMessageTooBig) {
+				t.Errorf("expected message too big close, got %v", err)
+			}
+			return
+		}
+	}
+}
+
+func TestStressManyClients(t *testing.T) {
+	const (
+		numRooms       = 5
+		clientsPerRoom = 60
+		eventsPerRoom  = 50
+	)
+	store, hub, srv := newTestServer(t, &config.Config{})
+
+	var rooms []*room.Room
+	var conns []*websocket.Conn
+	for i := 0; i < numRooms; i++ {
+		rm := store.Create(fmt.Sprintf("Host%d", i))
+		rooms = append(rooms, rm)
+		for j := 0; j < clientsPerRoom; j++ {
+			_, spectator, err := store.Spectate(rm.ID, fmt.Sprintf("Watcher%d", j))
+			if err != nil {
+				t.Fatalf("Spectate failed: %v", err)
+			}
+			conns = append(conns, dial(t, srv, "roomId="+rm.ID+"&spectatorId="+spectator.ID))
+		}
+	}
+	if !waitFor(t, 2*time.Second, func() bool {
+		hub.mu.RLock()
+		defer hub.mu.RUnlock()
+		total := 0
+		for _, clients := range hub.rooms {
+			total += len(clients)
+		}
+		return total == numRooms*clientsPerRoom
+	}) {
+		t.Fatal("not all clients registered")
+	}
+	store.SetDisconnectGracePeriod(20 * time.Millisecond)
+
+	// Every client reads its room's events concurrently with the broadcasts
+	var wg sync.WaitGroup
+	errs := make(chan error, len(conns))
+	for _, conn := range conns {
+		wg.Add(1)
+		go func(conn *websocket.Conn) {
+			defer wg.Done()
+			conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+			var last uint64
+			for received := 0; received < eventsPerRoom; received++ {
+				var event Event
+				if err := conn.ReadJSON(&event); err != nil {
+					errs <- err
+					return
+				}
+				if last != 0 && event.Seq != last+1 {
+					errs <- fmt.Errorf("expected seq %d, got %d", last+1, event.Seq)
+					return
+				}
+				last = event.Seq
+			}
+		}(conn)
+	}
+	for _, rm := range rooms {
+		go func(roomID string) {
+			for i := 0; i < eventsPerRoom; i++ {
+				hub.Broadcast(roomID, Event{Type: "tick"})
+			}
+		}(rm.ID)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// Closing every connection at once leaves no clients or spectators behind
+	for _, conn := range conns {
+		go conn.Close()
+	}
+	if !waitFor(t, 5*time.Second, func() bool {
+		hub.mu.RLock()
+		defer hub.mu.RUnlock()
+		return len(hub.rooms) == 0
+	}) {
+		t.Error("expected all clients to be unregistered")
+	}
+	for _, rm := range rooms {
+		if !waitFor(t, 2*time.Second, func() bool { return spectatorCount(store, rm.ID) == 0 }) {
+			t.Errorf("expected no spectators left in %s, got %d", rm.ID, spectatorCount(store, rm.ID))
+		}
+	}
+}
+
+func TestBroadcastHandicapChanged(t *testing.T) {
+	store := room.NewRoomService()
+	cfg := &config.Config{}
+	hub := NewHub(store, cfg)
+
+	client := mockClient(hub, "ROOM1", "player1")
+	hub.register(client)
+
+	hub.BroadcastHandicapChanged("ROOM1", "player1", room.Handicap{ExtraMoves: 2, Delay: 1500 * time.Millisecond})
+
+	select {
+	case msg := <-client.send:
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if event.Type != "handicap_changed" {
+			t.Errorf("expected event type 'handicap_changed', got '%s'", event.Type)
+		}
+		payload, ok := event.Payload.(map[string]interface{})
+		if !ok {
+			t.Fatalf("payload is not a map")
+		}
+		if payload["playerId"] != "player1" || payload["extraMoves"] != float64(2) || payload["delayMs"] != float64(1500) {
+			t.Errorf("expected player1 with 2 extra moves and a 1500ms delay, got %v", payload)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive broadcast message")
+	}
+}
@@ -0,0 +1,143 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/websocket"
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/logging"
+	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/proto"
+)
+
+// Inbound message types sent by clients over the WebSocket.
+// These mirror the Connect RPCs, which remain available as an alternative.
+const (
+	MessageSubmitSolution = "submit_solution"
+	MessageMarkFinished   = "mark_finished"
+	MessageMarkReady      = "mark_ready"
+	MessagePing           = "ping"
+)
+
+// ClientMessage is the envelope for messages sent from a client to the server.
+// RequestID is echoed back in the ack so clients can match replies to requests.
+type ClientMessage struct {
+	Type      string          `json:"type"`
+	RequestID string          `json:"requestId"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+// SubmitSolutionMessage is the payload for submit_solution messages.
+type SubmitSolutionMessage struct {
+	Moves []room.MovePayload `json:"moves"`
+}
+
+// AckPayload is the payload for ack events sent in reply to a ClientMessage.
+type AckPayload struct {
+	RequestID string      `json:"requestId"`
+	OK        bool        `json:"ok"`
+	Error     string      `json:"error,omitempty"`
+	Result    interface{} `json:"result,omitempty"`
+}
+
+// SubmitSolutionResult is the ack result for submit_solution messages.
+type SubmitSolutionResult struct {
+	MoveCount int `json:"moveCount"`
+}
+
+// handleFrame handles a frame read from the connection. Client messages are JSON
+// text frames whatever format the client receives, so binary frames are rejected.
+func (c *Client) handleFrame(messageType int, data []byte) {
+	if messageType != websocket.TextMessage {
+		c.sendAck(AckPayload{Error: "binary messages are not supported, send JSON text messages"})
+		return
+	}
+	c.handleMessage(data)
+}
+
+// handleMessage decodes a client message, routes it to the room service and sends an ack.
+// Messages from one client are handled in the order they are read.
+func (c *Client) handleMessage(data []byte) {
+	var msg ClientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		c.sendAck(AckPayload{Error: "malformed message"})
+		return
+	}
+
+	result, err := c.dispatch(msg)
+	ack := AckPayload{RequestID: msg.RequestID, OK: err == nil, Result: result}
+	logger := c.logger().With(logging.KeyMethod, msg.Type, logging.KeyRequestID, msg.RequestID)
+	if err != nil {
+		ack.Error = err.Error()
+		logger.Warn("WebSocket: message failed", "error", err)
+	} else {
+		logger.Debug("WebSocket: message handled")
+	}
+	c.sendAck(ack)
+}
+
+// dispatch performs the action requested by a client message.
+func (c *Client) dispatch(msg ClientMessage) (interface{}, error) {
+	if msg.Type == MessagePing {
+		return nil, nil
+	}
+
+	if c.playerID == "" {
+		return nil, fmt.Errorf("spectators cannot perform %s", msg.Type)
+	}
+
+	store := c.hub.store
+	switch msg.Type {
+	case MessageSubmitSolution:
+		var payload SubmitSolutionMessage
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("invalid payload: %v", err)
+		}
+		moves := make([]model.BotPosition, len(payload.Moves))
+		for i, m := range payload.Moves {
+			moves[i] = model.NewBotPosition(model.BotId(m.RobotId), model.BoardDim(m.X), model.BoardDim(m.Y))
+		}
+		solution, err := store.SubmitSolution(c.roomID, c.playerID, moves)
+		if err != nil {
+			return nil, err
+		}
+		return SubmitSolutionResult{MoveCount: solution.MoveCount()}, nil
+
+	case MessageMarkFinished:
+		return nil, store.MarkFinishedSolving(c.roomID, c.playerID)
+
+	case MessageMarkReady:
+		return nil, store.MarkReadyForNext(c.roomID, c.playerID)
+
+	default:
+		return nil, fmt.Errorf("unknown message type: %s", msg.Type)
+	}
+}
+
+// sendAck queues an ack event for this client only, in the client's format.
+func (c *Client) sendAck(ack AckPayload) {
+	data, err := c.encodeAck(ack)
+	if err != nil {
+		c.logger().Error("WebSocket: failed to marshal ack", logging.KeyRequestID, ack.RequestID, "error", err)
+		return
+	}
+	c.hub.sendTo(c, data)
+}
+
+// encodeAck encodes an ack as a JSON event or, for binary clients, a pb.RoomEvent.
+func (c *Client) encodeAck(ack AckPayload) ([]byte, error) {
+	if !c.binary {
+		return json.Marshal(Event{Type: "ack", Payload: ack})
+	}
+	msg := &pb.ActionAck{
+		RequestId: ack.RequestID,
+		Ok:        ack.OK,
+		Error:     ack.Error,
+	}
+	if result, ok := ack.Result.(SubmitSolutionResult); ok {
+		msg.MoveCount = int32(result.MoveCount)
+	}
+	return proto.Marshal(&pb.RoomEvent{RoomId: c.roomID, Event: &pb.RoomEvent_Ack{Ack: msg}})
+}
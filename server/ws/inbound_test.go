@@ -0,0 +1,233 @@
+package ws
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/srsalisbury/bouncebot/model"
+	pb "github.com/srsalisbury/bouncebot/proto"
+	"github.com/srsalisbury/bouncebot/server/config"
+	"github.com/srsalisbury/bouncebot/server/room"
+	"google.golang.org/protobuf/proto"
+)
+
+// readAck reads events from the client until an ack arrives, skipping broadcasts.
+func readAck(t *testing.T, client *Client) AckPayload {
+	t.Helper()
+	for {
+		select {
+		case msg := <-client.send:
+			var event struct {
+				Type    string     `json:"type"`
+				Payload AckPayload `json:"payload"`
+			}
+			if err := json.Unmarshal(msg, &event); err != nil {
+				t.Fatalf("failed to unmarshal event: %v", err)
+			}
+			if event.Type == "ack" {
+				return event.Payload
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("client did not receive ack")
+		}
+	}
+}
+
+// newGameRoom creates a room with a started Game1 and a registered client for its player.
+func newGameRoom(t *testing.T) (*room.RoomService, *room.Room, *Client) {
+	t.Helper()
+	store := room.NewRoomService()
+	hub := NewHub(store, &config.Config{})
+	store.SetBroadcaster(hub)
+
+	rm := store.Create("Alice")
+	store.StartGame(rm.ID)
+	rm.CurrentGame = model.Game1()
+
+	client := mockClient(hub, rm.ID, rm.Players[0].ID)
+	hub.register(client)
+	return store, rm, client
+}
+
+func TestHandleMessage_Ping(t *testing.T) {
+	_, _, client := newGameRoom(t)
+
+	client.handleMessage([]byte(`{"type":"ping","requestId":"r1"}`))
+
+	ack := readAck(t, client)
+	if ack.RequestID != "r1" {
+		t.Errorf("expected requestId 'r1', got '%s'", ack.RequestID)
+	}
+	if !ack.OK {
+		t.Errorf("expected ok ack, got error '%s'", ack.Error)
+	}
+}
+
+func TestHandleMessage_SubmitSolution(t *testing.T) {
+	_, rm, client := newGameRoom(t)
+
+	moves := make([]room.MovePayload, 0)
+	for _, m := range model.Game1Solution() {
+		moves = append(moves, room.MovePayload{RobotId: int(m.Id), X: int(m.Pos.X), Y: int(m.Pos.Y)})
+	}
+	payload, _ := json.Marshal(SubmitSolutionMessage{Moves: moves})
+	msg, _ := json.Marshal(ClientMessage{Type: MessageSubmitSolution, RequestID: "r2", Payload: payload})
+
+	client.handleMessage(msg)
+
+	ack := readAck(t, client)
+	if !ack.OK {
+		t.Fatalf("expected ok ack, got error '%s'", ack.Error)
+	}
+	if ack.RequestID != "r2" {
+		t.Errorf("expected requestId 'r2', got '%s'", ack.RequestID)
+	}
+	if len(rm.Solutions) != 1 {
+		t.Errorf("expected 1 solution, got %d", len(rm.Solutions))
+	}
+}
+
+func TestHandleMessage_SubmitInvalidSolution(t *testing.T) {
+	_, rm, client := newGameRoom(t)
+
+	client.handleMessage([]byte(`{"type":"submit_solution","requestId":"r3","payload":{"moves":[{"robotId":0,"x":1,"y":1}]}}`))
+
+	ack := readAck(t, client)
+	if ack.OK {
+		t.Error("expected failed ack for invalid solution")
+	}
+	if ack.Error == "" {
+		t.Error("expected error message in ack")
+	}
+	if len(rm.Solutions) != 0 {
+		t.Errorf("expected 0 solutions, got %d", len(rm.Solutions))
+	}
+}
+
+func TestHandleMessage_MarkFinishedAndReady(t *testing.T) {
+	_, rm, client := newGameRoom(t)
+	playerID := rm.Players[0].ID
+
+	client.handleMessage([]byte(`{"type":"mark_finished","requestId":"r4"}`))
+	if ack := readAck(t, client); !ack.OK {
+		t.Fatalf("expected ok ack, got error '%s'", ack.Error)
+	}
+
+	client.handleMessage([]byte(`{"type":"mark_ready","requestId":"r5"}`))
+	if ack := readAck(t, client); !ack.OK {
+		t.Fatalf("expected ok ack, got error '%s'", ack.Error)
+	}
+
+	// Single player finished then ready: game ended and the next game started
+	if rm.GamesPlayed != 1 {
+		t.Errorf("expected 1 game played, got %d", rm.GamesPlayed)
+	}
+	for _, id := range rm.ReadyForNext {
+		if id == playerID {
+			t.Error("expected ready state to be cleared by next game")
+		}
+	}
+}
+
+func TestHandleMessage_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+	}{
+		{"malformed", `not json`},
+		{"unknown type", `{"type":"dance","requestId":"r6"}`},
+		{"bad payload", `{"type":"submit_solution","requestId":"r7","payload":"nope"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, client := newGameRoom(t)
+			client.handleMessage([]byte(tt.msg))
+			ack := readAck(t, client)
+			if ack.OK || ack.Error == "" {
+				t.Errorf("expected error ack, got %+v", ack)
+			}
+		})
+	}
+}
+
+func TestHandleMessage_SpectatorCannotAct(t *testing.T) {
+	store, rm, _ := newGameRoom(t)
+	_, spectator, _ := store.Spectate(rm.ID, "Big Screen")
+
+	hub := NewHub(store, &config.Config{})
+	client := mockClient(hub, rm.ID, "")
+	client.spectatorID = spectator.ID
+	hub.register(client)
+
+	client.handleMessage([]byte(`{"type":"mark_finished","requestId":"r8"}`))
+	if ack := readAck(t, client); ack.OK {
+		t.Error("expected spectator action to be rejected")
+	}
+	if len(rm.FinishedSolving) != 0 {
+		t.Errorf("expected no finished players, got %v", rm.FinishedSolving)
+	}
+
+	// Spectators may still ping
+	client.handleMessage([]byte(`{"type":"ping","requestId":"r9"}`))
+	if ack := readAck(t, client); !ack.OK {
+		t.Errorf("expected ping to succeed for spectator, got error '%s'", ack.Error)
+	}
+}
+
+func TestHandleMessage_BinaryAck(t *testing.T) {
+	_, _, client := newGameRoom(t)
+	client.binary = true
+
+	client.handleMessage([]byte(`{"type":"submit_solution","requestId":"r4","payload":{"moves":[{"robotId":0,"x":0,"y":0}]}}`))
+
+	for {
+		select {
+		case msg := <-client.send:
+			event := &pb.RoomEvent{}
+			if err := proto.Unmarshal(msg, event); err != nil {
+				t.Fatalf("failed to unmarshal binary event: %v", err)
+			}
+			ack := event.GetAck()
+			if ack == nil {
+				continue
+			}
+			if ack.RequestId != "r4" || ack.Ok || ack.Error == "" {
+				t.Errorf("expected failed ack for r4, got %v", ack)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("client did not receive ack")
+		}
+	}
+}
+
+func TestHandleFrame_RejectsBinary(t *testing.T) {
+	_, rm, client := newGameRoom(t)
+	client.binary = true
+
+	data, _ := proto.Marshal(&pb.RoomEvent{RoomId: rm.ID})
+	client.handleFrame(websocket.BinaryMessage, data)
+
+	for {
+		select {
+		case msg := <-client.send:
+			event := &pb.RoomEvent{}
+			if err := proto.Unmarshal(msg, event); err != nil {
+				t.Fatalf("failed to unmarshal binary event: %v", err)
+			}
+			ack := event.GetAck()
+			if ack == nil {
+				continue
+			}
+			if ack.Ok || ack.Error == "" {
+				t.Errorf("expected failed ack for binary frame, got %v", ack)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("client did not receive ack")
+		}
+	}
+}